@@ -12,6 +12,7 @@ import (
 	apiruntime "k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/drift"
 	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 
 	"github.com/go-logr/logr"
@@ -68,7 +69,29 @@ func (h HcCmdHelper) RegisterPPROFServer(mgr manager.Manager) error {
 	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
 	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
 
-	s := &http.Server{Addr: pprofAddr, Handler: mux}
+	return addHTTPServer(mgr, &http.Server{Addr: pprofAddr, Handler: mux})
+}
+
+const driftAddrEnvVar = "HCO_DRIFT_ADDR"
+
+// Registers a server for the drift reports of the running operator. The reports hold the content of the operand CRs,
+// so they are not exposed on the metrics server, but only on this server, if HCO_DRIFT_ADDR is set.
+func (h HcCmdHelper) RegisterDriftReportServer(mgr manager.Manager) error {
+	driftAddr := os.Getenv(driftAddrEnvVar)
+	if len(driftAddr) == 0 {
+		return nil
+	}
+
+	h.Logger.Info("Registering drift report server.")
+
+	mux := http.NewServeMux()
+	mux.Handle(hcoutil.DriftReportEndpointName, drift.Reports)
+
+	return addHTTPServer(mgr, &http.Server{Addr: driftAddr, Handler: mux})
+}
+
+// addHTTPServer adds the server to the manager, so it runs until the manager is stopped
+func addHTTPServer(mgr manager.Manager, s *http.Server) error {
 	return mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
 		errCh := make(chan error)
 		defer func() {
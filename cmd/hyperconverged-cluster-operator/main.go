@@ -31,7 +31,6 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/config"
-	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/manager/signals"
@@ -102,6 +101,9 @@ func main() {
 	// register pprof instrumentation if HCO_PPROF_ADDR is set
 	cmdHelper.ExitOnError(cmdHelper.RegisterPPROFServer(mgr), "can't register pprof server")
 
+	// register the drift report server if HCO_DRIFT_ADDR is set
+	cmdHelper.ExitOnError(cmdHelper.RegisterDriftReportServer(mgr), "can't register the drift report server")
+
 	logger.Info("Registering Components.")
 
 	// apiclient.New() returns a client without cache.
@@ -112,20 +114,27 @@ func main() {
 	})
 	cmdHelper.ExitOnError(err, "Cannot create a new API client")
 
+	health := hcoutil.GetHealthChecker()
+	reconcileDeadline, err := hcoutil.GetReconcileDeadlineFromEnv()
+	cmdHelper.ExitOnError(err, "can't get the reconcile deadline")
+	health.SetReconcileDeadline(reconcileDeadline)
+
 	// Detect OpenShift version
 	ctx := context.TODO()
 	err = ci.Init(ctx, apiClient, logger)
+	health.SetClusterInfoDetected(err)
 	cmdHelper.ExitOnError(err, "Cannot detect cluster type")
 
 	eventEmitter := hcoutil.GetEventEmitter()
 	eventEmitter.Init(ctx, apiClient, mgr.GetEventRecorderFor(hcoutil.HyperConvergedName), logger)
 
-	err = mgr.AddHealthzCheck("ping", healthz.Ping)
+	// the operator is live as long as the reconciliation is not stuck
+	err = mgr.AddHealthzCheck("reconcile", health.LiveCheck)
 	cmdHelper.ExitOnError(err, "unable to add health check")
 
-	readyCheck := hcoutil.GetHcoPing()
-
-	err = mgr.AddReadyzCheck("ready", readyCheck)
+	// the operator is ready when its caches are synced, and the reconciliation does not keep failing
+	health.SetCacheSyncCheck(mgr.GetCache().WaitForCacheSync)
+	err = mgr.AddReadyzCheck("ready", health.ReadyCheck)
 	cmdHelper.ExitOnError(err, "unable to add ready check")
 
 	// Force OperatorCondition Upgradeable to False
@@ -187,6 +196,7 @@ func getNewManagerCache(operatorNamespace string) cache.NewCacheFunc {
 				&cdiv1beta1.CDI{}:                      {},
 				&networkaddonsv1.NetworkAddonsConfig{}: {},
 				&sspv1beta1.SSP{}:                      {},
+				operands.NewEmptyHostPathProvisioner(): {},
 				&schedulingv1.PriorityClass{}: {
 					Label: labels.SelectorFromSet(labels.Set{hcoutil.AppLabel: hcoutil.HyperConvergedName}),
 				},
@@ -215,6 +225,9 @@ func getNewManagerCache(operatorNamespace string) cache.NewCacheFunc {
 				&openshiftroutev1.Route{}: {
 					Field: namespaceSelector,
 				},
+				&appsv1.Deployment{}: {
+					Field: fields.Set{"metadata.namespace": operatorNamespace, "metadata.name": operands.NmoDeploymentName}.AsSelector(),
+				},
 			},
 		},
 	)
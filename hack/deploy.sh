@@ -136,7 +136,8 @@ fi
 "${CMD}" apply $LABEL_SELECTOR_ARG -f _out/cluster_role.yaml
 "${CMD}" apply $LABEL_SELECTOR_ARG -f _out/service_account.yaml
 "${CMD}" apply $LABEL_SELECTOR_ARG -f _out/cluster_role_binding.yaml
-"${CMD}" apply $LABEL_SELECTOR_ARG -f _out/crds/
+# The HyperConverged CRD is too large for the last-applied-configuration annotation of a client-side apply
+"${CMD}" apply --server-side $LABEL_SELECTOR_ARG -f _out/crds/
 
 sleep 20
 if [[ "$(${CMD} get crd ${HCO_CRD_NAME} -o=jsonpath='{.status.conditions[?(@.type=="NonStructuralSchema")].status}')" == "True" ]];
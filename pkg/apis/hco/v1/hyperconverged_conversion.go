@@ -0,0 +1,4 @@
+package v1
+
+// Hub marks the v1 HyperConverged as the conversion hub. All the other versions are converted to and from v1.
+func (*HyperConverged) Hub() {}
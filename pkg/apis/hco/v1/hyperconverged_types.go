@@ -0,0 +1,910 @@
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	sdkapi "kubevirt.io/controller-lifecycle-operator-sdk/pkg/sdk/api"
+	sspv1beta1 "kubevirt.io/ssp-operator/api/v1beta1"
+)
+
+// HyperConvergedName is the name of the HyperConverged resource that will be reconciled
+const HyperConvergedName = "kubevirt-hyperconverged"
+
+// HyperConvergedSpec defines the desired state of HyperConverged
+// +k8s:openapi-gen=true
+type HyperConvergedSpec struct {
+	// infra HyperConvergedConfig influences the pod configuration (currently only placement)
+	// for all the infra components needed on the virtualization enabled cluster
+	// but not necessarely directly on each node running VMs/VMIs.
+	// +optional
+	Infra HyperConvergedConfig `json:"infra,omitempty"`
+
+	// workloads HyperConvergedConfig influences the pod configuration (currently only placement) of components
+	// which need to be running on a node where virtualization workloads should be able to run.
+	// Changes to Workloads HyperConvergedConfig can be applied only without existing workload.
+	// +optional
+	Workloads HyperConvergedConfig `json:"workloads,omitempty"`
+
+	// nodePlacementOverrides allows overriding the infra and the workloads node placement for specific components.
+	// A component with no override, uses the global infra and workloads node placement.
+	// +listType=map
+	// +listMapKey=component
+	// +optional
+	NodePlacementOverrides []HyperConvergedNodePlacementOverride `json:"nodePlacementOverrides,omitempty"`
+
+	// featureGates is a map of feature gate flags. Setting a flag to `true` will enable
+	// the feature. Setting `false` or removing the feature gate, disables the feature.
+	// +kubebuilder:default={"withHostPassthroughCPU": false, "sriovLiveMigration": true, "enableCommonBootImageImport": false}
+	// +optional
+	FeatureGates HyperConvergedFeatureGates `json:"featureGates,omitempty"`
+
+	// certConfig holds the rotation policy for internal, self-signed certificates
+	// +kubebuilder:default={"ca": {"duration": "48h0m0s", "renewBefore": "24h0m0s"}, "server": {"duration": "24h0m0s", "renewBefore": "12h0m0s"}}
+	// +optional
+	CertConfig HyperConvergedCertConfig `json:"certConfig,omitempty"`
+
+	// ResourceRequirements describes the resource requirements for the operand workloads.
+	// +optional
+	ResourceRequirements *OperandResourceRequirements `json:"resourceRequirements,omitempty"`
+
+	// compute contains the configurations of the compute components
+	// +optional
+	Compute HyperConvergedComputeConfig `json:"compute,omitempty"`
+
+	// storage contains the configurations of the storage components
+	// +optional
+	Storage HyperConvergedStorageConfig `json:"storage,omitempty"`
+
+	// network contains the configurations of the network components
+	// +optional
+	Network HyperConvergedNetworkConfig `json:"network,omitempty"`
+
+	// nodeMaintenance contains the configuration of the node maintenance operator
+	// +optional
+	NodeMaintenance *HyperConvergedNodeMaintenanceConfig `json:"nodeMaintenance,omitempty"`
+
+	// Live migration limits and timeouts are applied so that migration processes do not
+	// overwhelm the cluster.
+	// +kubebuilder:default={"completionTimeoutPerGiB": 800, "parallelMigrationsPerCluster": 5, "parallelOutboundMigrationsPerNode": 2, "progressTimeout": 150}
+	// +optional
+	Migration LiveMigrationConfigurations `json:"migration,omitempty"`
+
+	// Overrides is a list of patches to apply on the resources deployed by HCO, for configurations that are not
+	// exposed by the HyperConverged API. Each entry should state why it is needed and who is responsible for it.
+	// +optional
+	// +listType=atomic
+	Overrides []HyperConvergedOverride `json:"overrides,omitempty"`
+
+	// ReconcilePolicies sets how HCO reconciles specific operand CRs. The required state of an operand CR with no
+	// reconcile policy is enforced.
+	// +optional
+	// +listType=map
+	// +listMapKey=kind
+	ReconcilePolicies []OperandReconcilePolicy `json:"reconcilePolicies,omitempty"`
+
+	// UpgradeStrategy configures the rollout of the operands during an upgrade of HCO
+	// +optional
+	UpgradeStrategy *HyperConvergedUpgradeStrategy `json:"upgradeStrategy,omitempty"`
+}
+
+// HyperConvergedComputeConfig contains the configurations of the compute components
+// +k8s:openapi-gen=true
+type HyperConvergedComputeConfig struct {
+	// PermittedHostDevices holds information about devices allowed for passthrough
+	// +optional
+	PermittedHostDevices *PermittedHostDevices `json:"permittedHostDevices,omitempty"`
+
+	// ObsoleteCPUs allows avoiding scheduling of VMs for obsolete CPU models
+	// +optional
+	ObsoleteCPUs *HyperConvergedObsoleteCPUs `json:"obsoleteCPUs,omitempty"`
+
+	// WorkloadUpdateStrategy defines at the cluster level how to handle automated workload updates
+	// +optional
+	WorkloadUpdateStrategy *HyperConvergedWorkloadUpdateStrategy `json:"workloadUpdateStrategy,omitempty"`
+
+	// CommonTemplatesNamespace defines namespace in which common templates will
+	// be deployed. It overrides the default openshift namespace.
+	// +optional
+	CommonTemplatesNamespace *string `json:"commonTemplatesNamespace,omitempty"`
+}
+
+// HyperConvergedStorageConfig contains the configurations of the storage components
+// +k8s:openapi-gen=true
+type HyperConvergedStorageConfig struct {
+	// LocalStorageClassName the name of the local storage class.
+	// +optional
+	LocalStorageClassName string `json:"localStorageClassName,omitempty"`
+
+	// Override the storage class used for scratch space during transfer operations. The scratch space storage class
+	// is determined in the following order:
+	// value of scratchSpaceStorageClass, if that doesn't exist, use the default storage class, if there is no default
+	// storage class, use the storage class of the DataVolume, if no storage class specified, use no storage class for
+	// scratch space
+	// +optional
+	ScratchSpaceStorageClass *string `json:"scratchSpaceStorageClass,omitempty"`
+
+	// VDDK Init Image eventually used to import VMs from external providers
+	// +optional
+	VddkInitImage *string `json:"vddkInitImage,omitempty"`
+
+	// Import contains configuration for importing containerized data
+	// +optional
+	Import *StorageImportConfig `json:"import,omitempty"`
+
+	// DataImportCronTemplates holds list of data import cron templates (golden images)
+	// +optional
+	// +listType=atomic
+	DataImportCronTemplates []sspv1beta1.DataImportCronTemplate `json:"dataImportCronTemplates,omitempty"`
+
+	// HostPathProvisioner contains the configuration of the hostpath provisioner. The hostpath provisioner is deployed
+	// only if this field is set.
+	// +optional
+	HostPathProvisioner *HostPathProvisionerConfig `json:"hostPathProvisioner,omitempty"`
+}
+
+// HyperConvergedNetworkConfig contains the configurations of the network components
+// +k8s:openapi-gen=true
+type HyperConvergedNetworkConfig struct {
+	// DeployOVS deploys the OvS CNI DaemonSet on all the workload nodes
+	// +optional
+	DeployOVS *bool `json:"deployOVS,omitempty"`
+}
+
+// CertRotateConfigCA contains the tunables for TLS certificates.
+// +k8s:openapi-gen=true
+type CertRotateConfigCA struct {
+	// The requested 'duration' (i.e. lifetime) of the Certificate.
+	// This should comply with golang's ParseDuration format (https://golang.org/pkg/time/#ParseDuration)
+	// +kubebuilder:default="48h0m0s"
+	// +optional
+	Duration metav1.Duration `json:"duration,omitempty"`
+
+	// The amount of time before the currently issued certificate's `notAfter`
+	// time that we will begin to attempt to renew the certificate.
+	// This should comply with golang's ParseDuration format (https://golang.org/pkg/time/#ParseDuration)
+	// +kubebuilder:default="24h0m0s"
+	// +optional
+	RenewBefore metav1.Duration `json:"renewBefore,omitempty"`
+}
+
+// CertRotateConfigServer contains the tunables for TLS certificates.
+// +k8s:openapi-gen=true
+type CertRotateConfigServer struct {
+	// The requested 'duration' (i.e. lifetime) of the Certificate.
+	// This should comply with golang's ParseDuration format (https://golang.org/pkg/time/#ParseDuration)
+	// +kubebuilder:default="24h0m0s"
+	// +optional
+	Duration metav1.Duration `json:"duration,omitempty"`
+
+	// The amount of time before the currently issued certificate's `notAfter`
+	// time that we will begin to attempt to renew the certificate.
+	// This should comply with golang's ParseDuration format (https://golang.org/pkg/time/#ParseDuration)
+	// +kubebuilder:default="12h0m0s"
+	// +optional
+	RenewBefore metav1.Duration `json:"renewBefore,omitempty"`
+}
+
+// HyperConvergedCertConfig holds the CertConfig entries for the HCO operands
+// +k8s:openapi-gen=true
+type HyperConvergedCertConfig struct {
+	// CA configuration -
+	// CA certs are kept in the CA bundle as long as they are valid
+	// +kubebuilder:default={"duration": "48h0m0s", "renewBefore": "24h0m0s"}
+	// +optional
+	CA CertRotateConfigCA `json:"ca,omitempty"`
+
+	// Server configuration -
+	// Certs are rotated and discarded
+	// +kubebuilder:default={"duration": "24h0m0s", "renewBefore": "12h0m0s"}
+	// +optional
+	Server CertRotateConfigServer `json:"server,omitempty"`
+}
+
+// HyperConvergedConfig defines a set of configurations to pass to components
+type HyperConvergedConfig struct {
+	// NodePlacement describes node scheduling configuration.
+	// +optional
+	NodePlacement *sdkapi.NodePlacement `json:"nodePlacement,omitempty"`
+}
+
+// HyperConvergedNodePlacementOverride overrides the infra and the workloads node placement of a specific component.
+// An unset field falls back to the global infra or workloads node placement.
+// +k8s:openapi-gen=true
+type HyperConvergedNodePlacementOverride struct {
+	// component is the component whose node placement is overridden; one of compute (KubeVirt), storage (CDI and the
+	// hostpath provisioner), network (cluster network addons) or schedule (SSP)
+	// +kubebuilder:validation:Enum=compute;storage;network;schedule
+	Component string `json:"component"`
+
+	// infra overrides the global infra configuration for the component
+	// +optional
+	Infra HyperConvergedConfig `json:"infra,omitempty"`
+
+	// workloads overrides the global workloads configuration for the component
+	// +optional
+	Workloads HyperConvergedConfig `json:"workloads,omitempty"`
+}
+
+// LiveMigrationConfigurations - Live migration limits and timeouts are applied so that migration processes do not
+// overwhelm the cluster.
+// +k8s:openapi-gen=true
+type LiveMigrationConfigurations struct {
+	// Number of migrations running in parallel in the cluster.
+	// +optional
+	// +kubebuilder:default=5
+	ParallelMigrationsPerCluster *uint32 `json:"parallelMigrationsPerCluster,omitempty"`
+
+	// Maximum number of outbound migrations per node.
+	// +optional
+	// +kubebuilder:default=2
+	ParallelOutboundMigrationsPerNode *uint32 `json:"parallelOutboundMigrationsPerNode,omitempty"`
+
+	// Bandwidth limit of each migration, in MiB/s.
+	// +optional
+	// +kubebuilder:validation:Pattern=^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+	BandwidthPerMigration *string `json:"bandwidthPerMigration,omitempty"`
+
+	// The migration will be canceled if it has not completed in this time, in seconds per GiB
+	// of memory. For example, a virtual machine instance with 6GiB memory will timeout if it has not completed
+	// migration in 4800 seconds. If the Migration Method is BlockMigration, the size of the migrating disks is included
+	// in the calculation.
+	// +kubebuilder:default=800
+	// +optional
+	CompletionTimeoutPerGiB *int64 `json:"completionTimeoutPerGiB,omitempty"`
+
+	// The migration will be canceled if memory copy fails to make progress in this time, in seconds.
+	// +kubebuilder:default=150
+	// +optional
+	ProgressTimeout *int64 `json:"progressTimeout,omitempty"`
+}
+
+// HyperConvergedFeatureGates is a set of optional feature gates to enable or disable new features that are not enabled
+// by default yet.
+// +k8s:openapi-gen=true
+type HyperConvergedFeatureGates struct {
+	// Allow migrating a virtual machine with CPU host-passthrough mode. This should be
+	// enabled only when the Cluster is homogeneous from CPU HW perspective doc here
+	// +optional
+	// +kubebuilder:default=false
+	WithHostPassthroughCPU *bool `json:"withHostPassthroughCPU,omitempty"`
+
+	// Allow migrating a virtual machine with SRIOV interfaces.
+	// +optional
+	// +kubebuilder:default=true
+	SRIOVLiveMigration *bool `json:"sriovLiveMigration,omitempty"`
+
+	// Opt-in to automatic delivery/updates of the common data import cron templates.
+	// There are two sources for the data import cron templates: hard coded list of common templates, and custom
+	// templates that can be added to the dataImportCronTemplates field. This feature gates only control the common
+	// templates. It is possible to use custom templates by adding them to the dataImportCronTemplates field.
+	// +optional
+	// +kubebuilder:default=false
+	EnableCommonBootImageImport *bool `json:"enableCommonBootImageImport,omitempty"`
+}
+
+// PermittedHostDevices holds information about devices allowed for passthrough
+// +k8s:openapi-gen=true
+type PermittedHostDevices struct {
+	// +listType=map
+	// +listMapKey=pciDeviceSelector
+	PciHostDevices []PciHostDevice `json:"pciHostDevices,omitempty"`
+	// +listType=map
+	// +listMapKey=mdevNameSelector
+	MediatedDevices []MediatedHostDevice `json:"mediatedDevices,omitempty"`
+}
+
+// PciHostDevice represents a host PCI device allowed for passthrough
+// +k8s:openapi-gen=true
+type PciHostDevice struct {
+	// a combination of a vendor_id:product_id required to identify a PCI device on a host.
+	PCIDeviceSelector string `json:"pciDeviceSelector"`
+	// name by which a device is advertised and being requested
+	ResourceName string `json:"resourceName"`
+	// indicates that this resource is being provided by an external device plugin
+	// +optional
+	ExternalResourceProvider bool `json:"externalResourceProvider,omitempty"`
+	// HCO enforces the existence of several PciHostDevice objects. Set disabled field to true instead of remove
+	// these objects.
+	// +optional
+	Disabled bool `json:"disabled,omitempty"`
+}
+
+// MediatedHostDevice represents a host mediated device allowed for passthrough
+// +k8s:openapi-gen=true
+type MediatedHostDevice struct {
+	// name of a mediated device type required to identify a mediated device on a host
+	MDEVNameSelector string `json:"mdevNameSelector"`
+	// name by which a device is advertised and being requested
+	ResourceName string `json:"resourceName"`
+	// indicates that this resource is being provided by an external device plugin
+	// +optional
+	ExternalResourceProvider bool `json:"externalResourceProvider,omitempty"`
+	// HCO enforces the existence of several MediatedHostDevice objects. Set disabled field to true instead of remove
+	// these objects.
+	// +optional
+	Disabled bool `json:"disabled,omitempty"`
+}
+
+// OperandResourceRequirements is a list of resource requirements for the operand workloads pods
+// +k8s:openapi-gen=true
+type OperandResourceRequirements struct {
+	// StorageWorkloads defines the resources requirements for storage workloads. It will propagate to the CDI custom
+	// resource
+	// +optional
+	StorageWorkloads *corev1.ResourceRequirements `json:"storageWorkloads,omitempty"`
+}
+
+// HyperConvergedObsoleteCPUs allows avoiding scheduling of VMs for obsolete CPU models
+// +k8s:openapi-gen=true
+type HyperConvergedObsoleteCPUs struct {
+	// MinCPUModel is the Minimum CPU model that is used for basic CPU features; e.g. Penryn or Haswell.
+	// The default value for this field is nil, but in KubeVirt, the default value is "Penryn", if nothing else is set.
+	// Use this field to override KubeVirt default value.
+	// +optional
+	MinCPUModel string `json:"minCPUModel,omitempty"`
+	// CPUModels is a list of obsolete CPU models. When the node-labeller obtains the list of obsolete CPU models, it
+	// eliminates those CPU models and creates labels for valid CPU models.
+	// The default values for this field is nil, however, HCO uses opinionated values, and adding values to this list
+	// will add them to the opinionated values.
+	// +listType=set
+	// +optional
+	CPUModels []string `json:"cpuModels,omitempty"`
+}
+
+// StorageImportConfig contains configuration for importing containerized data
+// +k8s:openapi-gen=true
+type StorageImportConfig struct {
+	// InsecureRegistries is a list of image registries URLs that are not secured. Setting an insecure registry URL
+	// in this list allows pulling images from this registry.
+	// +listType=set
+	// +optional
+	InsecureRegistries []string `json:"insecureRegistries,omitempty"`
+}
+
+// HostPathProvisionerConfig contains the configuration of the hostpath provisioner
+// +k8s:openapi-gen=true
+type HostPathProvisionerConfig struct {
+	// PathConfig describes the location and layout of PV storage on nodes
+	PathConfig HostPathProvisionerPathConfig `json:"pathConfig"`
+
+	// ImagePullPolicy is the pull policy of the hostpath provisioner images
+	// +kubebuilder:validation:Enum=Always;IfNotPresent;Never
+	// +optional
+	ImagePullPolicy corev1.PullPolicy `json:"imagePullPolicy,omitempty"`
+}
+
+// HostPathProvisionerPathConfig describes the location and layout of PV storage on nodes
+// +k8s:openapi-gen=true
+type HostPathProvisionerPathConfig struct {
+	// Path is the path the directories for the PVs are created under
+	Path string `json:"path"`
+
+	// UseNamingPrefix uses the name of the PVC requesting the PV as part of the directory created
+	// +optional
+	UseNamingPrefix bool `json:"useNamingPrefix,omitempty"`
+}
+
+// HyperConvergedNodeMaintenanceConfig contains the configuration of the node maintenance operator
+// +k8s:openapi-gen=true
+type HyperConvergedNodeMaintenanceConfig struct {
+	// NodePlacement describes the node scheduling configuration of the node maintenance operator. It is applied on the
+	// node maintenance operator deployment.
+	// +optional
+	NodePlacement *sdkapi.NodePlacement `json:"nodePlacement,omitempty"`
+}
+
+// HyperConvergedOverride is a patch to apply on one of the resources deployed by HCO
+// +k8s:openapi-gen=true
+type HyperConvergedOverride struct {
+	// Target is the resource to patch
+	Target HyperConvergedOverrideTarget `json:"target"`
+
+	// PatchType is the format of the patch; either a JSON patch (RFC6902) or a strategic merge patch
+	// +kubebuilder:validation:Enum=JSONPatch;StrategicMergePatch
+	PatchType OverridePatchType `json:"patchType"`
+
+	// Patch is the patch to apply on the target resource. Only the spec of an operand CR, or the data of a ConfigMap,
+	// can be modified.
+	// +kubebuilder:validation:MinLength=1
+	Patch string `json:"patch"`
+
+	// Reason describes why the override is needed
+	// +kubebuilder:validation:MinLength=1
+	Reason string `json:"reason"`
+
+	// Owner is the person or the team responsible for the override
+	// +kubebuilder:validation:MinLength=1
+	Owner string `json:"owner"`
+}
+
+// HyperConvergedOverrideTarget identifies a resource deployed by HCO
+// +k8s:openapi-gen=true
+type HyperConvergedOverrideTarget struct {
+	// Kind is the kind of the target resource
+	// +kubebuilder:validation:Enum=KubeVirt;CDI;NetworkAddonsConfig;SSP;HostPathProvisioner;ConfigMap
+	Kind string `json:"kind"`
+
+	// Name is the name of the target resource. It is required for ConfigMaps. The operand CRs are singletons, so the
+	// name is optional for them.
+	// +optional
+	Name string `json:"name,omitempty"`
+}
+
+// OverridePatchType is the format of the patch of an override
+type OverridePatchType string
+
+const (
+	// OverridePatchTypeJSON is a JSON patch, as defined in RFC6902
+	OverridePatchTypeJSON OverridePatchType = "JSONPatch"
+	// OverridePatchTypeStrategicMerge is a strategic merge patch
+	OverridePatchTypeStrategicMerge OverridePatchType = "StrategicMergePatch"
+)
+
+// OverrideState is the result of applying an override
+type OverrideState string
+
+const (
+	// OverrideStateApplied means that the override was applied on its target resource
+	OverrideStateApplied OverrideState = "Applied"
+	// OverrideStateFailed means that the override could not be applied on its target resource
+	OverrideStateFailed OverrideState = "Failed"
+	// OverrideStateIgnored means that none of the resources deployed by HCO matches the override target
+	OverrideStateIgnored OverrideState = "Ignored"
+)
+
+// HyperConvergedOverrideStatus reports the result of applying a spec.overrides entry
+// +k8s:openapi-gen=true
+type HyperConvergedOverrideStatus struct {
+	// Target is the target resource of the override
+	Target HyperConvergedOverrideTarget `json:"target"`
+
+	// State is the result of applying the override
+	State OverrideState `json:"state"`
+
+	// Message describes the reason of a failed or an ignored override
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// OperandReconcilePolicy sets how HCO reconciles an operand CR
+// +k8s:openapi-gen=true
+type OperandReconcilePolicy struct {
+	// Kind is the kind of the operand CR
+	// +kubebuilder:validation:Enum=KubeVirt;CDI;NetworkAddonsConfig;SSP;HostPathProvisioner
+	Kind string `json:"kind"`
+
+	// ReconcilePolicy is how HCO reconciles the operand CR:
+	// Enforce - HCO reverts any out-of-band modification of the operand CR. This is the default.
+	// WarnOnly - HCO reports the out-of-band modifications of the operand CR, but does not revert them. The spec
+	// changes of the HyperConverged CR are not propagated to the operand CR.
+	// Paused - HCO does not create nor modify the operand CR, but still reports its state.
+	// +kubebuilder:validation:Enum=Enforce;WarnOnly;Paused
+	ReconcilePolicy ReconcilePolicy `json:"reconcilePolicy"`
+}
+
+// ReconcilePolicy is how HCO reconciles an operand CR
+type ReconcilePolicy string
+
+const (
+	// ReconcilePolicyEnforce means that HCO reverts any out-of-band modification of the operand CR
+	ReconcilePolicyEnforce ReconcilePolicy = "Enforce"
+	// ReconcilePolicyWarnOnly means that HCO reports the out-of-band modifications of the operand CR, but does not
+	// revert them
+	ReconcilePolicyWarnOnly ReconcilePolicy = "WarnOnly"
+	// ReconcilePolicyPaused means that HCO does not create nor modify the operand CR
+	ReconcilePolicyPaused ReconcilePolicy = "Paused"
+)
+
+//
+// HyperConvergedWorkloadUpdateStrategy defines options related to updating a KubeVirt install
+//
+// +k8s:openapi-gen=true
+type HyperConvergedWorkloadUpdateStrategy struct {
+	// WorkloadUpdateMethods defines the methods that can be used to disrupt workloads
+	// during automated workload updates.
+	// When multiple methods are present, the least disruptive method takes
+	// precedence over more disruptive methods. For example if both LiveMigrate and Evict
+	// methods are listed, only VMs which are not live migratable will be restarted/shutdown.
+	// An empty list defaults to no automated workload updating.
+	//
+	// +listType=atomic
+	// +optional
+	WorkloadUpdateMethods []string `json:"workloadUpdateMethods,omitempty"`
+
+	// BatchEvictionSize Represents the number of VMIs that can be forced updated per
+	// the BatchShutdownInteral interval
+	//
+	// +optional
+	BatchEvictionSize *int `json:"batchEvictionSize,omitempty"`
+
+	// BatchEvictionInterval Represents the interval to wait before issuing the next
+	// batch of shutdowns
+	//
+	// +optional
+	BatchEvictionInterval *metav1.Duration `json:"batchEvictionInterval,omitempty"`
+}
+
+// HyperConvergedUpgradeStrategy configures the rollout of the operands during an upgrade of HCO. The operands are
+// upgraded one by one, in a predefined order; each step waits until the upgraded operand reports the expected version
+// and is available.
+// +k8s:openapi-gen=true
+type HyperConvergedUpgradeStrategy struct {
+	// StepTimeout is the maximum duration of an upgrade step. A step that is not completed in time, fails. If not set,
+	// a step fails only if its operand is degraded.
+	// +optional
+	StepTimeout *metav1.Duration `json:"stepTimeout,omitempty"`
+
+	// HaltOnFailure stops the rollout at a failed step, until the step is completed. If false, the rollout continues
+	// to the next steps; the upgrade is completed only when all the steps are completed.
+	// +kubebuilder:default=true
+	// +optional
+	HaltOnFailure *bool `json:"haltOnFailure,omitempty"`
+}
+
+// UpgradePhase is the phase of an upgrade
+type UpgradePhase string
+
+const (
+	// UpgradePhaseInProgress means that the operands are being upgraded
+	UpgradePhaseInProgress UpgradePhase = "InProgress"
+	// UpgradePhaseHalted means that the rollout is stopped at a failed step
+	UpgradePhaseHalted UpgradePhase = "Halted"
+	// UpgradePhaseCompleted means that all the operands were upgraded
+	UpgradePhaseCompleted UpgradePhase = "Completed"
+)
+
+// UpgradeStepPhase is the phase of an upgrade step
+type UpgradeStepPhase string
+
+const (
+	// UpgradeStepPending means that the operand of the step was not upgraded yet
+	UpgradeStepPending UpgradeStepPhase = "Pending"
+	// UpgradeStepInProgress means that the operand of the step is being upgraded
+	UpgradeStepInProgress UpgradeStepPhase = "InProgress"
+	// UpgradeStepCompleted means that the operand of the step reports the expected version and is available
+	UpgradeStepCompleted UpgradeStepPhase = "Completed"
+	// UpgradeStepSkipped means that the operand of the step is not deployed
+	UpgradeStepSkipped UpgradeStepPhase = "Skipped"
+	// UpgradeStepFailed means that the operand of the step is degraded, or was not upgraded in time
+	UpgradeStepFailed UpgradeStepPhase = "Failed"
+)
+
+// UpgradeStatus reports the progress of an upgrade of HCO
+// +k8s:openapi-gen=true
+type UpgradeStatus struct {
+	// SourceVersion is the HCO version that was upgraded from; empty if it is unknown
+	// +optional
+	SourceVersion string `json:"sourceVersion,omitempty"`
+
+	// TargetVersion is the HCO version to upgrade to
+	TargetVersion string `json:"targetVersion"`
+
+	// Phase is the phase of the upgrade
+	Phase UpgradePhase `json:"phase"`
+
+	// CurrentStep is the name of the first step that is not completed yet; empty if the upgrade is completed
+	// +optional
+	CurrentStep string `json:"currentStep,omitempty"`
+
+	// StartedAt is when the upgrade was started
+	StartedAt metav1.Time `json:"startedAt"`
+
+	// FinishedAt is when the upgrade was completed
+	// +optional
+	FinishedAt *metav1.Time `json:"finishedAt,omitempty"`
+
+	// Steps are the upgrade steps, in their rollout order
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Steps []UpgradeStepStatus `json:"steps,omitempty"`
+
+	// CompletedMigrations are the migrations that were already completed during this upgrade. They are not repeated
+	// if the operator is restarted before the upgrade is completed.
+	// +optional
+	// +listType=set
+	CompletedMigrations []string `json:"completedMigrations,omitempty"`
+}
+
+// UpgradeStepStatus reports the progress of an upgrade step; that is, the upgrade of a single operand
+// +k8s:openapi-gen=true
+type UpgradeStepStatus struct {
+	// Name is the name of the step; that is the kind of its operand CR
+	Name string `json:"name"`
+
+	// Phase is the phase of the step
+	Phase UpgradeStepPhase `json:"phase"`
+
+	// StartedAt is when the step was started
+	// +optional
+	StartedAt *metav1.Time `json:"startedAt,omitempty"`
+
+	// FinishedAt is when the step was completed
+	// +optional
+	FinishedAt *metav1.Time `json:"finishedAt,omitempty"`
+
+	// FailureReason describes why the step failed
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+// HyperConvergedStatus defines the observed state of HyperConverged
+// +k8s:openapi-gen=true
+type HyperConvergedStatus struct {
+	// Conditions describes the state of the HyperConverged resource.
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"  patchStrategy:"merge" patchMergeKey:"type"`
+
+	// RelatedObjects is a list of objects created and maintained by this
+	// operator. Object references will be added to this list after they have
+	// been created AND found in the cluster.
+	// +optional
+	RelatedObjects []corev1.ObjectReference `json:"relatedObjects,omitempty"`
+
+	// Versions is a list of HCO component versions, as name/version pairs. The version with a name of "operator"
+	// is the HCO version itself, as described here:
+	// https://github.com/openshift/cluster-version-operator/blob/master/docs/dev/clusteroperator.md#version
+	// The version of each operand is the version it reports, along with the version that HCO ships.
+	// +optional
+	Versions Versions `json:"versions,omitempty"`
+
+	// ObservedGeneration reflects the HyperConverged resource generation. If the ObservedGeneration is less than the
+	// resource generation in metadata, the status is out of date
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// DataImportSchedule is the cron expression that is used in for the hard-coded data import cron templates. HCO
+	// generates the value of this field once and stored in the status field, so will survive restart.
+	// +optional
+	DataImportSchedule string `json:"dataImportSchedule,omitempty"`
+
+	// Overrides reports the state of the spec.overrides entries, in the same order
+	// +optional
+	// +listType=atomic
+	Overrides []HyperConvergedOverrideStatus `json:"overrides,omitempty"`
+
+	// Components reports the observed state of each one of the operands deployed by HCO
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Components []ComponentStatus `json:"components,omitempty"`
+
+	// DriftReports describes the last out-of-band modifications of the resources deployed by HCO, that HCO has
+	// overwritten; the oldest report first
+	// +optional
+	// +listType=atomic
+	DriftReports []DriftReport `json:"driftReports,omitempty"`
+
+	// Upgrade reports the progress of the last upgrade of HCO
+	// +optional
+	Upgrade *UpgradeStatus `json:"upgrade,omitempty"`
+}
+
+// ComponentStatus is the observed state of an operand deployed by HCO
+// +k8s:openapi-gen=true
+type ComponentStatus struct {
+	// Name is the kind of the operand CR; e.g. KubeVirt or CDI
+	Name string `json:"name"`
+
+	// ObservedVersion is the version reported by the operand
+	// +optional
+	ObservedVersion string `json:"observedVersion,omitempty"`
+
+	// Generation is the generation of the operand CR, as last seen by HCO
+	// +optional
+	Generation int64 `json:"generation,omitempty"`
+
+	// Conditions are the last seen Available, Progressing and Degraded conditions of the operand
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []ComponentCondition `json:"conditions,omitempty"`
+}
+
+// ComponentCondition is a condition of an operand, as reported by the operand
+// +k8s:openapi-gen=true
+type ComponentCondition struct {
+	// Type of the condition; one of Available, Progressing or Degraded
+	Type string `json:"type"`
+
+	// Status of the condition; one of True, False or Unknown
+	Status metav1.ConditionStatus `json:"status"`
+
+	// Reason is the reason of the last transition of the condition, as reported by the operand
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// Message is the message of the last transition of the condition, as reported by the operand
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// LastTransitionTime is the last time the condition changed, as reported by the operand
+	// +optional
+	// +nullable
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+}
+
+// DriftReport describes an out-of-band modification of a resource deployed by HCO, that HCO has overwritten
+// +k8s:openapi-gen=true
+type DriftReport struct {
+	// Kind is the kind of the modified resource
+	Kind string `json:"kind"`
+
+	// Name is the name of the modified resource
+	Name string `json:"name"`
+
+	// Namespace is the namespace of the modified resource; empty for cluster scoped resources
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Time is when HCO has overwritten the modification
+	Time metav1.Time `json:"time"`
+
+	// Changes are the modified fields
+	// +optional
+	// +listType=atomic
+	Changes []DriftChange `json:"changes,omitempty"`
+}
+
+// DriftChange is a single modified field of a resource deployed by HCO
+// +k8s:openapi-gen=true
+type DriftChange struct {
+	// Path is the JSON pointer of the modified field; e.g. /spec/configuration/cpuRequest
+	Path string `json:"path"`
+
+	// Observed is the JSON encoded value of the field, as modified; empty if the field was removed
+	// +optional
+	Observed string `json:"observed,omitempty"`
+
+	// Desired is the JSON encoded value of the field that HCO has restored; empty if HCO has removed the field
+	// +optional
+	Desired string `json:"desired,omitempty"`
+
+	// Manager is the field manager that has modified the field, as recorded in the managedFields of the resource
+	// +optional
+	Manager string `json:"manager,omitempty"`
+}
+
+func (hcs *HyperConvergedStatus) UpdateVersion(name, version string) {
+	if hcs.Versions == nil {
+		hcs.Versions = Versions{}
+	}
+	hcs.Versions.updateVersion(name, version)
+}
+
+// UpdateComponentVersion sets the observed and the expected versions of an operand
+func (hcs *HyperConvergedStatus) UpdateComponentVersion(name, version, expectedVersion string) {
+	if hcs.Versions == nil {
+		hcs.Versions = Versions{}
+	}
+	hcs.Versions.updateVersion(name, version)
+	hcs.Versions.setExpectedVersion(name, expectedVersion)
+}
+
+// RemoveVersion removes the version with the given name, if exists
+func (hcs *HyperConvergedStatus) RemoveVersion(name string) {
+	for i, v := range hcs.Versions {
+		if v.Name == name {
+			hcs.Versions = append(hcs.Versions[:i], hcs.Versions[i+1:]...)
+			return
+		}
+	}
+}
+
+func (hcs *HyperConvergedStatus) GetVersion(name string) (string, bool) {
+	return hcs.Versions.getVersion(name)
+}
+
+type Version struct {
+	Name    string `json:"name,omitempty"`
+	Version string `json:"version,omitempty"`
+	// ExpectedVersion is the version of the component that HCO ships; empty for the "operator" version
+	// +optional
+	ExpectedVersion string `json:"expectedVersion,omitempty"`
+}
+
+func newVersion(name, version string) Version {
+	return Version{Name: name, Version: version}
+}
+
+type Versions []Version
+
+func (vs *Versions) updateVersion(name, version string) {
+	for i, v := range *vs {
+		if v.Name == name {
+			(*vs)[i].Version = version
+			return
+		}
+	}
+	*vs = append(*vs, newVersion(name, version))
+}
+
+func (vs *Versions) setExpectedVersion(name, expectedVersion string) {
+	for i, v := range *vs {
+		if v.Name == name {
+			(*vs)[i].ExpectedVersion = expectedVersion
+			return
+		}
+	}
+}
+
+func (vs *Versions) getVersion(name string) (string, bool) {
+	for _, v := range *vs {
+		if v.Name == name {
+			return v.Version, true
+		}
+	}
+	return "", false
+}
+
+const (
+	ConditionAvailable = "Available"
+
+	// ConditionProgressing indicates that the operator is actively making changes to the resources maintained by the
+	// operator
+	ConditionProgressing = "Progressing"
+
+	// ConditionDegraded indicates that the resources maintained by the operator are not functioning completely.
+	// An example of a degraded state would be if not all pods in a deployment were running.
+	// It may still be available, but it is degraded
+	ConditionDegraded = "Degraded"
+
+	// ConditionUpgradeable indicates whether the resources maintained by the operator are in a state that is safe to upgrade.
+	// When `False`, the resources maintained by the operator should not be upgraded and the
+	// message field should contain a human readable description of what the administrator should do to
+	// allow the operator to successfully update the resources maintained by the operator.
+	ConditionUpgradeable = "Upgradeable"
+
+	// ConditionReconcileComplete communicates the status of the HyperConverged resource's
+	// reconcile functionality. Basically, is the Reconcile function running to completion.
+	ConditionReconcileComplete = "ReconcileComplete"
+
+	// ConditionTaintedConfiguration indicates that a hidden/debug configuration
+	// has been applied to the HyperConverged resource via a specialized annotation.
+	// This condition is exposed only when its value is True, and is otherwise hidden.
+	ConditionTaintedConfiguration = "TaintedConfiguration"
+
+	// ConditionOperandsNotEnforced indicates that the reconcile policy of one or more operand CRs is not Enforce, so
+	// HCO does not revert the out-of-band modifications of these CRs.
+	// This condition is exposed only when its value is True, and is otherwise hidden.
+	ConditionOperandsNotEnforced = "OperandsNotEnforced"
+
+	// ConditionComponentVersionMismatch indicates that one or more operands run a version other than the one that
+	// HCO ships, while no upgrade is in progress.
+	// This condition is exposed only when its value is True, and is otherwise hidden.
+	ConditionComponentVersionMismatch = "ComponentVersionMismatch"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// HyperConverged is the Schema for the hyperconvergeds API
+// +k8s:openapi-gen=true
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:resource:scope=Namespaced,categories={all},shortName={hco,hcos}
+// +kubebuilder:subresource:status
+type HyperConverged struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:default={"certConfig": {"ca": {"duration": "48h0m0s", "renewBefore": "24h0m0s"}, "server": {"duration": "24h0m0s", "renewBefore": "12h0m0s"}}, "featureGates": {"withHostPassthroughCPU": false, "sriovLiveMigration": true, "enableCommonBootImageImport": false}, "migration": {"completionTimeoutPerGiB": 800, "parallelMigrationsPerCluster": 5, "parallelOutboundMigrationsPerNode": 2, "progressTimeout": 150}}
+	// +optional
+	Spec   HyperConvergedSpec   `json:"spec,omitempty"`
+	Status HyperConvergedStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// HyperConvergedList contains a list of HyperConverged
+type HyperConvergedList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []HyperConverged `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&HyperConverged{}, &HyperConvergedList{})
+}
@@ -0,0 +1,935 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by deepcopy-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	v1beta1 "kubevirt.io/ssp-operator/api/v1beta1"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertRotateConfigCA) DeepCopyInto(out *CertRotateConfigCA) {
+	*out = *in
+	out.Duration = in.Duration
+	out.RenewBefore = in.RenewBefore
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertRotateConfigCA.
+func (in *CertRotateConfigCA) DeepCopy() *CertRotateConfigCA {
+	if in == nil {
+		return nil
+	}
+	out := new(CertRotateConfigCA)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertRotateConfigServer) DeepCopyInto(out *CertRotateConfigServer) {
+	*out = *in
+	out.Duration = in.Duration
+	out.RenewBefore = in.RenewBefore
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertRotateConfigServer.
+func (in *CertRotateConfigServer) DeepCopy() *CertRotateConfigServer {
+	if in == nil {
+		return nil
+	}
+	out := new(CertRotateConfigServer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentCondition) DeepCopyInto(out *ComponentCondition) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentCondition.
+func (in *ComponentCondition) DeepCopy() *ComponentCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentStatus) DeepCopyInto(out *ComponentStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]ComponentCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentStatus.
+func (in *ComponentStatus) DeepCopy() *ComponentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DriftChange) DeepCopyInto(out *DriftChange) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DriftChange.
+func (in *DriftChange) DeepCopy() *DriftChange {
+	if in == nil {
+		return nil
+	}
+	out := new(DriftChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DriftReport) DeepCopyInto(out *DriftReport) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	if in.Changes != nil {
+		in, out := &in.Changes, &out.Changes
+		*out = make([]DriftChange, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DriftReport.
+func (in *DriftReport) DeepCopy() *DriftReport {
+	if in == nil {
+		return nil
+	}
+	out := new(DriftReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostPathProvisionerConfig) DeepCopyInto(out *HostPathProvisionerConfig) {
+	*out = *in
+	out.PathConfig = in.PathConfig
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostPathProvisionerConfig.
+func (in *HostPathProvisionerConfig) DeepCopy() *HostPathProvisionerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(HostPathProvisionerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostPathProvisionerPathConfig) DeepCopyInto(out *HostPathProvisionerPathConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostPathProvisionerPathConfig.
+func (in *HostPathProvisionerPathConfig) DeepCopy() *HostPathProvisionerPathConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(HostPathProvisionerPathConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HyperConverged) DeepCopyInto(out *HyperConverged) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HyperConverged.
+func (in *HyperConverged) DeepCopy() *HyperConverged {
+	if in == nil {
+		return nil
+	}
+	out := new(HyperConverged)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HyperConverged) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HyperConvergedCertConfig) DeepCopyInto(out *HyperConvergedCertConfig) {
+	*out = *in
+	out.CA = in.CA
+	out.Server = in.Server
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HyperConvergedCertConfig.
+func (in *HyperConvergedCertConfig) DeepCopy() *HyperConvergedCertConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(HyperConvergedCertConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HyperConvergedComputeConfig) DeepCopyInto(out *HyperConvergedComputeConfig) {
+	*out = *in
+	if in.PermittedHostDevices != nil {
+		in, out := &in.PermittedHostDevices, &out.PermittedHostDevices
+		*out = new(PermittedHostDevices)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ObsoleteCPUs != nil {
+		in, out := &in.ObsoleteCPUs, &out.ObsoleteCPUs
+		*out = new(HyperConvergedObsoleteCPUs)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WorkloadUpdateStrategy != nil {
+		in, out := &in.WorkloadUpdateStrategy, &out.WorkloadUpdateStrategy
+		*out = new(HyperConvergedWorkloadUpdateStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CommonTemplatesNamespace != nil {
+		in, out := &in.CommonTemplatesNamespace, &out.CommonTemplatesNamespace
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HyperConvergedComputeConfig.
+func (in *HyperConvergedComputeConfig) DeepCopy() *HyperConvergedComputeConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(HyperConvergedComputeConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HyperConvergedConfig) DeepCopyInto(out *HyperConvergedConfig) {
+	*out = *in
+	if in.NodePlacement != nil {
+		in, out := &in.NodePlacement, &out.NodePlacement
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HyperConvergedConfig.
+func (in *HyperConvergedConfig) DeepCopy() *HyperConvergedConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(HyperConvergedConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HyperConvergedFeatureGates) DeepCopyInto(out *HyperConvergedFeatureGates) {
+	*out = *in
+	if in.WithHostPassthroughCPU != nil {
+		in, out := &in.WithHostPassthroughCPU, &out.WithHostPassthroughCPU
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SRIOVLiveMigration != nil {
+		in, out := &in.SRIOVLiveMigration, &out.SRIOVLiveMigration
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableCommonBootImageImport != nil {
+		in, out := &in.EnableCommonBootImageImport, &out.EnableCommonBootImageImport
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HyperConvergedFeatureGates.
+func (in *HyperConvergedFeatureGates) DeepCopy() *HyperConvergedFeatureGates {
+	if in == nil {
+		return nil
+	}
+	out := new(HyperConvergedFeatureGates)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HyperConvergedList) DeepCopyInto(out *HyperConvergedList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]HyperConverged, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HyperConvergedList.
+func (in *HyperConvergedList) DeepCopy() *HyperConvergedList {
+	if in == nil {
+		return nil
+	}
+	out := new(HyperConvergedList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HyperConvergedList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HyperConvergedNetworkConfig) DeepCopyInto(out *HyperConvergedNetworkConfig) {
+	*out = *in
+	if in.DeployOVS != nil {
+		in, out := &in.DeployOVS, &out.DeployOVS
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HyperConvergedNetworkConfig.
+func (in *HyperConvergedNetworkConfig) DeepCopy() *HyperConvergedNetworkConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(HyperConvergedNetworkConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HyperConvergedNodeMaintenanceConfig) DeepCopyInto(out *HyperConvergedNodeMaintenanceConfig) {
+	*out = *in
+	if in.NodePlacement != nil {
+		in, out := &in.NodePlacement, &out.NodePlacement
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HyperConvergedNodeMaintenanceConfig.
+func (in *HyperConvergedNodeMaintenanceConfig) DeepCopy() *HyperConvergedNodeMaintenanceConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(HyperConvergedNodeMaintenanceConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HyperConvergedNodePlacementOverride) DeepCopyInto(out *HyperConvergedNodePlacementOverride) {
+	*out = *in
+	in.Infra.DeepCopyInto(&out.Infra)
+	in.Workloads.DeepCopyInto(&out.Workloads)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HyperConvergedNodePlacementOverride.
+func (in *HyperConvergedNodePlacementOverride) DeepCopy() *HyperConvergedNodePlacementOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(HyperConvergedNodePlacementOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HyperConvergedObsoleteCPUs) DeepCopyInto(out *HyperConvergedObsoleteCPUs) {
+	*out = *in
+	if in.CPUModels != nil {
+		in, out := &in.CPUModels, &out.CPUModels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HyperConvergedObsoleteCPUs.
+func (in *HyperConvergedObsoleteCPUs) DeepCopy() *HyperConvergedObsoleteCPUs {
+	if in == nil {
+		return nil
+	}
+	out := new(HyperConvergedObsoleteCPUs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HyperConvergedOverride) DeepCopyInto(out *HyperConvergedOverride) {
+	*out = *in
+	out.Target = in.Target
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HyperConvergedOverride.
+func (in *HyperConvergedOverride) DeepCopy() *HyperConvergedOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(HyperConvergedOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HyperConvergedOverrideStatus) DeepCopyInto(out *HyperConvergedOverrideStatus) {
+	*out = *in
+	out.Target = in.Target
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HyperConvergedOverrideStatus.
+func (in *HyperConvergedOverrideStatus) DeepCopy() *HyperConvergedOverrideStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HyperConvergedOverrideStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HyperConvergedOverrideTarget) DeepCopyInto(out *HyperConvergedOverrideTarget) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HyperConvergedOverrideTarget.
+func (in *HyperConvergedOverrideTarget) DeepCopy() *HyperConvergedOverrideTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(HyperConvergedOverrideTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HyperConvergedSpec) DeepCopyInto(out *HyperConvergedSpec) {
+	*out = *in
+	in.Infra.DeepCopyInto(&out.Infra)
+	in.Workloads.DeepCopyInto(&out.Workloads)
+	if in.NodePlacementOverrides != nil {
+		in, out := &in.NodePlacementOverrides, &out.NodePlacementOverrides
+		*out = make([]HyperConvergedNodePlacementOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.FeatureGates.DeepCopyInto(&out.FeatureGates)
+	out.CertConfig = in.CertConfig
+	if in.ResourceRequirements != nil {
+		in, out := &in.ResourceRequirements, &out.ResourceRequirements
+		*out = new(OperandResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Compute.DeepCopyInto(&out.Compute)
+	in.Storage.DeepCopyInto(&out.Storage)
+	in.Network.DeepCopyInto(&out.Network)
+	if in.NodeMaintenance != nil {
+		in, out := &in.NodeMaintenance, &out.NodeMaintenance
+		*out = new(HyperConvergedNodeMaintenanceConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Migration.DeepCopyInto(&out.Migration)
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = make([]HyperConvergedOverride, len(*in))
+		copy(*out, *in)
+	}
+	if in.ReconcilePolicies != nil {
+		in, out := &in.ReconcilePolicies, &out.ReconcilePolicies
+		*out = make([]OperandReconcilePolicy, len(*in))
+		copy(*out, *in)
+	}
+	if in.UpgradeStrategy != nil {
+		in, out := &in.UpgradeStrategy, &out.UpgradeStrategy
+		*out = new(HyperConvergedUpgradeStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HyperConvergedSpec.
+func (in *HyperConvergedSpec) DeepCopy() *HyperConvergedSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HyperConvergedSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HyperConvergedStatus) DeepCopyInto(out *HyperConvergedStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RelatedObjects != nil {
+		in, out := &in.RelatedObjects, &out.RelatedObjects
+		*out = make([]corev1.ObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.Versions != nil {
+		in, out := &in.Versions, &out.Versions
+		*out = make(Versions, len(*in))
+		copy(*out, *in)
+	}
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = make([]HyperConvergedOverrideStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Components != nil {
+		in, out := &in.Components, &out.Components
+		*out = make([]ComponentStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DriftReports != nil {
+		in, out := &in.DriftReports, &out.DriftReports
+		*out = make([]DriftReport, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Upgrade != nil {
+		in, out := &in.Upgrade, &out.Upgrade
+		*out = new(UpgradeStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HyperConvergedStatus.
+func (in *HyperConvergedStatus) DeepCopy() *HyperConvergedStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HyperConvergedStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HyperConvergedStorageConfig) DeepCopyInto(out *HyperConvergedStorageConfig) {
+	*out = *in
+	if in.ScratchSpaceStorageClass != nil {
+		in, out := &in.ScratchSpaceStorageClass, &out.ScratchSpaceStorageClass
+		*out = new(string)
+		**out = **in
+	}
+	if in.VddkInitImage != nil {
+		in, out := &in.VddkInitImage, &out.VddkInitImage
+		*out = new(string)
+		**out = **in
+	}
+	if in.Import != nil {
+		in, out := &in.Import, &out.Import
+		*out = new(StorageImportConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DataImportCronTemplates != nil {
+		in, out := &in.DataImportCronTemplates, &out.DataImportCronTemplates
+		*out = make([]v1beta1.DataImportCronTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.HostPathProvisioner != nil {
+		in, out := &in.HostPathProvisioner, &out.HostPathProvisioner
+		*out = new(HostPathProvisionerConfig)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HyperConvergedStorageConfig.
+func (in *HyperConvergedStorageConfig) DeepCopy() *HyperConvergedStorageConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(HyperConvergedStorageConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HyperConvergedUpgradeStrategy) DeepCopyInto(out *HyperConvergedUpgradeStrategy) {
+	*out = *in
+	if in.StepTimeout != nil {
+		in, out := &in.StepTimeout, &out.StepTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.HaltOnFailure != nil {
+		in, out := &in.HaltOnFailure, &out.HaltOnFailure
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HyperConvergedUpgradeStrategy.
+func (in *HyperConvergedUpgradeStrategy) DeepCopy() *HyperConvergedUpgradeStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(HyperConvergedUpgradeStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HyperConvergedWorkloadUpdateStrategy) DeepCopyInto(out *HyperConvergedWorkloadUpdateStrategy) {
+	*out = *in
+	if in.WorkloadUpdateMethods != nil {
+		in, out := &in.WorkloadUpdateMethods, &out.WorkloadUpdateMethods
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BatchEvictionSize != nil {
+		in, out := &in.BatchEvictionSize, &out.BatchEvictionSize
+		*out = new(int)
+		**out = **in
+	}
+	if in.BatchEvictionInterval != nil {
+		in, out := &in.BatchEvictionInterval, &out.BatchEvictionInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HyperConvergedWorkloadUpdateStrategy.
+func (in *HyperConvergedWorkloadUpdateStrategy) DeepCopy() *HyperConvergedWorkloadUpdateStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(HyperConvergedWorkloadUpdateStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LiveMigrationConfigurations) DeepCopyInto(out *LiveMigrationConfigurations) {
+	*out = *in
+	if in.ParallelMigrationsPerCluster != nil {
+		in, out := &in.ParallelMigrationsPerCluster, &out.ParallelMigrationsPerCluster
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.ParallelOutboundMigrationsPerNode != nil {
+		in, out := &in.ParallelOutboundMigrationsPerNode, &out.ParallelOutboundMigrationsPerNode
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.BandwidthPerMigration != nil {
+		in, out := &in.BandwidthPerMigration, &out.BandwidthPerMigration
+		*out = new(string)
+		**out = **in
+	}
+	if in.CompletionTimeoutPerGiB != nil {
+		in, out := &in.CompletionTimeoutPerGiB, &out.CompletionTimeoutPerGiB
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ProgressTimeout != nil {
+		in, out := &in.ProgressTimeout, &out.ProgressTimeout
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LiveMigrationConfigurations.
+func (in *LiveMigrationConfigurations) DeepCopy() *LiveMigrationConfigurations {
+	if in == nil {
+		return nil
+	}
+	out := new(LiveMigrationConfigurations)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MediatedHostDevice) DeepCopyInto(out *MediatedHostDevice) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MediatedHostDevice.
+func (in *MediatedHostDevice) DeepCopy() *MediatedHostDevice {
+	if in == nil {
+		return nil
+	}
+	out := new(MediatedHostDevice)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperandReconcilePolicy) DeepCopyInto(out *OperandReconcilePolicy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperandReconcilePolicy.
+func (in *OperandReconcilePolicy) DeepCopy() *OperandReconcilePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(OperandReconcilePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperandResourceRequirements) DeepCopyInto(out *OperandResourceRequirements) {
+	*out = *in
+	if in.StorageWorkloads != nil {
+		in, out := &in.StorageWorkloads, &out.StorageWorkloads
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperandResourceRequirements.
+func (in *OperandResourceRequirements) DeepCopy() *OperandResourceRequirements {
+	if in == nil {
+		return nil
+	}
+	out := new(OperandResourceRequirements)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PciHostDevice) DeepCopyInto(out *PciHostDevice) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PciHostDevice.
+func (in *PciHostDevice) DeepCopy() *PciHostDevice {
+	if in == nil {
+		return nil
+	}
+	out := new(PciHostDevice)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PermittedHostDevices) DeepCopyInto(out *PermittedHostDevices) {
+	*out = *in
+	if in.PciHostDevices != nil {
+		in, out := &in.PciHostDevices, &out.PciHostDevices
+		*out = make([]PciHostDevice, len(*in))
+		copy(*out, *in)
+	}
+	if in.MediatedDevices != nil {
+		in, out := &in.MediatedDevices, &out.MediatedDevices
+		*out = make([]MediatedHostDevice, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PermittedHostDevices.
+func (in *PermittedHostDevices) DeepCopy() *PermittedHostDevices {
+	if in == nil {
+		return nil
+	}
+	out := new(PermittedHostDevices)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageImportConfig) DeepCopyInto(out *StorageImportConfig) {
+	*out = *in
+	if in.InsecureRegistries != nil {
+		in, out := &in.InsecureRegistries, &out.InsecureRegistries
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageImportConfig.
+func (in *StorageImportConfig) DeepCopy() *StorageImportConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageImportConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeStatus) DeepCopyInto(out *UpgradeStatus) {
+	*out = *in
+	in.StartedAt.DeepCopyInto(&out.StartedAt)
+	if in.FinishedAt != nil {
+		in, out := &in.FinishedAt, &out.FinishedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]UpgradeStepStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CompletedMigrations != nil {
+		in, out := &in.CompletedMigrations, &out.CompletedMigrations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeStatus.
+func (in *UpgradeStatus) DeepCopy() *UpgradeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeStepStatus) DeepCopyInto(out *UpgradeStepStatus) {
+	*out = *in
+	if in.StartedAt != nil {
+		in, out := &in.StartedAt, &out.StartedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.FinishedAt != nil {
+		in, out := &in.FinishedAt, &out.FinishedAt
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeStepStatus.
+func (in *UpgradeStepStatus) DeepCopy() *UpgradeStepStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeStepStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Version) DeepCopyInto(out *Version) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Version.
+func (in *Version) DeepCopy() *Version {
+	if in == nil {
+		return nil
+	}
+	out := new(Version)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in Versions) DeepCopyInto(out *Versions) {
+	{
+		in := &in
+		*out = make(Versions, len(*in))
+		copy(*out, *in)
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Versions.
+func (in Versions) DeepCopy() Versions {
+	if in == nil {
+		return nil
+	}
+	out := new(Versions)
+	in.DeepCopyInto(out)
+	return *out
+}
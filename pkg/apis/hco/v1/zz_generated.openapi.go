@@ -0,0 +1,1663 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by openapi-gen. DO NOT EDIT.
+
+// This file was autogenerated by openapi-gen. Do not edit it manually!
+
+package v1
+
+import (
+	spec "github.com/go-openapi/spec"
+	common "k8s.io/kube-openapi/pkg/common"
+)
+
+func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
+	return map[string]common.OpenAPIDefinition{
+		"github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.CertRotateConfigCA":                   schema_pkg_apis_hco_v1_CertRotateConfigCA(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.CertRotateConfigServer":               schema_pkg_apis_hco_v1_CertRotateConfigServer(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.ComponentCondition":                   schema_pkg_apis_hco_v1_ComponentCondition(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.ComponentStatus":                      schema_pkg_apis_hco_v1_ComponentStatus(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.DriftChange":                          schema_pkg_apis_hco_v1_DriftChange(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.DriftReport":                          schema_pkg_apis_hco_v1_DriftReport(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.HostPathProvisionerConfig":            schema_pkg_apis_hco_v1_HostPathProvisionerConfig(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.HostPathProvisionerPathConfig":        schema_pkg_apis_hco_v1_HostPathProvisionerPathConfig(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.HyperConverged":                       schema_pkg_apis_hco_v1_HyperConverged(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.HyperConvergedCertConfig":             schema_pkg_apis_hco_v1_HyperConvergedCertConfig(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.HyperConvergedComputeConfig":          schema_pkg_apis_hco_v1_HyperConvergedComputeConfig(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.HyperConvergedFeatureGates":           schema_pkg_apis_hco_v1_HyperConvergedFeatureGates(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.HyperConvergedNetworkConfig":          schema_pkg_apis_hco_v1_HyperConvergedNetworkConfig(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.HyperConvergedNodeMaintenanceConfig":  schema_pkg_apis_hco_v1_HyperConvergedNodeMaintenanceConfig(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.HyperConvergedNodePlacementOverride":  schema_pkg_apis_hco_v1_HyperConvergedNodePlacementOverride(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.HyperConvergedObsoleteCPUs":           schema_pkg_apis_hco_v1_HyperConvergedObsoleteCPUs(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.HyperConvergedOverride":               schema_pkg_apis_hco_v1_HyperConvergedOverride(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.HyperConvergedOverrideStatus":         schema_pkg_apis_hco_v1_HyperConvergedOverrideStatus(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.HyperConvergedOverrideTarget":         schema_pkg_apis_hco_v1_HyperConvergedOverrideTarget(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.HyperConvergedSpec":                   schema_pkg_apis_hco_v1_HyperConvergedSpec(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.HyperConvergedStatus":                 schema_pkg_apis_hco_v1_HyperConvergedStatus(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.HyperConvergedStorageConfig":          schema_pkg_apis_hco_v1_HyperConvergedStorageConfig(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.HyperConvergedUpgradeStrategy":        schema_pkg_apis_hco_v1_HyperConvergedUpgradeStrategy(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.HyperConvergedWorkloadUpdateStrategy": schema_pkg_apis_hco_v1_HyperConvergedWorkloadUpdateStrategy(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.LiveMigrationConfigurations":          schema_pkg_apis_hco_v1_LiveMigrationConfigurations(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.MediatedHostDevice":                   schema_pkg_apis_hco_v1_MediatedHostDevice(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.OperandReconcilePolicy":               schema_pkg_apis_hco_v1_OperandReconcilePolicy(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.OperandResourceRequirements":          schema_pkg_apis_hco_v1_OperandResourceRequirements(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.PciHostDevice":                        schema_pkg_apis_hco_v1_PciHostDevice(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.PermittedHostDevices":                 schema_pkg_apis_hco_v1_PermittedHostDevices(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.StorageImportConfig":                  schema_pkg_apis_hco_v1_StorageImportConfig(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.UpgradeStatus":                        schema_pkg_apis_hco_v1_UpgradeStatus(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.UpgradeStepStatus":                    schema_pkg_apis_hco_v1_UpgradeStepStatus(ref),
+	}
+}
+
+func schema_pkg_apis_hco_v1_CertRotateConfigCA(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CertRotateConfigCA contains the tunables for TLS certificates.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The requested 'duration' (i.e. lifetime) of the Certificate. This should comply with golang's ParseDuration format (https://golang.org/pkg/time/#ParseDuration)",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"renewBefore": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The amount of time before the currently issued certificate's `notAfter` time that we will begin to attempt to renew the certificate. This should comply with golang's ParseDuration format (https://golang.org/pkg/time/#ParseDuration)",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_hco_v1_CertRotateConfigServer(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CertRotateConfigServer contains the tunables for TLS certificates.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The requested 'duration' (i.e. lifetime) of the Certificate. This should comply with golang's ParseDuration format (https://golang.org/pkg/time/#ParseDuration)",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"renewBefore": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The amount of time before the currently issued certificate's `notAfter` time that we will begin to attempt to renew the certificate. This should comply with golang's ParseDuration format (https://golang.org/pkg/time/#ParseDuration)",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_hco_v1_ComponentCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ComponentCondition is a condition of an operand, as reported by the operand",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type of the condition; one of Available, Progressing or Degraded",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status of the condition; one of True, False or Unknown",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason is the reason of the last transition of the condition, as reported by the operand",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is the message of the last transition of the condition, as reported by the operand",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastTransitionTime is the last time the condition changed, as reported by the operand",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"type", "status"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_hco_v1_ComponentStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ComponentStatus is the observed state of an operand deployed by HCO",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the kind of the operand CR; e.g. KubeVirt or CDI",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"observedVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedVersion is the version reported by the operand",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"generation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Generation is the generation of the operand CR, as last seen by HCO",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"type",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions are the last seen Available, Progressing and Degraded conditions of the operand",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.ComponentCondition"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.ComponentCondition"},
+	}
+}
+
+func schema_pkg_apis_hco_v1_DriftChange(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DriftChange is a single modified field of a resource deployed by HCO",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path is the JSON pointer of the modified field; e.g. /spec/configuration/cpuRequest",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"observed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Observed is the JSON encoded value of the field, as modified; empty if the field was removed",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"desired": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Desired is the JSON encoded value of the field that HCO has restored; empty if HCO has removed the field",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"manager": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Manager is the field manager that has modified the field, as recorded in the managedFields of the resource",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"path"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_hco_v1_DriftReport(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DriftReport describes an out-of-band modification of a resource deployed by HCO, that HCO has overwritten",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is the kind of the modified resource",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the modified resource",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the namespace of the modified resource; empty for cluster scoped resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"time": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Time is when HCO has overwritten the modification",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"changes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Changes are the modified fields",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.DriftChange"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"kind", "name", "time"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.DriftChange", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_hco_v1_HostPathProvisionerConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HostPathProvisionerConfig contains the configuration of the hostpath provisioner",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"pathConfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PathConfig describes the location and layout of PV storage on nodes",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.HostPathProvisionerPathConfig"),
+						},
+					},
+					"imagePullPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImagePullPolicy is the pull policy of the hostpath provisioner images",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"pathConfig"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.HostPathProvisionerPathConfig"},
+	}
+}
+
+func schema_pkg_apis_hco_v1_HostPathProvisionerPathConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HostPathProvisionerPathConfig describes the location and layout of PV storage on nodes",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path is the path the directories for the PVs are created under",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"useNamingPrefix": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UseNamingPrefix uses the name of the PVC requesting the PV as part of the directory created",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"path"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_hco_v1_HyperConverged(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HyperConverged is the Schema for the hyperconvergeds API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.HyperConvergedSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.HyperConvergedStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.HyperConvergedSpec", "github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.HyperConvergedStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_hco_v1_HyperConvergedCertConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HyperConvergedCertConfig holds the CertConfig entries for the HCO operands",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ca": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CA configuration - CA certs are kept in the CA bundle as long as they are valid",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.CertRotateConfigCA"),
+						},
+					},
+					"server": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Server configuration - Certs are rotated and discarded",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.CertRotateConfigServer"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.CertRotateConfigCA", "github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.CertRotateConfigServer"},
+	}
+}
+
+func schema_pkg_apis_hco_v1_HyperConvergedComputeConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HyperConvergedComputeConfig contains the configurations of the compute components",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"permittedHostDevices": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PermittedHostDevices holds information about devices allowed for passthrough",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.PermittedHostDevices"),
+						},
+					},
+					"obsoleteCPUs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObsoleteCPUs allows avoiding scheduling of VMs for obsolete CPU models",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.HyperConvergedObsoleteCPUs"),
+						},
+					},
+					"workloadUpdateStrategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WorkloadUpdateStrategy defines at the cluster level how to handle automated workload updates",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.HyperConvergedWorkloadUpdateStrategy"),
+						},
+					},
+					"commonTemplatesNamespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CommonTemplatesNamespace defines namespace in which common templates will be deployed. It overrides the default openshift namespace.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.HyperConvergedObsoleteCPUs", "github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.HyperConvergedWorkloadUpdateStrategy", "github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.PermittedHostDevices"},
+	}
+}
+
+func schema_pkg_apis_hco_v1_HyperConvergedFeatureGates(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HyperConvergedFeatureGates is a set of optional feature gates to enable or disable new features that are not enabled by default yet.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"withHostPassthroughCPU": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Allow migrating a virtual machine with CPU host-passthrough mode. This should be enabled only when the Cluster is homogeneous from CPU HW perspective doc here",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"sriovLiveMigration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Allow migrating a virtual machine with SRIOV interfaces.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"enableCommonBootImageImport": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Opt-in to automatic delivery/updates of the common data import cron templates. There are two sources for the data import cron templates: hard coded list of common templates, and custom templates that can be added to the dataImportCronTemplates field. This feature gates only control the common templates. It is possible to use custom templates by adding them to the dataImportCronTemplates field.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_hco_v1_HyperConvergedNetworkConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HyperConvergedNetworkConfig contains the configurations of the network components",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"deployOVS": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeployOVS deploys the OvS CNI DaemonSet on all the workload nodes",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_hco_v1_HyperConvergedNodeMaintenanceConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HyperConvergedNodeMaintenanceConfig contains the configuration of the node maintenance operator",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"nodePlacement": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodePlacement describes the node scheduling configuration of the node maintenance operator. It is applied on the node maintenance operator deployment.",
+							Ref:         ref("kubevirt.io/controller-lifecycle-operator-sdk/pkg/sdk/api.NodePlacement"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/controller-lifecycle-operator-sdk/pkg/sdk/api.NodePlacement"},
+	}
+}
+
+func schema_pkg_apis_hco_v1_HyperConvergedNodePlacementOverride(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HyperConvergedNodePlacementOverride overrides the infra and the workloads node placement of a specific component. An unset field falls back to the global infra or workloads node placement.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"component": {
+						SchemaProps: spec.SchemaProps{
+							Description: "component is the component whose node placement is overridden; one of compute (KubeVirt), storage (CDI and the hostpath provisioner), network (cluster network addons) or schedule (SSP)",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"infra": {
+						SchemaProps: spec.SchemaProps{
+							Description: "infra overrides the global infra configuration for the component",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.HyperConvergedConfig"),
+						},
+					},
+					"workloads": {
+						SchemaProps: spec.SchemaProps{
+							Description: "workloads overrides the global workloads configuration for the component",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.HyperConvergedConfig"),
+						},
+					},
+				},
+				Required: []string{"component"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.HyperConvergedConfig"},
+	}
+}
+
+func schema_pkg_apis_hco_v1_HyperConvergedObsoleteCPUs(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HyperConvergedObsoleteCPUs allows avoiding scheduling of VMs for obsolete CPU models",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"minCPUModel": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinCPUModel is the Minimum CPU model that is used for basic CPU features; e.g. Penryn or Haswell. The default value for this field is nil, but in KubeVirt, the default value is \"Penryn\", if nothing else is set. Use this field to override KubeVirt default value.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"cpuModels": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "CPUModels is a list of obsolete CPU models. When the node-labeller obtains the list of obsolete CPU models, it eliminates those CPU models and creates labels for valid CPU models. The default values for this field is nil, however, HCO uses opinionated values, and adding values to this list will add them to the opinionated values.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_hco_v1_HyperConvergedOverride(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HyperConvergedOverride is a patch to apply on one of the resources deployed by HCO",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"target": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Target is the resource to patch",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.HyperConvergedOverrideTarget"),
+						},
+					},
+					"patchType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PatchType is the format of the patch; either a JSON patch (RFC6902) or a strategic merge patch",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"patch": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Patch is the patch to apply on the target resource. Only the spec of an operand CR, or the data of a ConfigMap, can be modified.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason describes why the override is needed",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"owner": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Owner is the person or the team responsible for the override",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"target", "patchType", "patch", "reason", "owner"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.HyperConvergedOverrideTarget"},
+	}
+}
+
+func schema_pkg_apis_hco_v1_HyperConvergedOverrideStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HyperConvergedOverrideStatus reports the result of applying a spec.overrides entry",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"target": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Target is the target resource of the override",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.HyperConvergedOverrideTarget"),
+						},
+					},
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Description: "State is the result of applying the override",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message describes the reason of a failed or an ignored override",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"target", "state"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.HyperConvergedOverrideTarget"},
+	}
+}
+
+func schema_pkg_apis_hco_v1_HyperConvergedOverrideTarget(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HyperConvergedOverrideTarget identifies a resource deployed by HCO",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is the kind of the target resource",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the target resource. It is required for ConfigMaps. The operand CRs are singletons, so the name is optional for them.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"kind"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_hco_v1_HyperConvergedSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HyperConvergedSpec defines the desired state of HyperConverged",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"infra": {
+						SchemaProps: spec.SchemaProps{
+							Description: "infra HyperConvergedConfig influences the pod configuration (currently only placement) for all the infra components needed on the virtualization enabled cluster but not necessarely directly on each node running VMs/VMIs.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.HyperConvergedConfig"),
+						},
+					},
+					"workloads": {
+						SchemaProps: spec.SchemaProps{
+							Description: "workloads HyperConvergedConfig influences the pod configuration (currently only placement) of components which need to be running on a node where virtualization workloads should be able to run. Changes to Workloads HyperConvergedConfig can be applied only without existing workload.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.HyperConvergedConfig"),
+						},
+					},
+					"nodePlacementOverrides": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"component",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "nodePlacementOverrides allows overriding the infra and the workloads node placement for specific components. A component with no override, uses the global infra and workloads node placement.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.HyperConvergedNodePlacementOverride"),
+									},
+								},
+							},
+						},
+					},
+					"featureGates": {
+						SchemaProps: spec.SchemaProps{
+							Description: "featureGates is a map of feature gate flags. Setting a flag to `true` will enable the feature. Setting `false` or removing the feature gate, disables the feature.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.HyperConvergedFeatureGates"),
+						},
+					},
+					"certConfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "certConfig holds the rotation policy for internal, self-signed certificates",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.HyperConvergedCertConfig"),
+						},
+					},
+					"resourceRequirements": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourceRequirements describes the resource requirements for the operand workloads.",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.OperandResourceRequirements"),
+						},
+					},
+					"compute": {
+						SchemaProps: spec.SchemaProps{
+							Description: "compute contains the configurations of the compute components",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.HyperConvergedComputeConfig"),
+						},
+					},
+					"storage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "storage contains the configurations of the storage components",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.HyperConvergedStorageConfig"),
+						},
+					},
+					"network": {
+						SchemaProps: spec.SchemaProps{
+							Description: "network contains the configurations of the network components",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.HyperConvergedNetworkConfig"),
+						},
+					},
+					"nodeMaintenance": {
+						SchemaProps: spec.SchemaProps{
+							Description: "nodeMaintenance contains the configuration of the node maintenance operator",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.HyperConvergedNodeMaintenanceConfig"),
+						},
+					},
+					"migration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Live migration limits and timeouts are applied so that migration processes do not overwhelm the cluster.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.LiveMigrationConfigurations"),
+						},
+					},
+					"overrides": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Overrides is a list of patches to apply on the resources deployed by HCO, for configurations that are not exposed by the HyperConverged API. Each entry should state why it is needed and who is responsible for it.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.HyperConvergedOverride"),
+									},
+								},
+							},
+						},
+					},
+					"reconcilePolicies": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"kind",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "ReconcilePolicies sets how HCO reconciles specific operand CRs. The required state of an operand CR with no reconcile policy is enforced.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.OperandReconcilePolicy"),
+									},
+								},
+							},
+						},
+					},
+					"upgradeStrategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UpgradeStrategy configures the rollout of the operands during an upgrade of HCO",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.HyperConvergedUpgradeStrategy"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.HyperConvergedCertConfig", "github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.HyperConvergedComputeConfig", "github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.HyperConvergedConfig", "github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.HyperConvergedFeatureGates", "github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.HyperConvergedNetworkConfig", "github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.HyperConvergedNodeMaintenanceConfig", "github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.HyperConvergedNodePlacementOverride", "github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.HyperConvergedOverride", "github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.HyperConvergedStorageConfig", "github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.HyperConvergedUpgradeStrategy", "github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.LiveMigrationConfigurations", "github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.OperandReconcilePolicy", "github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.OperandResourceRequirements"},
+	}
+}
+
+func schema_pkg_apis_hco_v1_HyperConvergedStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HyperConvergedStatus defines the observed state of HyperConverged",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "type",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions describes the state of the HyperConverged resource.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"relatedObjects": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RelatedObjects is a list of objects created and maintained by this operator. Object references will be added to this list after they have been created AND found in the cluster.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.ObjectReference"),
+									},
+								},
+							},
+						},
+					},
+					"versions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Versions is a list of HCO component versions, as name/version pairs. The version with a name of \"operator\" is the HCO version itself, as described here: https://github.com/openshift/cluster-version-operator/blob/master/docs/dev/clusteroperator.md#version The version of each operand is the version it reports, along with the version that HCO ships.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.Version"),
+									},
+								},
+							},
+						},
+					},
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration reflects the HyperConverged resource generation. If the ObservedGeneration is less than the resource generation in metadata, the status is out of date",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"dataImportSchedule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DataImportSchedule is the cron expression that is used in for the hard-coded data import cron templates. HCO generates the value of this field once and stored in the status field, so will survive restart.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"overrides": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Overrides reports the state of the spec.overrides entries, in the same order",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.HyperConvergedOverrideStatus"),
+									},
+								},
+							},
+						},
+					},
+					"components": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Components reports the observed state of each one of the operands deployed by HCO",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.ComponentStatus"),
+									},
+								},
+							},
+						},
+					},
+					"driftReports": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "DriftReports describes the last out-of-band modifications of the resources deployed by HCO, that HCO has overwritten; the oldest report first",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.DriftReport"),
+									},
+								},
+							},
+						},
+					},
+					"upgrade": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Upgrade reports the progress of the last upgrade of HCO",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.UpgradeStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.ComponentStatus", "github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.DriftReport", "github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.HyperConvergedOverrideStatus", "github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.UpgradeStatus", "github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.Version", "k8s.io/api/core/v1.ObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Condition"},
+	}
+}
+
+func schema_pkg_apis_hco_v1_HyperConvergedStorageConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HyperConvergedStorageConfig contains the configurations of the storage components",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"localStorageClassName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LocalStorageClassName the name of the local storage class.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"scratchSpaceStorageClass": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Override the storage class used for scratch space during transfer operations. The scratch space storage class is determined in the following order: value of scratchSpaceStorageClass, if that doesn't exist, use the default storage class, if there is no default storage class, use the storage class of the DataVolume, if no storage class specified, use no storage class for scratch space",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"vddkInitImage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VDDK Init Image eventually used to import VMs from external providers",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"import": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Import contains configuration for importing containerized data",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.StorageImportConfig"),
+						},
+					},
+					"dataImportCronTemplates": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "DataImportCronTemplates holds list of data import cron templates (golden images)",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/ssp-operator/api/v1beta1.DataImportCronTemplate"),
+									},
+								},
+							},
+						},
+					},
+					"hostPathProvisioner": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HostPathProvisioner contains the configuration of the hostpath provisioner. The hostpath provisioner is deployed only if this field is set.",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.HostPathProvisionerConfig"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.HostPathProvisionerConfig", "github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.StorageImportConfig", "kubevirt.io/ssp-operator/api/v1beta1.DataImportCronTemplate"},
+	}
+}
+
+func schema_pkg_apis_hco_v1_HyperConvergedUpgradeStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HyperConvergedUpgradeStrategy configures the rollout of the operands during an upgrade of HCO. The operands are upgraded one by one, in a predefined order; each step waits until the upgraded operand reports the expected version and is available.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"stepTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StepTimeout is the maximum duration of an upgrade step. A step that is not completed in time, fails. If not set, a step fails only if its operand is degraded.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"haltOnFailure": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HaltOnFailure stops the rollout at a failed step, until the step is completed. If false, the rollout continues to the next steps; the upgrade is completed only when all the steps are completed.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_hco_v1_HyperConvergedWorkloadUpdateStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HyperConvergedWorkloadUpdateStrategy defines options related to updating a KubeVirt install",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"workloadUpdateMethods": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "WorkloadUpdateMethods defines the methods that can be used to disrupt workloads during automated workload updates. When multiple methods are present, the least disruptive method takes precedence over more disruptive methods. For example if both LiveMigrate and Evict methods are listed, only VMs which are not live migratable will be restarted/shutdown. An empty list defaults to no automated workload updating.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"batchEvictionSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BatchEvictionSize Represents the number of VMIs that can be forced updated per the BatchShutdownInteral interval",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"batchEvictionInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BatchEvictionInterval Represents the interval to wait before issuing the next batch of shutdowns",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_hco_v1_LiveMigrationConfigurations(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LiveMigrationConfigurations - Live migration limits and timeouts are applied so that migration processes do not overwhelm the cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"parallelMigrationsPerCluster": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Number of migrations running in parallel in the cluster.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"parallelOutboundMigrationsPerNode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Maximum number of outbound migrations per node.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"bandwidthPerMigration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Bandwidth limit of each migration, in MiB/s.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"completionTimeoutPerGiB": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The migration will be canceled if it has not completed in this time, in seconds per GiB of memory. For example, a virtual machine instance with 6GiB memory will timeout if it has not completed migration in 4800 seconds. If the Migration Method is BlockMigration, the size of the migrating disks is included in the calculation.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"progressTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The migration will be canceled if memory copy fails to make progress in this time, in seconds.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_hco_v1_MediatedHostDevice(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MediatedHostDevice represents a host mediated device allowed for passthrough",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"mdevNameSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "name of a mediated device type required to identify a mediated device on a host",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"resourceName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "name by which a device is advertised and being requested",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"externalResourceProvider": {
+						SchemaProps: spec.SchemaProps{
+							Description: "indicates that this resource is being provided by an external device plugin",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"disabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HCO enforces the existence of several MediatedHostDevice objects. Set disabled field to true instead of remove these objects.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"mdevNameSelector", "resourceName"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_hco_v1_OperandReconcilePolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OperandReconcilePolicy sets how HCO reconciles an operand CR",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is the kind of the operand CR",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reconcilePolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReconcilePolicy is how HCO reconciles the operand CR: Enforce - HCO reverts any out-of-band modification of the operand CR. This is the default. WarnOnly - HCO reports the out-of-band modifications of the operand CR, but does not revert them. The spec changes of the HyperConverged CR are not propagated to the operand CR. Paused - HCO does not create nor modify the operand CR, but still reports its state.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"kind", "reconcilePolicy"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_hco_v1_OperandResourceRequirements(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OperandResourceRequirements is a list of resource requirements for the operand workloads pods",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"storageWorkloads": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StorageWorkloads defines the resources requirements for storage workloads. It will propagate to the CDI custom resource",
+							Ref:         ref("k8s.io/api/core/v1.ResourceRequirements"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.ResourceRequirements"},
+	}
+}
+
+func schema_pkg_apis_hco_v1_PciHostDevice(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PciHostDevice represents a host PCI device allowed for passthrough",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"pciDeviceSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "a combination of a vendor_id:product_id required to identify a PCI device on a host.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"resourceName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "name by which a device is advertised and being requested",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"externalResourceProvider": {
+						SchemaProps: spec.SchemaProps{
+							Description: "indicates that this resource is being provided by an external device plugin",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"disabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HCO enforces the existence of several PciHostDevice objects. Set disabled field to true instead of remove these objects.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"pciDeviceSelector", "resourceName"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_hco_v1_PermittedHostDevices(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PermittedHostDevices holds information about devices allowed for passthrough",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"pciHostDevices": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"pciDeviceSelector",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.PciHostDevice"),
+									},
+								},
+							},
+						},
+					},
+					"mediatedDevices": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"mdevNameSelector",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.MediatedHostDevice"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.MediatedHostDevice", "github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.PciHostDevice"},
+	}
+}
+
+func schema_pkg_apis_hco_v1_StorageImportConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "StorageImportConfig contains configuration for importing containerized data",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"insecureRegistries": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "InsecureRegistries is a list of image registries URLs that are not secured. Setting an insecure registry URL in this list allows pulling images from this registry.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_hco_v1_UpgradeStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "UpgradeStatus reports the progress of an upgrade of HCO",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"sourceVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SourceVersion is the HCO version that was upgraded from; empty if it is unknown",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"targetVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetVersion is the HCO version to upgrade to",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the phase of the upgrade",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"currentStep": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CurrentStep is the name of the first step that is not completed yet; empty if the upgrade is completed",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"startedAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartedAt is when the upgrade was started",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"finishedAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FinishedAt is when the upgrade was completed",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"steps": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Steps are the upgrade steps, in their rollout order",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.UpgradeStepStatus"),
+									},
+								},
+							},
+						},
+					},
+					"completedMigrations": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "CompletedMigrations are the migrations that were already completed during this upgrade. They are not repeated if the operator is restarted before the upgrade is completed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"targetVersion", "phase", "startedAt"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1.UpgradeStepStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_hco_v1_UpgradeStepStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "UpgradeStepStatus reports the progress of an upgrade step; that is, the upgrade of a single operand",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the step; that is the kind of its operand CR",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the phase of the step",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"startedAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartedAt is when the step was started",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"finishedAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FinishedAt is when the step was completed",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"failureReason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailureReason describes why the step failed",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "phase"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
@@ -0,0 +1,451 @@
+package v1beta1
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1"
+)
+
+// deployOVSAnnotation is the v1beta1 representation of the v1 spec.network.deployOVS field
+const deployOVSAnnotation = "deployOVS"
+
+var _ conversion.Convertible = &HyperConverged{}
+
+// ConvertTo converts this HyperConverged to the hub version (v1)
+func (r *HyperConverged) ConvertTo(dstRaw conversion.Hub) error {
+	dst, ok := dstRaw.(*hcov1.HyperConverged)
+	if !ok {
+		return fmt.Errorf("unexpected conversion hub type %T", dstRaw)
+	}
+
+	src := r.DeepCopy()
+	dst.ObjectMeta = src.ObjectMeta
+
+	if value, found := dst.Annotations[deployOVSAnnotation]; found && (value == "true" || value == "false") {
+		deployOVS := value == "true"
+		dst.Spec.Network.DeployOVS = &deployOVS
+		delete(dst.Annotations, deployOVSAnnotation)
+		if len(dst.Annotations) == 0 {
+			dst.Annotations = nil
+		}
+	} else {
+		dst.Spec.Network.DeployOVS = nil
+	}
+
+	convertSpecToV1(&src.Spec, &dst.Spec)
+	convertStatusToV1(&src.Status, &dst.Status)
+
+	return nil
+}
+
+// ConvertFrom converts from the hub version (v1) to this version
+func (r *HyperConverged) ConvertFrom(srcRaw conversion.Hub) error {
+	hub, ok := srcRaw.(*hcov1.HyperConverged)
+	if !ok {
+		return fmt.Errorf("unexpected conversion hub type %T", srcRaw)
+	}
+
+	src := hub.DeepCopy()
+	r.ObjectMeta = src.ObjectMeta
+
+	if src.Spec.Network.DeployOVS != nil {
+		if r.Annotations == nil {
+			r.Annotations = make(map[string]string)
+		}
+		r.Annotations[deployOVSAnnotation] = fmt.Sprintf("%t", *src.Spec.Network.DeployOVS)
+	}
+
+	convertSpecFromV1(&src.Spec, &r.Spec)
+	convertStatusFromV1(&src.Status, &r.Status)
+
+	return nil
+}
+
+func convertSpecToV1(in *HyperConvergedSpec, out *hcov1.HyperConvergedSpec) {
+	out.Infra = hcov1.HyperConvergedConfig(in.Infra)
+	out.Workloads = hcov1.HyperConvergedConfig(in.Workloads)
+	out.NodePlacementOverrides = nil
+	for _, override := range in.NodePlacementOverrides {
+		out.NodePlacementOverrides = append(out.NodePlacementOverrides, hcov1.HyperConvergedNodePlacementOverride{
+			Component: override.Component,
+			Infra:     hcov1.HyperConvergedConfig(override.Infra),
+			Workloads: hcov1.HyperConvergedConfig(override.Workloads),
+		})
+	}
+	out.FeatureGates = hcov1.HyperConvergedFeatureGates(in.FeatureGates)
+	out.CertConfig = hcov1.HyperConvergedCertConfig{
+		CA:     hcov1.CertRotateConfigCA(in.CertConfig.CA),
+		Server: hcov1.CertRotateConfigServer(in.CertConfig.Server),
+	}
+	out.ResourceRequirements = (*hcov1.OperandResourceRequirements)(in.ResourceRequirements)
+
+	out.Compute = hcov1.HyperConvergedComputeConfig{
+		PermittedHostDevices:     convertPermittedHostDevicesToV1(in.PermittedHostDevices),
+		ObsoleteCPUs:             (*hcov1.HyperConvergedObsoleteCPUs)(in.ObsoleteCPUs),
+		WorkloadUpdateStrategy:   (*hcov1.HyperConvergedWorkloadUpdateStrategy)(in.WorkloadUpdateStrategy),
+		CommonTemplatesNamespace: in.CommonTemplatesNamespace,
+	}
+
+	out.Storage = hcov1.HyperConvergedStorageConfig{
+		LocalStorageClassName:    in.LocalStorageClassName,
+		ScratchSpaceStorageClass: in.ScratchSpaceStorageClass,
+		VddkInitImage:            in.VddkInitImage,
+		Import:                   (*hcov1.StorageImportConfig)(in.StorageImport),
+		DataImportCronTemplates:  in.DataImportCronTemplates,
+	}
+	if in.Storage != nil && in.Storage.HostPathProvisioner != nil {
+		out.Storage.HostPathProvisioner = &hcov1.HostPathProvisionerConfig{
+			PathConfig:      hcov1.HostPathProvisionerPathConfig(in.Storage.HostPathProvisioner.PathConfig),
+			ImagePullPolicy: in.Storage.HostPathProvisioner.ImagePullPolicy,
+		}
+	}
+
+	out.NodeMaintenance = (*hcov1.HyperConvergedNodeMaintenanceConfig)(in.NodeMaintenance)
+
+	out.Migration = hcov1.LiveMigrationConfigurations(in.LiveMigrationConfig)
+
+	out.Overrides = nil
+	if in.Overrides != nil {
+		out.Overrides = make([]hcov1.HyperConvergedOverride, len(in.Overrides))
+		for i, override := range in.Overrides {
+			out.Overrides[i] = hcov1.HyperConvergedOverride{
+				Target:    hcov1.HyperConvergedOverrideTarget(override.Target),
+				PatchType: hcov1.OverridePatchType(override.PatchType),
+				Patch:     override.Patch,
+				Reason:    override.Reason,
+				Owner:     override.Owner,
+			}
+		}
+	}
+
+	out.UpgradeStrategy = (*hcov1.HyperConvergedUpgradeStrategy)(in.UpgradeStrategy)
+
+	out.ReconcilePolicies = nil
+	if in.ReconcilePolicies != nil {
+		out.ReconcilePolicies = make([]hcov1.OperandReconcilePolicy, len(in.ReconcilePolicies))
+		for i, policy := range in.ReconcilePolicies {
+			out.ReconcilePolicies[i] = hcov1.OperandReconcilePolicy{
+				Kind:            policy.Kind,
+				ReconcilePolicy: hcov1.ReconcilePolicy(policy.ReconcilePolicy),
+			}
+		}
+	}
+}
+
+func convertSpecFromV1(in *hcov1.HyperConvergedSpec, out *HyperConvergedSpec) {
+	out.Infra = HyperConvergedConfig(in.Infra)
+	out.Workloads = HyperConvergedConfig(in.Workloads)
+	out.NodePlacementOverrides = nil
+	for _, override := range in.NodePlacementOverrides {
+		out.NodePlacementOverrides = append(out.NodePlacementOverrides, HyperConvergedNodePlacementOverride{
+			Component: override.Component,
+			Infra:     HyperConvergedConfig(override.Infra),
+			Workloads: HyperConvergedConfig(override.Workloads),
+		})
+	}
+	out.FeatureGates = HyperConvergedFeatureGates(in.FeatureGates)
+	out.CertConfig = HyperConvergedCertConfig{
+		CA:     CertRotateConfigCA(in.CertConfig.CA),
+		Server: CertRotateConfigServer(in.CertConfig.Server),
+	}
+	out.ResourceRequirements = (*OperandResourceRequirements)(in.ResourceRequirements)
+
+	out.PermittedHostDevices = convertPermittedHostDevicesFromV1(in.Compute.PermittedHostDevices)
+	out.ObsoleteCPUs = (*HyperConvergedObsoleteCPUs)(in.Compute.ObsoleteCPUs)
+	out.WorkloadUpdateStrategy = (*HyperConvergedWorkloadUpdateStrategy)(in.Compute.WorkloadUpdateStrategy)
+	out.CommonTemplatesNamespace = in.Compute.CommonTemplatesNamespace
+
+	out.LocalStorageClassName = in.Storage.LocalStorageClassName
+	out.ScratchSpaceStorageClass = in.Storage.ScratchSpaceStorageClass
+	out.VddkInitImage = in.Storage.VddkInitImage
+	out.StorageImport = (*StorageImportConfig)(in.Storage.Import)
+	out.DataImportCronTemplates = in.Storage.DataImportCronTemplates
+	out.Storage = nil
+	if in.Storage.HostPathProvisioner != nil {
+		out.Storage = &HyperConvergedStorageConfig{
+			HostPathProvisioner: &HostPathProvisionerConfig{
+				PathConfig:      HostPathProvisionerPathConfig(in.Storage.HostPathProvisioner.PathConfig),
+				ImagePullPolicy: in.Storage.HostPathProvisioner.ImagePullPolicy,
+			},
+		}
+	}
+
+	out.NodeMaintenance = (*HyperConvergedNodeMaintenanceConfig)(in.NodeMaintenance)
+
+	out.LiveMigrationConfig = LiveMigrationConfigurations(in.Migration)
+
+	out.Overrides = nil
+	if in.Overrides != nil {
+		out.Overrides = make([]HyperConvergedOverride, len(in.Overrides))
+		for i, override := range in.Overrides {
+			out.Overrides[i] = HyperConvergedOverride{
+				Target:    HyperConvergedOverrideTarget(override.Target),
+				PatchType: OverridePatchType(override.PatchType),
+				Patch:     override.Patch,
+				Reason:    override.Reason,
+				Owner:     override.Owner,
+			}
+		}
+	}
+
+	out.UpgradeStrategy = (*HyperConvergedUpgradeStrategy)(in.UpgradeStrategy)
+
+	out.ReconcilePolicies = nil
+	if in.ReconcilePolicies != nil {
+		out.ReconcilePolicies = make([]OperandReconcilePolicy, len(in.ReconcilePolicies))
+		for i, policy := range in.ReconcilePolicies {
+			out.ReconcilePolicies[i] = OperandReconcilePolicy{
+				Kind:            policy.Kind,
+				ReconcilePolicy: ReconcilePolicy(policy.ReconcilePolicy),
+			}
+		}
+	}
+}
+
+func convertPermittedHostDevicesToV1(in *PermittedHostDevices) *hcov1.PermittedHostDevices {
+	if in == nil {
+		return nil
+	}
+
+	out := &hcov1.PermittedHostDevices{}
+	if in.PciHostDevices != nil {
+		out.PciHostDevices = make([]hcov1.PciHostDevice, len(in.PciHostDevices))
+		for i, dev := range in.PciHostDevices {
+			out.PciHostDevices[i] = hcov1.PciHostDevice(dev)
+		}
+	}
+	if in.MediatedDevices != nil {
+		out.MediatedDevices = make([]hcov1.MediatedHostDevice, len(in.MediatedDevices))
+		for i, dev := range in.MediatedDevices {
+			out.MediatedDevices[i] = hcov1.MediatedHostDevice(dev)
+		}
+	}
+	return out
+}
+
+func convertPermittedHostDevicesFromV1(in *hcov1.PermittedHostDevices) *PermittedHostDevices {
+	if in == nil {
+		return nil
+	}
+
+	out := &PermittedHostDevices{}
+	if in.PciHostDevices != nil {
+		out.PciHostDevices = make([]PciHostDevice, len(in.PciHostDevices))
+		for i, dev := range in.PciHostDevices {
+			out.PciHostDevices[i] = PciHostDevice(dev)
+		}
+	}
+	if in.MediatedDevices != nil {
+		out.MediatedDevices = make([]MediatedHostDevice, len(in.MediatedDevices))
+		for i, dev := range in.MediatedDevices {
+			out.MediatedDevices[i] = MediatedHostDevice(dev)
+		}
+	}
+	return out
+}
+
+func convertStatusToV1(in *HyperConvergedStatus, out *hcov1.HyperConvergedStatus) {
+	out.Conditions = in.Conditions
+	out.RelatedObjects = in.RelatedObjects
+	out.Versions = nil
+	if in.Versions != nil {
+		out.Versions = make(hcov1.Versions, len(in.Versions))
+		for i, version := range in.Versions {
+			out.Versions[i] = hcov1.Version(version)
+		}
+	}
+	out.ObservedGeneration = in.ObservedGeneration
+	out.DataImportSchedule = in.DataImportSchedule
+	out.Components = convertComponentsToV1(in.Components)
+	out.DriftReports = convertDriftReportsToV1(in.DriftReports)
+	out.Upgrade = convertUpgradeStatusToV1(in.Upgrade)
+	out.Overrides = nil
+	if in.Overrides != nil {
+		out.Overrides = make([]hcov1.HyperConvergedOverrideStatus, len(in.Overrides))
+		for i, status := range in.Overrides {
+			out.Overrides[i] = hcov1.HyperConvergedOverrideStatus{
+				Target:  hcov1.HyperConvergedOverrideTarget(status.Target),
+				State:   hcov1.OverrideState(status.State),
+				Message: status.Message,
+			}
+		}
+	}
+}
+
+func convertStatusFromV1(in *hcov1.HyperConvergedStatus, out *HyperConvergedStatus) {
+	out.Conditions = in.Conditions
+	out.RelatedObjects = in.RelatedObjects
+	out.Versions = nil
+	if in.Versions != nil {
+		out.Versions = make(Versions, len(in.Versions))
+		for i, version := range in.Versions {
+			out.Versions[i] = Version(version)
+		}
+	}
+	out.ObservedGeneration = in.ObservedGeneration
+	out.DataImportSchedule = in.DataImportSchedule
+	out.Components = convertComponentsFromV1(in.Components)
+	out.DriftReports = convertDriftReportsFromV1(in.DriftReports)
+	out.Upgrade = convertUpgradeStatusFromV1(in.Upgrade)
+	out.Overrides = nil
+	if in.Overrides != nil {
+		out.Overrides = make([]HyperConvergedOverrideStatus, len(in.Overrides))
+		for i, status := range in.Overrides {
+			out.Overrides[i] = HyperConvergedOverrideStatus{
+				Target:  HyperConvergedOverrideTarget(status.Target),
+				State:   OverrideState(status.State),
+				Message: status.Message,
+			}
+		}
+	}
+}
+
+func convertComponentsToV1(in []ComponentStatus) []hcov1.ComponentStatus {
+	if in == nil {
+		return nil
+	}
+
+	out := make([]hcov1.ComponentStatus, len(in))
+	for i, component := range in {
+		out[i] = hcov1.ComponentStatus{
+			Name:            component.Name,
+			ObservedVersion: component.ObservedVersion,
+			Generation:      component.Generation,
+		}
+		if component.Conditions != nil {
+			out[i].Conditions = make([]hcov1.ComponentCondition, len(component.Conditions))
+			for j, condition := range component.Conditions {
+				out[i].Conditions[j] = hcov1.ComponentCondition(condition)
+			}
+		}
+	}
+	return out
+}
+
+func convertComponentsFromV1(in []hcov1.ComponentStatus) []ComponentStatus {
+	if in == nil {
+		return nil
+	}
+
+	out := make([]ComponentStatus, len(in))
+	for i, component := range in {
+		out[i] = ComponentStatus{
+			Name:            component.Name,
+			ObservedVersion: component.ObservedVersion,
+			Generation:      component.Generation,
+		}
+		if component.Conditions != nil {
+			out[i].Conditions = make([]ComponentCondition, len(component.Conditions))
+			for j, condition := range component.Conditions {
+				out[i].Conditions[j] = ComponentCondition(condition)
+			}
+		}
+	}
+	return out
+}
+
+func convertDriftReportsToV1(in []DriftReport) []hcov1.DriftReport {
+	if in == nil {
+		return nil
+	}
+
+	out := make([]hcov1.DriftReport, len(in))
+	for i, report := range in {
+		out[i] = hcov1.DriftReport{
+			Kind:      report.Kind,
+			Name:      report.Name,
+			Namespace: report.Namespace,
+			Time:      report.Time,
+		}
+		if report.Changes != nil {
+			out[i].Changes = make([]hcov1.DriftChange, len(report.Changes))
+			for j, change := range report.Changes {
+				out[i].Changes[j] = hcov1.DriftChange(change)
+			}
+		}
+	}
+	return out
+}
+
+func convertDriftReportsFromV1(in []hcov1.DriftReport) []DriftReport {
+	if in == nil {
+		return nil
+	}
+
+	out := make([]DriftReport, len(in))
+	for i, report := range in {
+		out[i] = DriftReport{
+			Kind:      report.Kind,
+			Name:      report.Name,
+			Namespace: report.Namespace,
+			Time:      report.Time,
+		}
+		if report.Changes != nil {
+			out[i].Changes = make([]DriftChange, len(report.Changes))
+			for j, change := range report.Changes {
+				out[i].Changes[j] = DriftChange(change)
+			}
+		}
+	}
+	return out
+}
+
+func convertUpgradeStatusToV1(in *UpgradeStatus) *hcov1.UpgradeStatus {
+	if in == nil {
+		return nil
+	}
+
+	out := &hcov1.UpgradeStatus{
+		SourceVersion:       in.SourceVersion,
+		TargetVersion:       in.TargetVersion,
+		Phase:               hcov1.UpgradePhase(in.Phase),
+		CurrentStep:         in.CurrentStep,
+		StartedAt:           in.StartedAt,
+		FinishedAt:          in.FinishedAt,
+		CompletedMigrations: in.CompletedMigrations,
+	}
+	if in.Steps != nil {
+		out.Steps = make([]hcov1.UpgradeStepStatus, len(in.Steps))
+		for i, step := range in.Steps {
+			out.Steps[i] = hcov1.UpgradeStepStatus{
+				Name:          step.Name,
+				Phase:         hcov1.UpgradeStepPhase(step.Phase),
+				StartedAt:     step.StartedAt,
+				FinishedAt:    step.FinishedAt,
+				FailureReason: step.FailureReason,
+			}
+		}
+	}
+	return out
+}
+
+func convertUpgradeStatusFromV1(in *hcov1.UpgradeStatus) *UpgradeStatus {
+	if in == nil {
+		return nil
+	}
+
+	out := &UpgradeStatus{
+		SourceVersion:       in.SourceVersion,
+		TargetVersion:       in.TargetVersion,
+		Phase:               UpgradePhase(in.Phase),
+		CurrentStep:         in.CurrentStep,
+		StartedAt:           in.StartedAt,
+		FinishedAt:          in.FinishedAt,
+		CompletedMigrations: in.CompletedMigrations,
+	}
+	if in.Steps != nil {
+		out.Steps = make([]UpgradeStepStatus, len(in.Steps))
+		for i, step := range in.Steps {
+			out.Steps[i] = UpgradeStepStatus{
+				Name:          step.Name,
+				Phase:         UpgradeStepPhase(step.Phase),
+				StartedAt:     step.StartedAt,
+				FinishedAt:    step.FinishedAt,
+				FailureReason: step.FailureReason,
+			}
+		}
+	}
+	return out
+}
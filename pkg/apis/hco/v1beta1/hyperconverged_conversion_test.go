@@ -0,0 +1,123 @@
+package v1beta1
+
+import (
+	fuzz "github.com/google/gofuzz"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1"
+)
+
+const fuzzIterations = 1000
+
+var _ = Describe("HyperConverged conversion", func() {
+	fuzzer := fuzz.New().NilChance(0.2).NumElements(1, 3).Funcs(
+		// the conversion webhook sets the TypeMeta of the converted object
+		func(tm *metav1.TypeMeta, _ fuzz.Continue) {},
+		// an empty v1beta1 storage configuration has no representation in v1, and it is equivalent to a missing one
+		func(spec *HyperConvergedSpec, c fuzz.Continue) {
+			c.FuzzNoCustom(spec)
+			if spec.Storage != nil && spec.Storage.HostPathProvisioner == nil {
+				spec.Storage = nil
+			}
+		},
+	)
+
+	It("should convert v1beta1 to v1 and back without losing data", func() {
+		for i := 0; i < fuzzIterations; i++ {
+			orig := &HyperConverged{}
+			fuzzer.Fuzz(orig)
+
+			hub := &hcov1.HyperConverged{}
+			Expect(orig.ConvertTo(hub)).To(Succeed())
+			converted := &HyperConverged{}
+			Expect(converted.ConvertFrom(hub)).To(Succeed())
+
+			Expect(converted).To(Equal(orig))
+		}
+	})
+
+	It("should convert v1 to v1beta1 and back without losing data", func() {
+		for i := 0; i < fuzzIterations; i++ {
+			orig := &hcov1.HyperConverged{}
+			fuzzer.Fuzz(orig)
+
+			spoke := &HyperConverged{}
+			Expect(spoke.ConvertFrom(orig)).To(Succeed())
+			converted := &hcov1.HyperConverged{}
+			Expect(spoke.ConvertTo(converted)).To(Succeed())
+
+			Expect(converted).To(Equal(orig))
+		}
+	})
+
+	It("should not modify the source object", func() {
+		for i := 0; i < fuzzIterations; i++ {
+			orig := &HyperConverged{}
+			fuzzer.Fuzz(orig)
+			src := orig.DeepCopy()
+
+			Expect(src.ConvertTo(&hcov1.HyperConverged{})).To(Succeed())
+			Expect(src).To(Equal(orig))
+		}
+	})
+
+	Context("deployOVS", func() {
+		It("should convert the deployOVS annotation to spec.network.deployOVS", func() {
+			hc := &HyperConverged{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{deployOVSAnnotation: "true", "other": "value"},
+				},
+			}
+
+			hub := &hcov1.HyperConverged{}
+			Expect(hc.ConvertTo(hub)).To(Succeed())
+			Expect(hub.Spec.Network.DeployOVS).ToNot(BeNil())
+			Expect(*hub.Spec.Network.DeployOVS).To(BeTrue())
+			Expect(hub.Annotations).To(Equal(map[string]string{"other": "value"}))
+			Expect(hc.Annotations).To(HaveKeyWithValue(deployOVSAnnotation, "true"))
+		})
+
+		It("should keep a deployOVS annotation with an invalid value", func() {
+			hc := &HyperConverged{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{deployOVSAnnotation: "yes"},
+				},
+			}
+
+			hub := &hcov1.HyperConverged{}
+			Expect(hc.ConvertTo(hub)).To(Succeed())
+			Expect(hub.Spec.Network.DeployOVS).To(BeNil())
+			Expect(hub.Annotations).To(HaveKeyWithValue(deployOVSAnnotation, "yes"))
+		})
+
+		It("should convert spec.network.deployOVS to the deployOVS annotation", func() {
+			deployOVS := false
+			hub := &hcov1.HyperConverged{}
+			hub.Spec.Network.DeployOVS = &deployOVS
+
+			hc := &HyperConverged{}
+			Expect(hc.ConvertFrom(hub)).To(Succeed())
+			Expect(hc.Annotations).To(HaveKeyWithValue(deployOVSAnnotation, "false"))
+		})
+	})
+
+	Context("storage", func() {
+		It("should convert the hostpath provisioner configuration", func() {
+			hc := &HyperConverged{}
+			hc.Spec.LocalStorageClassName = "local"
+			hc.Spec.Storage = &HyperConvergedStorageConfig{
+				HostPathProvisioner: &HostPathProvisionerConfig{
+					PathConfig: HostPathProvisionerPathConfig{Path: "/var/hpvolumes"},
+				},
+			}
+
+			hub := &hcov1.HyperConverged{}
+			Expect(hc.ConvertTo(hub)).To(Succeed())
+			Expect(hub.Spec.Storage.LocalStorageClassName).To(Equal("local"))
+			Expect(hub.Spec.Storage.HostPathProvisioner).ToNot(BeNil())
+			Expect(hub.Spec.Storage.HostPathProvisioner.PathConfig.Path).To(Equal("/var/hpvolumes"))
+		})
+	})
+})
@@ -0,0 +1,91 @@
+package v1beta1
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// The defaults of the HyperConverged spec. The kubebuilder:default markers of the fields must match them; the API
+// server applies the markers to the missing fields, and the defaulter webhook applies these defaults to the fields
+// that are still empty; e.g. a field that a client set to its zero value.
+const (
+	DefaultCADuration        = 48 * time.Hour
+	DefaultCARenewBefore     = 24 * time.Hour
+	DefaultServerDuration    = 24 * time.Hour
+	DefaultServerRenewBefore = 12 * time.Hour
+
+	DefaultParallelMigrationsPerCluster      uint32 = 5
+	DefaultParallelOutboundMigrationsPerNode uint32 = 2
+	DefaultCompletionTimeoutPerGiB           int64  = 800
+	DefaultProgressTimeout                   int64  = 150
+
+	DefaultWithHostPassthroughCPU      = false
+	DefaultSRIOVLiveMigration          = true
+	DefaultEnableCommonBootImageImport = false
+
+	DefaultHaltOnFailure = true
+)
+
+var _ webhook.Defaulter = &HyperConverged{}
+
+// Default implements the defaulter webhook of the HyperConverged CR
+func (r *HyperConverged) Default() {
+	r.Spec.FeatureGates.setDefaults()
+	r.Spec.CertConfig.setDefaults()
+	r.Spec.LiveMigrationConfig.setDefaults()
+
+	if r.Spec.UpgradeStrategy != nil && r.Spec.UpgradeStrategy.HaltOnFailure == nil {
+		haltOnFailure := DefaultHaltOnFailure
+		r.Spec.UpgradeStrategy.HaltOnFailure = &haltOnFailure
+	}
+}
+
+func (fgs *HyperConvergedFeatureGates) setDefaults() {
+	setDefaultFeatureGate(&fgs.WithHostPassthroughCPU, DefaultWithHostPassthroughCPU)
+	setDefaultFeatureGate(&fgs.SRIOVLiveMigration, DefaultSRIOVLiveMigration)
+	setDefaultFeatureGate(&fgs.EnableCommonBootImageImport, DefaultEnableCommonBootImageImport)
+}
+
+func (cc *HyperConvergedCertConfig) setDefaults() {
+	setDefaultDuration(&cc.CA.Duration, DefaultCADuration)
+	setDefaultDuration(&cc.CA.RenewBefore, DefaultCARenewBefore)
+	setDefaultDuration(&cc.Server.Duration, DefaultServerDuration)
+	setDefaultDuration(&cc.Server.RenewBefore, DefaultServerRenewBefore)
+}
+
+func (lmc *LiveMigrationConfigurations) setDefaults() {
+	if lmc.ParallelMigrationsPerCluster == nil {
+		parallelMigrationsPerCluster := DefaultParallelMigrationsPerCluster
+		lmc.ParallelMigrationsPerCluster = &parallelMigrationsPerCluster
+	}
+
+	if lmc.ParallelOutboundMigrationsPerNode == nil {
+		parallelOutboundMigrationsPerNode := DefaultParallelOutboundMigrationsPerNode
+		lmc.ParallelOutboundMigrationsPerNode = &parallelOutboundMigrationsPerNode
+	}
+
+	if lmc.CompletionTimeoutPerGiB == nil {
+		completionTimeoutPerGiB := DefaultCompletionTimeoutPerGiB
+		lmc.CompletionTimeoutPerGiB = &completionTimeoutPerGiB
+	}
+
+	if lmc.ProgressTimeout == nil {
+		progressTimeout := DefaultProgressTimeout
+		lmc.ProgressTimeout = &progressTimeout
+	}
+}
+
+func setDefaultFeatureGate(featureGate **bool, defaultValue bool) {
+	if *featureGate == nil {
+		value := defaultValue
+		*featureGate = &value
+	}
+}
+
+func setDefaultDuration(duration *metav1.Duration, defaultDuration time.Duration) {
+	if duration.Duration == 0 {
+		duration.Duration = defaultDuration
+	}
+}
@@ -0,0 +1,219 @@
+package v1beta1
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ghodss/yaml"
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1"
+)
+
+const crdFile = "../../../../deploy/crds/hco00.crd.yaml"
+
+var _ = Describe("HyperConverged defaults", func() {
+	Context("Default", func() {
+		It("should set the defaults of the empty fields", func() {
+			hc := &HyperConverged{Spec: HyperConvergedSpec{UpgradeStrategy: &HyperConvergedUpgradeStrategy{}}}
+			hc.Default()
+
+			fgs := hc.Spec.FeatureGates
+			Expect(*fgs.WithHostPassthroughCPU).To(Equal(DefaultWithHostPassthroughCPU))
+			Expect(*fgs.SRIOVLiveMigration).To(Equal(DefaultSRIOVLiveMigration))
+			Expect(*fgs.EnableCommonBootImageImport).To(Equal(DefaultEnableCommonBootImageImport))
+
+			Expect(hc.Spec.CertConfig.CA.Duration.Duration).To(Equal(DefaultCADuration))
+			Expect(hc.Spec.CertConfig.CA.RenewBefore.Duration).To(Equal(DefaultCARenewBefore))
+			Expect(hc.Spec.CertConfig.Server.Duration.Duration).To(Equal(DefaultServerDuration))
+			Expect(hc.Spec.CertConfig.Server.RenewBefore.Duration).To(Equal(DefaultServerRenewBefore))
+
+			lmc := hc.Spec.LiveMigrationConfig
+			Expect(*lmc.ParallelMigrationsPerCluster).To(Equal(DefaultParallelMigrationsPerCluster))
+			Expect(*lmc.ParallelOutboundMigrationsPerNode).To(Equal(DefaultParallelOutboundMigrationsPerNode))
+			Expect(*lmc.CompletionTimeoutPerGiB).To(Equal(DefaultCompletionTimeoutPerGiB))
+			Expect(*lmc.ProgressTimeout).To(Equal(DefaultProgressTimeout))
+			Expect(lmc.BandwidthPerMigration).To(BeNil())
+
+			Expect(*hc.Spec.UpgradeStrategy.HaltOnFailure).To(Equal(DefaultHaltOnFailure))
+		})
+
+		It("should keep the values that are set", func() {
+			parallelMigrationsPerCluster := uint32(10)
+			progressTimeout := int64(300)
+			haltOnFailure := false
+			hc := &HyperConverged{
+				Spec: HyperConvergedSpec{
+					FeatureGates: HyperConvergedFeatureGates{
+						WithHostPassthroughCPU: boolPtr(true),
+						SRIOVLiveMigration:     boolPtr(false),
+					},
+					CertConfig: HyperConvergedCertConfig{
+						CA: CertRotateConfigCA{
+							Duration: metav1.Duration{Duration: 96 * time.Hour},
+						},
+						Server: CertRotateConfigServer{
+							RenewBefore: metav1.Duration{Duration: 6 * time.Hour},
+						},
+					},
+					LiveMigrationConfig: LiveMigrationConfigurations{
+						ParallelMigrationsPerCluster: &parallelMigrationsPerCluster,
+						ProgressTimeout:              &progressTimeout,
+					},
+					UpgradeStrategy: &HyperConvergedUpgradeStrategy{HaltOnFailure: &haltOnFailure},
+				},
+			}
+			hc.Default()
+
+			fgs := hc.Spec.FeatureGates
+			Expect(*fgs.WithHostPassthroughCPU).To(BeTrue())
+			Expect(*fgs.SRIOVLiveMigration).To(BeFalse())
+			Expect(*fgs.EnableCommonBootImageImport).To(Equal(DefaultEnableCommonBootImageImport))
+
+			Expect(hc.Spec.CertConfig.CA.Duration.Duration).To(Equal(96 * time.Hour))
+			Expect(hc.Spec.CertConfig.CA.RenewBefore.Duration).To(Equal(DefaultCARenewBefore))
+			Expect(hc.Spec.CertConfig.Server.Duration.Duration).To(Equal(DefaultServerDuration))
+			Expect(hc.Spec.CertConfig.Server.RenewBefore.Duration).To(Equal(6 * time.Hour))
+
+			lmc := hc.Spec.LiveMigrationConfig
+			Expect(*lmc.ParallelMigrationsPerCluster).To(Equal(uint32(10)))
+			Expect(*lmc.ParallelOutboundMigrationsPerNode).To(Equal(DefaultParallelOutboundMigrationsPerNode))
+			Expect(*lmc.CompletionTimeoutPerGiB).To(Equal(DefaultCompletionTimeoutPerGiB))
+			Expect(*lmc.ProgressTimeout).To(Equal(int64(300)))
+
+			Expect(*hc.Spec.UpgradeStrategy.HaltOnFailure).To(BeFalse())
+		})
+
+		It("should not add an upgrade strategy", func() {
+			hc := &HyperConverged{}
+			hc.Default()
+			Expect(hc.Spec.UpgradeStrategy).To(BeNil())
+		})
+	})
+
+	Context("CRD markers", func() {
+		var crd *apiextensionsv1.CustomResourceDefinition
+
+		BeforeEach(func() {
+			content, err := ioutil.ReadFile(crdFile)
+			Expect(err).ToNot(HaveOccurred())
+
+			crd = &apiextensionsv1.CustomResourceDefinition{}
+			Expect(yaml.Unmarshal(content, crd)).To(Succeed())
+		})
+
+		It("should convert between the API versions with the conversion webhook", func() {
+			Expect(crd.Spec.Conversion).ToNot(BeNil())
+			Expect(crd.Spec.Conversion.Strategy).To(Equal(apiextensionsv1.WebhookConverter))
+			Expect(crd.Spec.Conversion.Webhook.ConversionReviewVersions).To(ConsistOf("v1beta1", "v1"))
+			Expect(crd.Spec.Conversion.Webhook.ClientConfig.Service).ToNot(BeNil())
+			Expect(*crd.Spec.Conversion.Webhook.ClientConfig.Service.Path).To(Equal("/convert"))
+			Expect(crd.Annotations).To(HaveKey("cert-manager.io/inject-ca-from"))
+		})
+
+		table.DescribeTable("should match the defaults of the defaulter webhook", func(version string, getDefaults func() runtime.Object) {
+			var schema *apiextensionsv1.JSONSchemaProps
+			for _, crdVersion := range crd.Spec.Versions {
+				if crdVersion.Name == version {
+					schema = crdVersion.Schema.OpenAPIV3Schema
+				}
+			}
+			Expect(schema).ToNot(BeNil(), "API version %s is not in the CRD", version)
+
+			content, err := json.Marshal(getDefaults())
+			Expect(err).ToNot(HaveOccurred())
+			var defaults map[string]interface{}
+			Expect(json.Unmarshal(content, &defaults)).To(Succeed())
+
+			markers := make(map[string]interface{})
+			collectDefaultMarkers(schema, "", markers)
+			Expect(markers).To(HaveKey(".spec"))
+
+			paths := make([]string, 0, len(markers))
+			for path := range markers {
+				paths = append(paths, path)
+			}
+			sort.Strings(paths)
+
+			for _, path := range paths {
+				actual, found := getValueByPath(defaults, path)
+				Expect(found).To(BeTrue(), "%s has a default marker, but no default value", path)
+				assertDefaultMarker(path, markers[path], actual)
+			}
+		},
+			table.Entry("v1beta1", "v1beta1", func() runtime.Object {
+				return getDefaultHyperConverged()
+			}),
+			table.Entry("v1", "v1", func() runtime.Object {
+				hc := &hcov1.HyperConverged{}
+				Expect(getDefaultHyperConverged().ConvertTo(hc)).To(Succeed())
+				return hc
+			}),
+		)
+	})
+})
+
+// getDefaultHyperConverged returns a HyperConverged CR with all the defaults; the upgrade strategy is set, to get the
+// defaults of its fields
+func getDefaultHyperConverged() *HyperConverged {
+	hc := &HyperConverged{
+		Spec: HyperConvergedSpec{
+			UpgradeStrategy: &HyperConvergedUpgradeStrategy{},
+		},
+	}
+	hc.Default()
+	return hc
+}
+
+// collectDefaultMarkers collects the default values of the properties of the schema, by their paths
+func collectDefaultMarkers(schema *apiextensionsv1.JSONSchemaProps, path string, markers map[string]interface{}) {
+	if schema.Default != nil {
+		var value interface{}
+		ExpectWithOffset(1, json.Unmarshal(schema.Default.Raw, &value)).To(Succeed())
+		markers[path] = value
+	}
+
+	for name, prop := range schema.Properties {
+		prop := prop
+		collectDefaultMarkers(&prop, path+"."+name, markers)
+	}
+}
+
+func getValueByPath(obj map[string]interface{}, path string) (interface{}, bool) {
+	var value interface{} = obj
+	for _, name := range strings.Split(strings.TrimPrefix(path, "."), ".") {
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if value, ok = m[name]; !ok {
+			return nil, false
+		}
+	}
+	return value, true
+}
+
+// assertDefaultMarker checks that the default marker of an object matches the defaults of the fields it sets, and that
+// the default marker of a field matches its default
+func assertDefaultMarker(path string, marker, actual interface{}) {
+	markerObj, ok := marker.(map[string]interface{})
+	if !ok {
+		ExpectWithOffset(1, marker).To(Equal(actual), "the default marker of %s does not match the default", path)
+		return
+	}
+
+	actualObj, ok := actual.(map[string]interface{})
+	ExpectWithOffset(1, ok).To(BeTrue(), "the default of %s is not an object", path)
+	for name, value := range markerObj {
+		ExpectWithOffset(1, actualObj).To(HaveKey(name), "the default marker of %s sets %s, that has no default", path, name)
+		assertDefaultMarker(path+"."+name, value, actualObj[name])
+	}
+}
@@ -37,6 +37,13 @@ type HyperConvergedSpec struct {
 	// +optional
 	Workloads HyperConvergedConfig `json:"workloads,omitempty"`
 
+	// nodePlacementOverrides allows overriding the infra and the workloads node placement for specific components.
+	// A component with no override, uses the global infra and workloads node placement.
+	// +listType=map
+	// +listMapKey=component
+	// +optional
+	NodePlacementOverrides []HyperConvergedNodePlacementOverride `json:"nodePlacementOverrides,omitempty"`
+
 	// featureGates is a map of feature gate flags. Setting a flag to `true` will enable
 	// the feature. Setting `false` or removing the feature gate, disables the feature.
 	// +kubebuilder:default={"withHostPassthroughCPU": false, "sriovLiveMigration": true, "enableCommonBootImageImport": false}
@@ -95,6 +102,31 @@ type HyperConvergedSpec struct {
 	// +optional
 	// +listType=atomic
 	DataImportCronTemplates []sspv1beta1.DataImportCronTemplate `json:"dataImportCronTemplates,omitempty"`
+
+	// Storage contains configurations for the storage components deployed by HCO
+	// +optional
+	Storage *HyperConvergedStorageConfig `json:"storage,omitempty"`
+
+	// NodeMaintenance contains the configuration of the node maintenance operator
+	// +optional
+	NodeMaintenance *HyperConvergedNodeMaintenanceConfig `json:"nodeMaintenance,omitempty"`
+
+	// Overrides is a list of patches to apply on the resources deployed by HCO, for configurations that are not
+	// exposed by the HyperConverged API. Each entry should state why it is needed and who is responsible for it.
+	// +optional
+	// +listType=atomic
+	Overrides []HyperConvergedOverride `json:"overrides,omitempty"`
+
+	// ReconcilePolicies sets how HCO reconciles specific operand CRs. The required state of an operand CR with no
+	// reconcile policy is enforced.
+	// +optional
+	// +listType=map
+	// +listMapKey=kind
+	ReconcilePolicies []OperandReconcilePolicy `json:"reconcilePolicies,omitempty"`
+
+	// UpgradeStrategy configures the rollout of the operands during an upgrade of HCO
+	// +optional
+	UpgradeStrategy *HyperConvergedUpgradeStrategy `json:"upgradeStrategy,omitempty"`
 }
 
 // CertRotateConfigCA contains the tunables for TLS certificates.
@@ -154,6 +186,24 @@ type HyperConvergedConfig struct {
 	NodePlacement *sdkapi.NodePlacement `json:"nodePlacement,omitempty"`
 }
 
+// HyperConvergedNodePlacementOverride overrides the infra and the workloads node placement of a specific component.
+// An unset field falls back to the global infra or workloads node placement.
+// +k8s:openapi-gen=true
+type HyperConvergedNodePlacementOverride struct {
+	// component is the component whose node placement is overridden; one of compute (KubeVirt), storage (CDI and the
+	// hostpath provisioner), network (cluster network addons) or schedule (SSP)
+	// +kubebuilder:validation:Enum=compute;storage;network;schedule
+	Component string `json:"component"`
+
+	// infra overrides the global infra configuration for the component
+	// +optional
+	Infra HyperConvergedConfig `json:"infra,omitempty"`
+
+	// workloads overrides the global workloads configuration for the component
+	// +optional
+	Workloads HyperConvergedConfig `json:"workloads,omitempty"`
+}
+
 // LiveMigrationConfigurations - Live migration limits and timeouts are applied so that migration processes do not
 // overwhelm the cluster.
 // +k8s:openapi-gen=true
@@ -195,12 +245,12 @@ type HyperConvergedFeatureGates struct {
 	// enabled only when the Cluster is homogeneous from CPU HW perspective doc here
 	// +optional
 	// +kubebuilder:default=false
-	WithHostPassthroughCPU bool `json:"withHostPassthroughCPU"`
+	WithHostPassthroughCPU *bool `json:"withHostPassthroughCPU,omitempty"`
 
 	// Allow migrating a virtual machine with SRIOV interfaces.
 	// +optional
 	// +kubebuilder:default=true
-	SRIOVLiveMigration bool `json:"sriovLiveMigration"`
+	SRIOVLiveMigration *bool `json:"sriovLiveMigration,omitempty"`
 
 	// Opt-in to automatic delivery/updates of the common data import cron templates.
 	// There are two sources for the data import cron templates: hard coded list of common templates, and custom
@@ -208,7 +258,28 @@ type HyperConvergedFeatureGates struct {
 	// templates. It is possible to use custom templates by adding them to the dataImportCronTemplates field.
 	// +optional
 	// +kubebuilder:default=false
-	EnableCommonBootImageImport bool `json:"enableCommonBootImageImport"`
+	EnableCommonBootImageImport *bool `json:"enableCommonBootImageImport,omitempty"`
+}
+
+// IsWithHostPassthroughCPUEnabled returns true if the withHostPassthroughCPU feature gate is enabled
+func (fgs HyperConvergedFeatureGates) IsWithHostPassthroughCPUEnabled() bool {
+	return isFeatureGateEnabled(fgs.WithHostPassthroughCPU)
+}
+
+// IsSRIOVLiveMigrationEnabled returns true if the sriovLiveMigration feature gate is enabled
+func (fgs HyperConvergedFeatureGates) IsSRIOVLiveMigrationEnabled() bool {
+	return isFeatureGateEnabled(fgs.SRIOVLiveMigration)
+}
+
+// IsEnableCommonBootImageImportEnabled returns true if the enableCommonBootImageImport feature gate is enabled
+func (fgs HyperConvergedFeatureGates) IsEnableCommonBootImageImportEnabled() bool {
+	return isFeatureGateEnabled(fgs.EnableCommonBootImageImport)
+}
+
+// isFeatureGateEnabled returns true if the feature gate is set to true. The feature gates are pointers, so the defaulter
+// webhook can tell a missing feature gate apart from a disabled one; a feature gate that was not defaulted is disabled.
+func isFeatureGateEnabled(featureGate *bool) bool {
+	return featureGate != nil && *featureGate
 }
 
 // PermittedHostDevices holds information about devices allowed for passthrough
@@ -290,6 +361,149 @@ type StorageImportConfig struct {
 	InsecureRegistries []string `json:"insecureRegistries,omitempty"`
 }
 
+// HyperConvergedStorageConfig contains configurations for the storage components deployed by HCO
+// +k8s:openapi-gen=true
+type HyperConvergedStorageConfig struct {
+	// HostPathProvisioner configures the hostpath provisioner. When set, HCO deploys a HostPathProvisioner custom
+	// resource; when removed, HCO removes the HostPathProvisioner custom resource it had deployed.
+	// +optional
+	HostPathProvisioner *HostPathProvisionerConfig `json:"hostPathProvisioner,omitempty"`
+}
+
+// HostPathProvisionerConfig contains the configuration of the hostpath provisioner
+// +k8s:openapi-gen=true
+type HostPathProvisionerConfig struct {
+	// PathConfig describes the location and layout of PV storage on nodes
+	PathConfig HostPathProvisionerPathConfig `json:"pathConfig"`
+
+	// ImagePullPolicy is the pull policy of the hostpath provisioner images
+	// +kubebuilder:validation:Enum=Always;IfNotPresent;Never
+	// +optional
+	ImagePullPolicy corev1.PullPolicy `json:"imagePullPolicy,omitempty"`
+}
+
+// HostPathProvisionerPathConfig describes the location and layout of PV storage on nodes
+// +k8s:openapi-gen=true
+type HostPathProvisionerPathConfig struct {
+	// Path is the path the directories for the PVs are created under
+	Path string `json:"path"`
+
+	// UseNamingPrefix uses the name of the PVC requesting the PV as part of the directory created
+	// +optional
+	UseNamingPrefix bool `json:"useNamingPrefix,omitempty"`
+}
+
+// HyperConvergedNodeMaintenanceConfig contains the configuration of the node maintenance operator
+// +k8s:openapi-gen=true
+type HyperConvergedNodeMaintenanceConfig struct {
+	// NodePlacement describes the node scheduling configuration of the node maintenance operator. It is applied on the
+	// node maintenance operator deployment.
+	// +optional
+	NodePlacement *sdkapi.NodePlacement `json:"nodePlacement,omitempty"`
+}
+
+// HyperConvergedOverride is a patch to apply on one of the resources deployed by HCO
+// +k8s:openapi-gen=true
+type HyperConvergedOverride struct {
+	// Target is the resource to patch
+	Target HyperConvergedOverrideTarget `json:"target"`
+
+	// PatchType is the format of the patch; either a JSON patch (RFC6902) or a strategic merge patch
+	// +kubebuilder:validation:Enum=JSONPatch;StrategicMergePatch
+	PatchType OverridePatchType `json:"patchType"`
+
+	// Patch is the patch to apply on the target resource. Only the spec of an operand CR, or the data of a ConfigMap,
+	// can be modified.
+	// +kubebuilder:validation:MinLength=1
+	Patch string `json:"patch"`
+
+	// Reason describes why the override is needed
+	// +kubebuilder:validation:MinLength=1
+	Reason string `json:"reason"`
+
+	// Owner is the person or the team responsible for the override
+	// +kubebuilder:validation:MinLength=1
+	Owner string `json:"owner"`
+}
+
+// HyperConvergedOverrideTarget identifies a resource deployed by HCO
+// +k8s:openapi-gen=true
+type HyperConvergedOverrideTarget struct {
+	// Kind is the kind of the target resource
+	// +kubebuilder:validation:Enum=KubeVirt;CDI;NetworkAddonsConfig;SSP;HostPathProvisioner;ConfigMap
+	Kind string `json:"kind"`
+
+	// Name is the name of the target resource. It is required for ConfigMaps. The operand CRs are singletons, so the
+	// name is optional for them.
+	// +optional
+	Name string `json:"name,omitempty"`
+}
+
+// OverridePatchType is the format of the patch of an override
+type OverridePatchType string
+
+const (
+	// OverridePatchTypeJSON is a JSON patch, as defined in RFC6902
+	OverridePatchTypeJSON OverridePatchType = "JSONPatch"
+	// OverridePatchTypeStrategicMerge is a strategic merge patch
+	OverridePatchTypeStrategicMerge OverridePatchType = "StrategicMergePatch"
+)
+
+// OverrideState is the result of applying an override
+type OverrideState string
+
+const (
+	// OverrideStateApplied means that the override was applied on its target resource
+	OverrideStateApplied OverrideState = "Applied"
+	// OverrideStateFailed means that the override could not be applied on its target resource
+	OverrideStateFailed OverrideState = "Failed"
+	// OverrideStateIgnored means that none of the resources deployed by HCO matches the override target
+	OverrideStateIgnored OverrideState = "Ignored"
+)
+
+// HyperConvergedOverrideStatus reports the result of applying a spec.overrides entry
+// +k8s:openapi-gen=true
+type HyperConvergedOverrideStatus struct {
+	// Target is the target resource of the override
+	Target HyperConvergedOverrideTarget `json:"target"`
+
+	// State is the result of applying the override
+	State OverrideState `json:"state"`
+
+	// Message describes the reason of a failed or an ignored override
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// OperandReconcilePolicy sets how HCO reconciles an operand CR
+// +k8s:openapi-gen=true
+type OperandReconcilePolicy struct {
+	// Kind is the kind of the operand CR
+	// +kubebuilder:validation:Enum=KubeVirt;CDI;NetworkAddonsConfig;SSP;HostPathProvisioner
+	Kind string `json:"kind"`
+
+	// ReconcilePolicy is how HCO reconciles the operand CR:
+	// Enforce - HCO reverts any out-of-band modification of the operand CR. This is the default.
+	// WarnOnly - HCO reports the out-of-band modifications of the operand CR, but does not revert them. The spec
+	// changes of the HyperConverged CR are not propagated to the operand CR.
+	// Paused - HCO does not create nor modify the operand CR, but still reports its state.
+	// +kubebuilder:validation:Enum=Enforce;WarnOnly;Paused
+	ReconcilePolicy ReconcilePolicy `json:"reconcilePolicy"`
+}
+
+// ReconcilePolicy is how HCO reconciles an operand CR
+type ReconcilePolicy string
+
+const (
+	// ReconcilePolicyEnforce means that HCO reverts any out-of-band modification of the operand CR
+	ReconcilePolicyEnforce ReconcilePolicy = "Enforce"
+	// ReconcilePolicyWarnOnly means that HCO reports the out-of-band modifications of the operand CR, but does not
+	// revert them
+	ReconcilePolicyWarnOnly ReconcilePolicy = "WarnOnly"
+	// ReconcilePolicyPaused means that HCO does not create nor modify the operand CR
+	ReconcilePolicyPaused ReconcilePolicy = "Paused"
+)
+
 //
 // HyperConvergedWorkloadUpdateStrategy defines options related to updating a KubeVirt install
 //
@@ -319,6 +533,110 @@ type HyperConvergedWorkloadUpdateStrategy struct {
 	BatchEvictionInterval *metav1.Duration `json:"batchEvictionInterval,omitempty"`
 }
 
+// HyperConvergedUpgradeStrategy configures the rollout of the operands during an upgrade of HCO. The operands are
+// upgraded one by one, in a predefined order; each step waits until the upgraded operand reports the expected version
+// and is available.
+// +k8s:openapi-gen=true
+type HyperConvergedUpgradeStrategy struct {
+	// StepTimeout is the maximum duration of an upgrade step. A step that is not completed in time, fails. If not set,
+	// a step fails only if its operand is degraded.
+	// +optional
+	StepTimeout *metav1.Duration `json:"stepTimeout,omitempty"`
+
+	// HaltOnFailure stops the rollout at a failed step, until the step is completed. If false, the rollout continues
+	// to the next steps; the upgrade is completed only when all the steps are completed.
+	// +kubebuilder:default=true
+	// +optional
+	HaltOnFailure *bool `json:"haltOnFailure,omitempty"`
+}
+
+// UpgradePhase is the phase of an upgrade
+type UpgradePhase string
+
+const (
+	// UpgradePhaseInProgress means that the operands are being upgraded
+	UpgradePhaseInProgress UpgradePhase = "InProgress"
+	// UpgradePhaseHalted means that the rollout is stopped at a failed step
+	UpgradePhaseHalted UpgradePhase = "Halted"
+	// UpgradePhaseCompleted means that all the operands were upgraded
+	UpgradePhaseCompleted UpgradePhase = "Completed"
+)
+
+// UpgradeStepPhase is the phase of an upgrade step
+type UpgradeStepPhase string
+
+const (
+	// UpgradeStepPending means that the operand of the step was not upgraded yet
+	UpgradeStepPending UpgradeStepPhase = "Pending"
+	// UpgradeStepInProgress means that the operand of the step is being upgraded
+	UpgradeStepInProgress UpgradeStepPhase = "InProgress"
+	// UpgradeStepCompleted means that the operand of the step reports the expected version and is available
+	UpgradeStepCompleted UpgradeStepPhase = "Completed"
+	// UpgradeStepSkipped means that the operand of the step is not deployed
+	UpgradeStepSkipped UpgradeStepPhase = "Skipped"
+	// UpgradeStepFailed means that the operand of the step is degraded, or was not upgraded in time
+	UpgradeStepFailed UpgradeStepPhase = "Failed"
+)
+
+// UpgradeStatus reports the progress of an upgrade of HCO
+// +k8s:openapi-gen=true
+type UpgradeStatus struct {
+	// SourceVersion is the HCO version that was upgraded from; empty if it is unknown
+	// +optional
+	SourceVersion string `json:"sourceVersion,omitempty"`
+
+	// TargetVersion is the HCO version to upgrade to
+	TargetVersion string `json:"targetVersion"`
+
+	// Phase is the phase of the upgrade
+	Phase UpgradePhase `json:"phase"`
+
+	// CurrentStep is the name of the first step that is not completed yet; empty if the upgrade is completed
+	// +optional
+	CurrentStep string `json:"currentStep,omitempty"`
+
+	// StartedAt is when the upgrade was started
+	StartedAt metav1.Time `json:"startedAt"`
+
+	// FinishedAt is when the upgrade was completed
+	// +optional
+	FinishedAt *metav1.Time `json:"finishedAt,omitempty"`
+
+	// Steps are the upgrade steps, in their rollout order
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Steps []UpgradeStepStatus `json:"steps,omitempty"`
+
+	// CompletedMigrations are the migrations that were already completed during this upgrade. They are not repeated
+	// if the operator is restarted before the upgrade is completed.
+	// +optional
+	// +listType=set
+	CompletedMigrations []string `json:"completedMigrations,omitempty"`
+}
+
+// UpgradeStepStatus reports the progress of an upgrade step; that is, the upgrade of a single operand
+// +k8s:openapi-gen=true
+type UpgradeStepStatus struct {
+	// Name is the name of the step; that is the kind of its operand CR
+	Name string `json:"name"`
+
+	// Phase is the phase of the step
+	Phase UpgradeStepPhase `json:"phase"`
+
+	// StartedAt is when the step was started
+	// +optional
+	StartedAt *metav1.Time `json:"startedAt,omitempty"`
+
+	// FinishedAt is when the step was completed
+	// +optional
+	FinishedAt *metav1.Time `json:"finishedAt,omitempty"`
+
+	// FailureReason describes why the step failed
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
 // HyperConvergedStatus defines the observed state of HyperConverged
 // +k8s:openapi-gen=true
 type HyperConvergedStatus struct {
@@ -337,6 +655,7 @@ type HyperConvergedStatus struct {
 	// Versions is a list of HCO component versions, as name/version pairs. The version with a name of "operator"
 	// is the HCO version itself, as described here:
 	// https://github.com/openshift/cluster-version-operator/blob/master/docs/dev/clusteroperator.md#version
+	// The version of each operand is the version it reports, along with the version that HCO ships.
 	// +optional
 	Versions Versions `json:"versions,omitempty"`
 
@@ -349,6 +668,112 @@ type HyperConvergedStatus struct {
 	// generates the value of this field once and stored in the status field, so will survive restart.
 	// +optional
 	DataImportSchedule string `json:"dataImportSchedule,omitempty"`
+
+	// Overrides reports the state of the spec.overrides entries, in the same order
+	// +optional
+	// +listType=atomic
+	Overrides []HyperConvergedOverrideStatus `json:"overrides,omitempty"`
+
+	// Components reports the observed state of each one of the operands deployed by HCO
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Components []ComponentStatus `json:"components,omitempty"`
+
+	// DriftReports describes the last out-of-band modifications of the resources deployed by HCO, that HCO has
+	// overwritten; the oldest report first
+	// +optional
+	// +listType=atomic
+	DriftReports []DriftReport `json:"driftReports,omitempty"`
+
+	// Upgrade reports the progress of the last upgrade of HCO
+	// +optional
+	Upgrade *UpgradeStatus `json:"upgrade,omitempty"`
+}
+
+// ComponentStatus is the observed state of an operand deployed by HCO
+// +k8s:openapi-gen=true
+type ComponentStatus struct {
+	// Name is the kind of the operand CR; e.g. KubeVirt or CDI
+	Name string `json:"name"`
+
+	// ObservedVersion is the version reported by the operand
+	// +optional
+	ObservedVersion string `json:"observedVersion,omitempty"`
+
+	// Generation is the generation of the operand CR, as last seen by HCO
+	// +optional
+	Generation int64 `json:"generation,omitempty"`
+
+	// Conditions are the last seen Available, Progressing and Degraded conditions of the operand
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []ComponentCondition `json:"conditions,omitempty"`
+}
+
+// ComponentCondition is a condition of an operand, as reported by the operand
+// +k8s:openapi-gen=true
+type ComponentCondition struct {
+	// Type of the condition; one of Available, Progressing or Degraded
+	Type string `json:"type"`
+
+	// Status of the condition; one of True, False or Unknown
+	Status metav1.ConditionStatus `json:"status"`
+
+	// Reason is the reason of the last transition of the condition, as reported by the operand
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// Message is the message of the last transition of the condition, as reported by the operand
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// LastTransitionTime is the last time the condition changed, as reported by the operand
+	// +optional
+	// +nullable
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+}
+
+// DriftReport describes an out-of-band modification of a resource deployed by HCO, that HCO has overwritten
+// +k8s:openapi-gen=true
+type DriftReport struct {
+	// Kind is the kind of the modified resource
+	Kind string `json:"kind"`
+
+	// Name is the name of the modified resource
+	Name string `json:"name"`
+
+	// Namespace is the namespace of the modified resource; empty for cluster scoped resources
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Time is when HCO has overwritten the modification
+	Time metav1.Time `json:"time"`
+
+	// Changes are the modified fields
+	// +optional
+	// +listType=atomic
+	Changes []DriftChange `json:"changes,omitempty"`
+}
+
+// DriftChange is a single modified field of a resource deployed by HCO
+// +k8s:openapi-gen=true
+type DriftChange struct {
+	// Path is the JSON pointer of the modified field; e.g. /spec/configuration/cpuRequest
+	Path string `json:"path"`
+
+	// Observed is the JSON encoded value of the field, as modified; empty if the field was removed
+	// +optional
+	Observed string `json:"observed,omitempty"`
+
+	// Desired is the JSON encoded value of the field that HCO has restored; empty if HCO has removed the field
+	// +optional
+	Desired string `json:"desired,omitempty"`
+
+	// Manager is the field manager that has modified the field, as recorded in the managedFields of the resource
+	// +optional
+	Manager string `json:"manager,omitempty"`
 }
 
 func (hcs *HyperConvergedStatus) UpdateVersion(name, version string) {
@@ -358,6 +783,25 @@ func (hcs *HyperConvergedStatus) UpdateVersion(name, version string) {
 	hcs.Versions.updateVersion(name, version)
 }
 
+// UpdateComponentVersion sets the observed and the expected versions of an operand
+func (hcs *HyperConvergedStatus) UpdateComponentVersion(name, version, expectedVersion string) {
+	if hcs.Versions == nil {
+		hcs.Versions = Versions{}
+	}
+	hcs.Versions.updateVersion(name, version)
+	hcs.Versions.setExpectedVersion(name, expectedVersion)
+}
+
+// RemoveVersion removes the version with the given name, if exists
+func (hcs *HyperConvergedStatus) RemoveVersion(name string) {
+	for i, v := range hcs.Versions {
+		if v.Name == name {
+			hcs.Versions = append(hcs.Versions[:i], hcs.Versions[i+1:]...)
+			return
+		}
+	}
+}
+
 func (hcs *HyperConvergedStatus) GetVersion(name string) (string, bool) {
 	return hcs.Versions.getVersion(name)
 }
@@ -365,6 +809,9 @@ func (hcs *HyperConvergedStatus) GetVersion(name string) (string, bool) {
 type Version struct {
 	Name    string `json:"name,omitempty"`
 	Version string `json:"version,omitempty"`
+	// ExpectedVersion is the version of the component that HCO ships; empty for the "operator" version
+	// +optional
+	ExpectedVersion string `json:"expectedVersion,omitempty"`
 }
 
 func newVersion(name, version string) Version {
@@ -383,6 +830,15 @@ func (vs *Versions) updateVersion(name, version string) {
 	*vs = append(*vs, newVersion(name, version))
 }
 
+func (vs *Versions) setExpectedVersion(name, expectedVersion string) {
+	for i, v := range *vs {
+		if v.Name == name {
+			(*vs)[i].ExpectedVersion = expectedVersion
+			return
+		}
+	}
+}
+
 func (vs *Versions) getVersion(name string) (string, bool) {
 	for _, v := range *vs {
 		if v.Name == name {
@@ -418,13 +874,22 @@ const (
 	// has been applied to the HyperConverged resource via a specialized annotation.
 	// This condition is exposed only when its value is True, and is otherwise hidden.
 	ConditionTaintedConfiguration = "TaintedConfiguration"
+
+	// ConditionOperandsNotEnforced indicates that the reconcile policy of one or more operand CRs is not Enforce, so
+	// HCO does not revert the out-of-band modifications of these CRs.
+	// This condition is exposed only when its value is True, and is otherwise hidden.
+	ConditionOperandsNotEnforced = "OperandsNotEnforced"
+
+	// ConditionComponentVersionMismatch indicates that one or more operands run a version other than the one that
+	// HCO ships, while no upgrade is in progress.
+	// This condition is exposed only when its value is True, and is otherwise hidden.
+	ConditionComponentVersionMismatch = "ComponentVersionMismatch"
 )
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // HyperConverged is the Schema for the hyperconvergeds API
 // +k8s:openapi-gen=true
-// +kubebuilder:storageversion
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 // +kubebuilder:resource:scope=Namespaced,categories={all},shortName={hco,hcos}
 // +kubebuilder:subresource:status
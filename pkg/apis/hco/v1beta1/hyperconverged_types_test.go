@@ -341,7 +341,7 @@ var _ = Describe("HyperconvergedTypes", func() {
 					},
 				},
 				FeatureGates: HyperConvergedFeatureGates{
-					WithHostPassthroughCPU: true,
+					WithHostPassthroughCPU: boolPtr(true),
 				},
 				LiveMigrationConfig: LiveMigrationConfigurations{
 					BandwidthPerMigration:             &bandwidthPerMigration,
@@ -439,7 +439,7 @@ var _ = Describe("HyperconvergedTypes", func() {
 			Expect(aCopy.Spec.LocalStorageClassName).Should(Equal("LocalStorageClassName"))
 			Expect(aCopy.Spec.Infra.NodePlacement).Should(Equal(hco.Spec.Infra.NodePlacement))
 			Expect(aCopy.Spec.Workloads.NodePlacement).Should(Equal(hco.Spec.Workloads.NodePlacement))
-			Expect(aCopy.Spec.FeatureGates.WithHostPassthroughCPU).Should(BeTrue())
+			Expect(aCopy.Spec.FeatureGates.IsWithHostPassthroughCPUEnabled()).Should(BeTrue())
 		})
 
 		It("Should fail to compare if modified", func() {
@@ -450,3 +450,7 @@ var _ = Describe("HyperconvergedTypes", func() {
 		})
 	})
 })
+
+func boolPtr(b bool) *bool {
+	return &b
+}
@@ -64,6 +64,117 @@ func (in *CertRotateConfigServer) DeepCopy() *CertRotateConfigServer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentCondition) DeepCopyInto(out *ComponentCondition) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentCondition.
+func (in *ComponentCondition) DeepCopy() *ComponentCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentStatus) DeepCopyInto(out *ComponentStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]ComponentCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentStatus.
+func (in *ComponentStatus) DeepCopy() *ComponentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DriftChange) DeepCopyInto(out *DriftChange) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DriftChange.
+func (in *DriftChange) DeepCopy() *DriftChange {
+	if in == nil {
+		return nil
+	}
+	out := new(DriftChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DriftReport) DeepCopyInto(out *DriftReport) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	if in.Changes != nil {
+		in, out := &in.Changes, &out.Changes
+		*out = make([]DriftChange, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DriftReport.
+func (in *DriftReport) DeepCopy() *DriftReport {
+	if in == nil {
+		return nil
+	}
+	out := new(DriftReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostPathProvisionerConfig) DeepCopyInto(out *HostPathProvisionerConfig) {
+	*out = *in
+	out.PathConfig = in.PathConfig
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostPathProvisionerConfig.
+func (in *HostPathProvisionerConfig) DeepCopy() *HostPathProvisionerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(HostPathProvisionerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostPathProvisionerPathConfig) DeepCopyInto(out *HostPathProvisionerPathConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostPathProvisionerPathConfig.
+func (in *HostPathProvisionerPathConfig) DeepCopy() *HostPathProvisionerPathConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(HostPathProvisionerPathConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HyperConverged) DeepCopyInto(out *HyperConverged) {
 	*out = *in
@@ -133,6 +244,21 @@ func (in *HyperConvergedConfig) DeepCopy() *HyperConvergedConfig {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HyperConvergedFeatureGates) DeepCopyInto(out *HyperConvergedFeatureGates) {
 	*out = *in
+	if in.WithHostPassthroughCPU != nil {
+		in, out := &in.WithHostPassthroughCPU, &out.WithHostPassthroughCPU
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SRIOVLiveMigration != nil {
+		in, out := &in.SRIOVLiveMigration, &out.SRIOVLiveMigration
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableCommonBootImageImport != nil {
+		in, out := &in.EnableCommonBootImageImport, &out.EnableCommonBootImageImport
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -179,6 +305,44 @@ func (in *HyperConvergedList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HyperConvergedNodeMaintenanceConfig) DeepCopyInto(out *HyperConvergedNodeMaintenanceConfig) {
+	*out = *in
+	if in.NodePlacement != nil {
+		in, out := &in.NodePlacement, &out.NodePlacement
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HyperConvergedNodeMaintenanceConfig.
+func (in *HyperConvergedNodeMaintenanceConfig) DeepCopy() *HyperConvergedNodeMaintenanceConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(HyperConvergedNodeMaintenanceConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HyperConvergedNodePlacementOverride) DeepCopyInto(out *HyperConvergedNodePlacementOverride) {
+	*out = *in
+	in.Infra.DeepCopyInto(&out.Infra)
+	in.Workloads.DeepCopyInto(&out.Workloads)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HyperConvergedNodePlacementOverride.
+func (in *HyperConvergedNodePlacementOverride) DeepCopy() *HyperConvergedNodePlacementOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(HyperConvergedNodePlacementOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HyperConvergedObsoleteCPUs) DeepCopyInto(out *HyperConvergedObsoleteCPUs) {
 	*out = *in
@@ -200,12 +364,69 @@ func (in *HyperConvergedObsoleteCPUs) DeepCopy() *HyperConvergedObsoleteCPUs {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HyperConvergedOverride) DeepCopyInto(out *HyperConvergedOverride) {
+	*out = *in
+	out.Target = in.Target
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HyperConvergedOverride.
+func (in *HyperConvergedOverride) DeepCopy() *HyperConvergedOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(HyperConvergedOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HyperConvergedOverrideStatus) DeepCopyInto(out *HyperConvergedOverrideStatus) {
+	*out = *in
+	out.Target = in.Target
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HyperConvergedOverrideStatus.
+func (in *HyperConvergedOverrideStatus) DeepCopy() *HyperConvergedOverrideStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HyperConvergedOverrideStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HyperConvergedOverrideTarget) DeepCopyInto(out *HyperConvergedOverrideTarget) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HyperConvergedOverrideTarget.
+func (in *HyperConvergedOverrideTarget) DeepCopy() *HyperConvergedOverrideTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(HyperConvergedOverrideTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HyperConvergedSpec) DeepCopyInto(out *HyperConvergedSpec) {
 	*out = *in
 	in.Infra.DeepCopyInto(&out.Infra)
 	in.Workloads.DeepCopyInto(&out.Workloads)
-	out.FeatureGates = in.FeatureGates
+	if in.NodePlacementOverrides != nil {
+		in, out := &in.NodePlacementOverrides, &out.NodePlacementOverrides
+		*out = make([]HyperConvergedNodePlacementOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.FeatureGates.DeepCopyInto(&out.FeatureGates)
 	in.LiveMigrationConfig.DeepCopyInto(&out.LiveMigrationConfig)
 	if in.PermittedHostDevices != nil {
 		in, out := &in.PermittedHostDevices, &out.PermittedHostDevices
@@ -255,6 +476,31 @@ func (in *HyperConvergedSpec) DeepCopyInto(out *HyperConvergedSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = new(HyperConvergedStorageConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeMaintenance != nil {
+		in, out := &in.NodeMaintenance, &out.NodeMaintenance
+		*out = new(HyperConvergedNodeMaintenanceConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = make([]HyperConvergedOverride, len(*in))
+		copy(*out, *in)
+	}
+	if in.ReconcilePolicies != nil {
+		in, out := &in.ReconcilePolicies, &out.ReconcilePolicies
+		*out = make([]OperandReconcilePolicy, len(*in))
+		copy(*out, *in)
+	}
+	if in.UpgradeStrategy != nil {
+		in, out := &in.UpgradeStrategy, &out.UpgradeStrategy
+		*out = new(HyperConvergedUpgradeStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -288,6 +534,30 @@ func (in *HyperConvergedStatus) DeepCopyInto(out *HyperConvergedStatus) {
 		*out = make(Versions, len(*in))
 		copy(*out, *in)
 	}
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = make([]HyperConvergedOverrideStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Components != nil {
+		in, out := &in.Components, &out.Components
+		*out = make([]ComponentStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DriftReports != nil {
+		in, out := &in.DriftReports, &out.DriftReports
+		*out = make([]DriftReport, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Upgrade != nil {
+		in, out := &in.Upgrade, &out.Upgrade
+		*out = new(UpgradeStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -301,6 +571,53 @@ func (in *HyperConvergedStatus) DeepCopy() *HyperConvergedStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HyperConvergedStorageConfig) DeepCopyInto(out *HyperConvergedStorageConfig) {
+	*out = *in
+	if in.HostPathProvisioner != nil {
+		in, out := &in.HostPathProvisioner, &out.HostPathProvisioner
+		*out = new(HostPathProvisionerConfig)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HyperConvergedStorageConfig.
+func (in *HyperConvergedStorageConfig) DeepCopy() *HyperConvergedStorageConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(HyperConvergedStorageConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HyperConvergedUpgradeStrategy) DeepCopyInto(out *HyperConvergedUpgradeStrategy) {
+	*out = *in
+	if in.StepTimeout != nil {
+		in, out := &in.StepTimeout, &out.StepTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.HaltOnFailure != nil {
+		in, out := &in.HaltOnFailure, &out.HaltOnFailure
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HyperConvergedUpgradeStrategy.
+func (in *HyperConvergedUpgradeStrategy) DeepCopy() *HyperConvergedUpgradeStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(HyperConvergedUpgradeStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HyperConvergedWorkloadUpdateStrategy) DeepCopyInto(out *HyperConvergedWorkloadUpdateStrategy) {
 	*out = *in
@@ -389,6 +706,22 @@ func (in *MediatedHostDevice) DeepCopy() *MediatedHostDevice {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperandReconcilePolicy) DeepCopyInto(out *OperandReconcilePolicy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperandReconcilePolicy.
+func (in *OperandReconcilePolicy) DeepCopy() *OperandReconcilePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(OperandReconcilePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OperandResourceRequirements) DeepCopyInto(out *OperandResourceRequirements) {
 	*out = *in
@@ -473,6 +806,63 @@ func (in *StorageImportConfig) DeepCopy() *StorageImportConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeStatus) DeepCopyInto(out *UpgradeStatus) {
+	*out = *in
+	in.StartedAt.DeepCopyInto(&out.StartedAt)
+	if in.FinishedAt != nil {
+		in, out := &in.FinishedAt, &out.FinishedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]UpgradeStepStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CompletedMigrations != nil {
+		in, out := &in.CompletedMigrations, &out.CompletedMigrations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeStatus.
+func (in *UpgradeStatus) DeepCopy() *UpgradeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeStepStatus) DeepCopyInto(out *UpgradeStepStatus) {
+	*out = *in
+	if in.StartedAt != nil {
+		in, out := &in.StartedAt, &out.StartedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.FinishedAt != nil {
+		in, out := &in.FinishedAt, &out.FinishedAt
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeStepStatus.
+func (in *UpgradeStepStatus) DeepCopy() *UpgradeStepStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeStepStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Version) DeepCopyInto(out *Version) {
 	*out = *in
@@ -427,8 +427,19 @@ func GetClusterPermissions() []rbacv1.PolicyRule {
 			Verbs:     stringListToSlice("get", "list", "create", "update", "watch"),
 		},
 		roleWithAllPermissions("kubevirt.io", stringListToSlice("kubevirts", "kubevirts/finalizers")),
+		{
+			APIGroups: stringListToSlice("kubevirt.io"),
+			Resources: stringListToSlice("virtualmachineinstances"),
+			Verbs:     stringListToSlice("get", "list", "watch"),
+		},
 		roleWithAllPermissions("cdi.kubevirt.io", stringListToSlice("cdis", "cdis/finalizers")),
+		{
+			APIGroups: stringListToSlice("cdi.kubevirt.io"),
+			Resources: stringListToSlice("datavolumes"),
+			Verbs:     stringListToSlice("get", "list", "watch"),
+		},
 		roleWithAllPermissions("ssp.kubevirt.io", stringListToSlice("ssps", "ssps/finalizers")),
+		roleWithAllPermissions("hostpathprovisioner.kubevirt.io", stringListToSlice("hostpathprovisioners", "hostpathprovisioners/finalizers")),
 		roleWithAllPermissions("networkaddonsoperator.network.kubevirt.io", stringListToSlice("networkaddonsconfigs", "networkaddonsconfigs/finalizers")),
 		roleWithAllPermissions("", stringListToSlice("configmaps")),
 		{
@@ -439,12 +450,17 @@ func GetClusterPermissions() []rbacv1.PolicyRule {
 		roleWithAllPermissions("", stringListToSlice("services")),
 		{
 			APIGroups: emptyAPIGroup,
-			Resources: stringListToSlice("pods"),
+			Resources: stringListToSlice("pods", "nodes"),
 			Verbs:     stringListToSlice("get", "list", "watch"),
 		},
 		{
 			APIGroups: stringListToSlice("apps"),
-			Resources: stringListToSlice("deployments", "replicasets"),
+			Resources: stringListToSlice("deployments"),
+			Verbs:     stringListToSlice("get", "list", "watch", "patch"),
+		},
+		{
+			APIGroups: stringListToSlice("apps"),
+			Resources: stringListToSlice("replicasets"),
 			Verbs:     stringListToSlice("get", "list"),
 		},
 		roleWithAllPermissions("rbac.authorization.k8s.io", stringListToSlice("roles", "rolebindings")),
@@ -494,7 +510,7 @@ func roleWithAllPermissions(apiGroup string, resources []string) rbacv1.PolicyRu
 	return rbacv1.PolicyRule{
 		APIGroups: stringListToSlice(apiGroup),
 		Resources: resources,
-		Verbs:     stringListToSlice("get", "list", "watch", "create", "update", "delete"),
+		Verbs:     stringListToSlice("get", "list", "watch", "create", "update", "patch", "delete"),
 	}
 }
 
@@ -560,7 +576,7 @@ func packageErrors(pkg *loader.Package, filterKinds ...packages.ErrorKind) error
 
 const objectType = "object"
 
-func GetOperatorCRD(relPath string) *extv1.CustomResourceDefinition {
+func GetOperatorCRD(relPath, namespace string) *extv1.CustomResourceDefinition {
 	pkgs, err := loader.LoadRoots(relPath)
 	if err != nil {
 		panic(err)
@@ -602,16 +618,40 @@ func GetOperatorCRD(relPath string) *extv1.CustomResourceDefinition {
 			},
 		}
 	}
+
+	// OLM sets the conversion webhook from the CSV; cert-manager injects the CA bundle when deployed without OLM
+	if c.Annotations == nil {
+		c.Annotations = make(map[string]string)
+	}
+	c.Annotations["cert-manager.io/inject-ca-from"] = namespace + "/" + hcoNameWebhook + "-service-cert"
+	c.Spec.Conversion = getOperatorCRDConversion(namespace)
+
 	return &c
 }
 
-func GetOperatorCR() *hcov1beta1.HyperConverged {
-	completionTimeoutPerGiB := int64(800)
-	parallelMigrationsPerCluster := uint32(5)
-	parallelOutboundMigrationsPerNode := uint32(2)
-	progressTimeout := int64(150)
+func getOperatorCRDConversion(namespace string) *extv1.CustomResourceConversion {
+	conversionWebhookPath := util.ConversionWebhookPath
+	port := int32(util.WebhookPort)
+
+	return &extv1.CustomResourceConversion{
+		Strategy: extv1.WebhookConverter,
+		Webhook: &extv1.WebhookConversion{
+			ClientConfig: &extv1.WebhookClientConfig{
+				// caBundle is injected by cert-manager, because of the inject-ca-from annotation
+				Service: &extv1.ServiceReference{
+					Namespace: namespace,
+					Name:      hcoNameWebhook + "-service",
+					Path:      &conversionWebhookPath,
+					Port:      &port,
+				},
+			},
+			ConversionReviewVersions: stringListToSlice("v1beta1", "v1"),
+		},
+	}
+}
 
-	return &hcov1beta1.HyperConverged{
+func GetOperatorCR() *hcov1beta1.HyperConverged {
+	hc := &hcov1beta1.HyperConverged{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: util.APIVersion,
 			Kind:       util.HyperConvergedKind,
@@ -620,29 +660,12 @@ func GetOperatorCR() *hcov1beta1.HyperConverged {
 			Name: crName,
 		},
 		Spec: hcov1beta1.HyperConvergedSpec{
-			CertConfig: hcov1beta1.HyperConvergedCertConfig{
-				CA: hcov1beta1.CertRotateConfigCA{
-					Duration:    metav1.Duration{Duration: 48 * time.Hour},
-					RenewBefore: metav1.Duration{Duration: 24 * time.Hour},
-				},
-				Server: hcov1beta1.CertRotateConfigServer{
-					Duration:    metav1.Duration{Duration: 24 * time.Hour},
-					RenewBefore: metav1.Duration{Duration: 12 * time.Hour},
-				},
-			},
-			FeatureGates: hcov1beta1.HyperConvergedFeatureGates{
-				WithHostPassthroughCPU: false,
-				SRIOVLiveMigration:     true,
-			},
-			LiveMigrationConfig: hcov1beta1.LiveMigrationConfigurations{
-				CompletionTimeoutPerGiB:           &completionTimeoutPerGiB,
-				ParallelMigrationsPerCluster:      &parallelMigrationsPerCluster,
-				ParallelOutboundMigrationsPerNode: &parallelOutboundMigrationsPerNode,
-				ProgressTimeout:                   &progressTimeout,
-			},
 			LocalStorageClassName: "",
 		},
 	}
+	hc.Default()
+
+	return hc
 }
 
 // GetInstallStrategyBase returns the basics of an HCO InstallStrategy
@@ -770,6 +793,49 @@ func GetCSVBase(params *CSVBaseParams) *csvv1alpha1.ClusterServiceVersion {
 		WebhookPath: &mutatingWebhookPath,
 	}
 
+	defaulterWebhookPath := util.DefaulterWebhookPath
+
+	// sets the defaults of the HyperConverged CR; requests for other API versions are converted to the API version
+	// of the rule
+	defaulterWebhook := csvv1alpha1.WebhookDescription{
+		GenerateName:            util.HcoMutatingWebhook,
+		Type:                    csvv1alpha1.MutatingAdmissionWebhook,
+		DeploymentName:          hcoWhDeploymentName,
+		ContainerPort:           util.WebhookPort,
+		AdmissionReviewVersions: stringListToSlice("v1beta1", "v1"),
+		SideEffects:             &sideEffect,
+		FailurePolicy:           &failurePolicy,
+		TimeoutSeconds:          &webhookTimeout,
+		Rules: []admissionregistrationv1.RuleWithOperations{
+			{
+				Operations: []admissionregistrationv1.OperationType{
+					admissionregistrationv1.Create,
+					admissionregistrationv1.Update,
+				},
+				Rule: admissionregistrationv1.Rule{
+					APIGroups:   stringListToSlice(util.APIVersionGroup),
+					APIVersions: stringListToSlice(util.APIVersionBeta),
+					Resources:   stringListToSlice("hyperconvergeds"),
+				},
+			},
+		},
+		WebhookPath: &defaulterWebhookPath,
+	}
+
+	conversionWebhookPath := util.ConversionWebhookPath
+
+	// converts the HyperConverged CR between the served API versions
+	conversionWebhook := csvv1alpha1.WebhookDescription{
+		GenerateName:            util.HcoConversionWebhook,
+		Type:                    csvv1alpha1.ConversionWebhook,
+		DeploymentName:          hcoWhDeploymentName,
+		ContainerPort:           util.WebhookPort,
+		AdmissionReviewVersions: stringListToSlice("v1beta1", "v1"),
+		SideEffects:             &sideEffect,
+		ConversionCRDs:          stringListToSlice("hyperconvergeds.hco.kubevirt.io"),
+		WebhookPath:             &conversionWebhookPath,
+	}
+
 	return &csvv1alpha1.ClusterServiceVersion{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: "operators.coreos.com/v1alpha1",
@@ -858,7 +924,7 @@ func GetCSVBase(params *CSVBaseParams) *csvv1alpha1.ClusterServiceVersion {
 			// Skip this in favor of having a separate function to get
 			// the actual StrategyDetailsDeployment when merging CSVs
 			InstallStrategy:    csvv1alpha1.NamedInstallStrategy{},
-			WebhookDefinitions: []csvv1alpha1.WebhookDescription{validatingWebhook, mutatingWebhook},
+			WebhookDefinitions: []csvv1alpha1.WebhookDescription{validatingWebhook, mutatingWebhook, defaulterWebhook, conversionWebhook},
 			CustomResourceDefinitions: csvv1alpha1.CustomResourceDefinitions{
 				Owned: []csvv1alpha1.CRDDescription{
 					{
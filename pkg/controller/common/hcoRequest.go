@@ -5,6 +5,7 @@ import (
 
 	"github.com/go-logr/logr"
 	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
@@ -20,6 +21,14 @@ type HcoRequest struct {
 	Dirty                      bool                       // is something was changed in the CR
 	StatusDirty                bool                       // is something was changed in the CR's Status
 	HCOTriggered               bool                       // if the request got triggered by a direct modification on HCO CR
+	TriggeredBy                *TriggeringObject          // the secondary resource that triggered the request, if known
+}
+
+// TriggeringObject identifies the secondary resource whose modification triggered the request
+type TriggeringObject struct {
+	GroupKind schema.GroupKind
+	Namespace string
+	Name      string
 }
 
 func NewHcoRequest(ctx context.Context, request reconcile.Request, log logr.Logger, upgradeMode, hcoTriggered bool) *HcoRequest {
@@ -51,6 +51,10 @@ func (eem *EventEmitterMock) EmitEvent(_ runtime.Object, eventType, reason, msg
 	eem.storedEvents = append(eem.storedEvents, event)
 }
 
+func (eem *EventEmitterMock) EmitAnnotatedEvent(object runtime.Object, _ map[string]string, eventType, reason, msg string) {
+	eem.EmitEvent(object, eventType, reason, msg)
+}
+
 func (EventEmitterMock) UpdateClient(_ context.Context, _ client.Reader, _ logr.Logger) {
 	/* not implemented; mock only */
 }
@@ -2,11 +2,20 @@ package commonTestUtils
 
 import (
 	"context"
+	"sync"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
@@ -21,6 +30,10 @@ type HcoTestClient struct {
 	createError FakeWriteErrorGenerator
 	updateError FakeWriteErrorGenerator
 	deleteError FakeWriteErrorGenerator
+	// the last configuration applied to each object, by server-side apply
+	applied map[string]map[string]interface{}
+	// serializes the server-side apply emulation, as the operands may be ensured concurrently
+	applyLock sync.Mutex
 }
 
 func (c *HcoTestClient) Get(ctx context.Context, key client.ObjectKey, obj client.Object) error {
@@ -84,9 +97,150 @@ func (c *HcoTestClient) Update(ctx context.Context, obj client.Object, opts ...c
 }
 
 func (c *HcoTestClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	if patch.Type() == types.ApplyPatchType {
+		c.applyLock.Lock()
+		defer c.applyLock.Unlock()
+		return c.apply(ctx, obj, isDryRun(opts))
+	}
 	return c.client.Patch(ctx, obj, patch, opts...)
 }
 
+// The fake client does not support server-side apply. apply emulates it: the applied fields are merged into the
+// existing object, and the fields that were applied last time, but are missing now, are removed from it. As in the
+// API server, the object is not updated if nothing was changed. On dry-run, obj gets the would-be result, but nothing
+// is stored.
+func (c *HcoTestClient) apply(ctx context.Context, obj client.Object, dryRun bool) error {
+	gvk, err := apiutil.GVKForObject(obj, c.client.Scheme())
+	if err != nil {
+		return err
+	}
+
+	applied, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return err
+	}
+	applied = runtime.DeepCopyJSON(applied)
+
+	key := client.ObjectKeyFromObject(obj)
+	appliedKey := gvk.String() + "/" + key.String()
+
+	existing := c.newObject(gvk)
+	err = c.Get(ctx, key, existing)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+
+		created, err := c.fromUnstructured(gvk, applied)
+		if err != nil {
+			return err
+		}
+		if dryRun {
+			return c.copyToApplied(created, obj)
+		}
+		if err = c.Create(ctx, created); err != nil {
+			return err
+		}
+		c.applied[appliedKey] = applied
+		return c.copyToApplied(created, obj)
+	}
+
+	existingContent, err := runtime.DefaultUnstructuredConverter.ToUnstructured(existing)
+	if err != nil {
+		return err
+	}
+	merged := runtime.DeepCopyJSON(existingContent)
+	removeUnapplied(merged, c.applied[appliedKey], applied)
+	mergeApplied(merged, applied)
+
+	updated, err := c.fromUnstructured(gvk, merged)
+	if err != nil {
+		return err
+	}
+	if dryRun {
+		return c.copyToApplied(updated, obj)
+	}
+	if !equality.Semantic.DeepEqual(existing, updated) {
+		if err = c.Update(ctx, updated); err != nil {
+			return err
+		}
+		existing = updated
+	}
+	c.applied[appliedKey] = applied
+
+	return c.copyToApplied(existing, obj)
+}
+
+func isDryRun(opts []client.PatchOption) bool {
+	patchOpts := &client.PatchOptions{}
+	patchOpts.ApplyOptions(opts)
+	return len(patchOpts.DryRun) > 0 && patchOpts.DryRun[0] == metav1.DryRunAll
+}
+
+func (c *HcoTestClient) newObject(gvk schema.GroupVersionKind) client.Object {
+	if obj, err := c.client.Scheme().New(gvk); err == nil {
+		return obj.(client.Object)
+	}
+	u := &unstructured.Unstructured{}
+	u.SetGroupVersionKind(gvk)
+	return u
+}
+
+func (c *HcoTestClient) fromUnstructured(gvk schema.GroupVersionKind, content map[string]interface{}) (client.Object, error) {
+	obj := c.newObject(gvk)
+	if u, ok := obj.(*unstructured.Unstructured); ok {
+		u.Object = runtime.DeepCopyJSON(content)
+		return u, nil
+	}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(content, obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+func (c *HcoTestClient) copyToApplied(src client.Object, dst client.Object) error {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(src)
+	if err != nil {
+		return err
+	}
+	if u, ok := dst.(*unstructured.Unstructured); ok {
+		u.Object = runtime.DeepCopyJSON(content)
+		return nil
+	}
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(content, dst)
+}
+
+// removeUnapplied removes the fields of the previous applied configuration, that are missing in the new one
+func removeUnapplied(obj, prev, applied map[string]interface{}) {
+	for key, prevValue := range prev {
+		appliedValue, ok := applied[key]
+		if !ok {
+			delete(obj, key)
+			continue
+		}
+
+		prevMap, ok1 := prevValue.(map[string]interface{})
+		appliedMap, ok2 := appliedValue.(map[string]interface{})
+		objMap, ok3 := obj[key].(map[string]interface{})
+		if ok1 && ok2 && ok3 {
+			removeUnapplied(objMap, prevMap, appliedMap)
+		}
+	}
+}
+
+// mergeApplied sets the applied fields in the object. Maps are merged; any other value, including lists, is replaced.
+func mergeApplied(obj, applied map[string]interface{}) {
+	for key, appliedValue := range applied {
+		appliedMap, ok1 := appliedValue.(map[string]interface{})
+		objMap, ok2 := obj[key].(map[string]interface{})
+		if ok1 && ok2 {
+			mergeApplied(objMap, appliedMap)
+		} else {
+			obj[key] = runtime.DeepCopyJSONValue(appliedValue)
+		}
+	}
+}
+
 func (c *HcoTestClient) DeleteAllOf(ctx context.Context, obj client.Object, opts ...client.DeleteAllOfOption) error {
 	return c.client.DeleteAllOf(ctx, obj, opts...)
 }
@@ -112,11 +266,51 @@ func (c *HcoTestClient) InitiateCreateErrors(f FakeWriteErrorGenerator) {
 }
 
 func (c *HcoTestClient) Scheme() *runtime.Scheme {
-	return &runtime.Scheme{}
+	return c.client.Scheme()
 }
 
+// RESTMapper returns a mapper that also maps the kinds of the CRDs in the fake cluster, as the discovery does
 func (c *HcoTestClient) RESTMapper() meta.RESTMapper {
-	return nil
+	return &crdRESTMapper{RESTMapper: c.client.RESTMapper(), client: c.client}
+}
+
+type crdRESTMapper struct {
+	meta.RESTMapper
+	client client.Client
+}
+
+func (m *crdRESTMapper) RESTMapping(gk schema.GroupKind, versions ...string) (*meta.RESTMapping, error) {
+	crds := &apiextensionsv1.CustomResourceDefinitionList{}
+	if err := m.client.List(context.Background(), crds); err != nil {
+		return nil, err
+	}
+
+	for _, crd := range crds.Items {
+		if crd.Spec.Group != gk.Group || crd.Spec.Names.Kind != gk.Kind || len(crd.Spec.Versions) == 0 {
+			continue
+		}
+
+		version := crd.Spec.Versions[0].Name
+		if len(versions) > 0 && versions[0] != "" {
+			version = versions[0]
+		}
+
+		scope := meta.RESTScopeNamespace
+		if crd.Spec.Scope == apiextensionsv1.ClusterScoped {
+			scope = meta.RESTScopeRoot
+		}
+
+		return &meta.RESTMapping{
+			Resource:         schema.GroupVersionResource{Group: gk.Group, Version: version, Resource: crd.Spec.Names.Plural},
+			GroupVersionKind: gk.WithVersion(version),
+			Scope:            scope,
+		}, nil
+	}
+
+	if m.RESTMapper == nil {
+		return nil, &meta.NoKindMatchError{GroupKind: gk, SearchedVersions: versions}
+	}
+	return m.RESTMapper.RESTMapping(gk, versions...)
 }
 
 type HcoTestStatusWriter struct {
@@ -162,5 +356,40 @@ func InitClient(clientObjects []runtime.Object) *HcoTestClient {
 		WithScheme(GetScheme()).
 		Build()
 
-	return &HcoTestClient{client: cl, sw: &HcoTestStatusWriter{client: cl}}
+	c := &HcoTestClient{
+		client:  cl,
+		sw:      &HcoTestStatusWriter{client: cl},
+		applied: make(map[string]map[string]interface{}),
+	}
+
+	// the initial objects are considered as created by HCO, so HCO owns their labels, annotations and spec
+	for _, obj := range clientObjects {
+		cObj, ok := obj.(client.Object)
+		if !ok {
+			continue
+		}
+		gvk, err := apiutil.GVKForObject(cObj, cl.Scheme())
+		if err != nil {
+			continue
+		}
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(cObj)
+		if err != nil {
+			continue
+		}
+
+		applied := map[string]interface{}{
+			"metadata": map[string]interface{}{},
+		}
+		for _, field := range []string{"labels", "annotations"} {
+			if value, ok := content["metadata"].(map[string]interface{})[field]; ok {
+				applied["metadata"].(map[string]interface{})[field] = runtime.DeepCopyJSONValue(value)
+			}
+		}
+		if spec, ok := content["spec"]; ok {
+			applied["spec"] = runtime.DeepCopyJSONValue(spec)
+		}
+		c.applied[gvk.String()+"/"+client.ObjectKeyFromObject(cObj).String()] = applied
+	}
+
+	return c
 }
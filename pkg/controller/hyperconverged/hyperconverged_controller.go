@@ -6,6 +6,7 @@ import (
 	"os"
 	"reflect"
 	"strings"
+	"time"
 
 	jsonpatch "github.com/evanphx/json-patch"
 	"github.com/google/uuid"
@@ -13,6 +14,7 @@ import (
 	routev1 "github.com/openshift/api/route/v1"
 	operatorhandler "github.com/operator-framework/operator-lib/handler"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	schedulingv1 "k8s.io/api/scheduling/v1"
@@ -20,12 +22,14 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	apimetav1 "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
-	"k8s.io/apimachinery/pkg/util/yaml"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -68,9 +72,16 @@ const (
 	commonProgressingReason     = "HCOProgressing"
 	taintedConfigurationReason  = "UnsupportedFeatureAnnotation"
 	taintedConfigurationMessage = "Unsupported feature was activated via an HCO annotation"
+	operandsNotEnforcedMessage  = "HCO does not enforce the required state of these operands: %s"
+	versionMismatchReason       = "UnexpectedOperandVersion"
+	versionMismatchMessage      = "These operands run a version other than the one HCO ships: %s"
 
 	hcoVersionName    = "operator"
 	secondaryCRPrefix = "hco-controlled-cr-"
+	// secondaryCRSeparator separates the fields of the secondary CR in the name of its request
+	secondaryCRSeparator = "/"
+	// unknownTrigger is the trigger of a reconciliation by a secondary CR, that its kind is not known
+	unknownTrigger = "unknown"
 
 	// These group are no longer supported. Use these constants to remove unused resources
 	v2vGroup     = "v2v.kubevirt.io"
@@ -110,43 +121,10 @@ func newReconciler(mgr manager.Manager, ci hcoutil.ClusterInfo, upgradeableCond
 		eventEmitter:         hcoutil.GetEventEmitter(),
 		firstLoop:            true,
 		upgradeableCondition: upgradeableCond,
+		health:               hcoutil.GetHealthChecker(),
 	}
 }
 
-// newCRDremover returns a new CRDRemover
-func newCRDremover(client client.Client) *CRDRemover {
-	crdRemover := &CRDRemover{
-		client: client,
-		crdsToRemove: []schema.GroupKind{
-			// These are the v2v CRDs we have to remove moving to MTV
-			{Group: v2vGroup, Kind: "V2VVmware"},
-			{Group: v2vGroup, Kind: "OVirtProvider"},
-			{Group: v2vGroup, Kind: "VMImportConfig"},
-
-			// These are the 2nd generation SSP CRDs,
-			// where the group name has been changed to "ssp.kubevirt.io"
-			{Group: prevSspGroup, Kind: "KubevirtCommonTemplatesBundle"},
-			{Group: prevSspGroup, Kind: "KubevirtNodeLabellerBundle"},
-			{Group: prevSspGroup, Kind: "KubevirtTemplateValidator"},
-			{Group: prevSspGroup, Kind: "KubevirtMetricsAggregation"},
-
-			// These are the original SSP CRDs, with the group name "kubevirt.io".
-			// We attempt to remove these too, for upgrades from even older version.
-			{Group: origSspGroup, Kind: "KubevirtCommonTemplatesBundle"},
-			{Group: origSspGroup, Kind: "KubevirtNodeLabellerBundle"},
-			{Group: origSspGroup, Kind: "KubevirtTemplateValidator"},
-			{Group: origSspGroup, Kind: "KubevirtMetricsAggregation"},
-		},
-	}
-
-	// The list of related objects to remove is initialized empty;
-	// Once the corresponding CRD (and hence CR) is removed successfully,
-	// the CR can be removed from the list of related objects.
-	crdRemover.relatedObjectsToRemove = make([]schema.GroupKind, 0, len(crdRemover.crdsToRemove))
-
-	return crdRemover
-}
-
 // add adds a new Controller to mgr with r as the reconcile.Reconciler
 func add(mgr manager.Manager, r reconcile.Reconciler, ci hcoutil.ClusterInfo) error {
 	// Create a new controller
@@ -183,6 +161,7 @@ func add(mgr manager.Manager, r reconcile.Reconciler, ci hcoutil.ClusterInfo) er
 		&corev1.Service{},
 		&rbacv1.Role{},
 		&rbacv1.RoleBinding{},
+		&appsv1.Deployment{},
 	}
 	if ci.IsOpenshift() {
 		secondaryResources = append(secondaryResources, []client.Object{
@@ -194,20 +173,41 @@ func add(mgr manager.Manager, r reconcile.Reconciler, ci hcoutil.ClusterInfo) er
 		}...)
 	}
 
+	// The HostPathProvisioner CRD is deployed by the hostpath provisioner operator; watch it only if it is available.
+	// Otherwise, the watch would fail the whole controller.
+	if _, mappingErr := mgr.GetRESTMapper().RESTMapping(operands.HostPathProvisionerGVK.GroupKind(), operands.HostPathProvisionerGVK.Version); mappingErr == nil {
+		secondaryResources = append(secondaryResources, operands.NewEmptyHostPathProvisioner())
+	} else {
+		log.Info("The HostPathProvisioner CRD is not available; not watching the HostPathProvisioner CR", "error", mappingErr.Error())
+	}
+
 	// Watch secondary resources
 	for _, resource := range secondaryResources {
-		msg := fmt.Sprintf("Reconciling for %T", resource)
+		gvk, err := apiutil.GVKForObject(resource, mgr.GetScheme())
+		if err != nil {
+			return err
+		}
+		gk := gvk.GroupKind()
+		msg := fmt.Sprintf("Reconciling for %s", gvk.Kind)
+
+		predicates := []predicate.Predicate{}
+		if !isStatusReadByHCO(resource) {
+			predicates = append(predicates, ignoreStatusUpdatesPredicate())
+		}
+
 		err = c.Watch(
 			&source.Kind{Type: resource},
 			handler.EnqueueRequestsFromMapFunc(func(a client.Object) []reconcile.Request {
 				// enqueue using a placeholder to be able to discriminate request triggered
 				// by changes on the HyperConverged object from request triggered by changes
-				// on a secondary CR controlled by HCO
-				log.Info(msg)
+				// on a secondary CR controlled by HCO. The request also identifies the
+				// secondary CR, so only the operand that deployed it is ensured.
+				log.Info(msg, "namespace", a.GetNamespace(), "name", a.GetName())
 				return []reconcile.Request{
-					{NamespacedName: secCRPlaceholder},
+					getSecondaryCRRequest(secCRPlaceholder, gk, a),
 				}
 			}),
+			predicates...,
 		)
 		if err != nil {
 			return err
@@ -231,6 +231,7 @@ type ReconcileHyperConverged struct {
 	eventEmitter         hcoutil.EventEmitter
 	firstLoop            bool
 	upgradeableCondition hcoutil.Condition
+	health               *hcoutil.HealthChecker
 }
 
 // Reconcile reads that state of the cluster for a HyperConverged object and makes changes based on the state read
@@ -239,6 +240,17 @@ type ReconcileHyperConverged struct {
 // The Controller will requeue the Request to be processed again if the returned error is non-nil or
 // Result.Requeue is true, otherwise upon completion it will remove the work from the queue.
 func (r *ReconcileHyperConverged) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	// report the progress and the outcome of the reconciliation to the readiness and liveness probes
+	r.health.ReconcileStarted()
+	start := time.Now()
+	result, err := r.reconcileRequest(ctx, request)
+	metrics.HcoMetrics.ObserveReconcileDuration(time.Since(start))
+	r.health.ReconcileCompleted(err)
+
+	return result, err
+}
+
+func (r *ReconcileHyperConverged) reconcileRequest(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
 	logger := log.WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name)
 
 	hcoTriggered, err := isTriggeredByHyperConverged(request)
@@ -254,9 +266,22 @@ func (r *ReconcileHyperConverged) Reconcile(ctx context.Context, request reconci
 
 	if hcoTriggered {
 		logger.Info("Reconciling HyperConverged operator")
+		metrics.HcoMetrics.IncReconcileTrigger(hcoutil.HyperConvergedKind)
 		r.operandHandler.Reset()
 	} else {
-		logger.Info("The reconciliation got triggered by a secondary CR object")
+		hcoRequest.TriggeredBy, err = getTriggeringObject(request)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+
+		if hcoRequest.TriggeredBy != nil {
+			logger.Info("The reconciliation got triggered by a secondary CR object", "Kind", hcoRequest.TriggeredBy.GroupKind.String(),
+				"namespace", hcoRequest.TriggeredBy.Namespace, "name", hcoRequest.TriggeredBy.Name)
+			metrics.HcoMetrics.IncReconcileTrigger(hcoRequest.TriggeredBy.GroupKind.Kind)
+		} else {
+			logger.Info("The reconciliation got triggered by a secondary CR object")
+			metrics.HcoMetrics.IncReconcileTrigger(unknownTrigger)
+		}
 	}
 
 	// Fetch the HyperConverged instance
@@ -267,6 +292,7 @@ func (r *ReconcileHyperConverged) Reconcile(ctx context.Context, request reconci
 	if instance == nil {
 		// if the HyperConverged CR was deleted during an upgrade process, then this is not an upgrade anymore
 		r.upgradeMode = false
+		metrics.HcoMetrics.SetConditions(nil)
 		if err == nil {
 			err = r.setOperatorUpgradeableStatus(hcoRequest)
 		}
@@ -321,10 +347,92 @@ func isTriggeredByHyperConverged(request reconcile.Request) (bool, error) {
 		return false, err
 	}
 
-	isHyperConverged := request.NamespacedName != placeholder
+	isHyperConverged := request.Namespace != placeholder.Namespace ||
+		(request.Name != placeholder.Name && !strings.HasPrefix(request.Name, placeholder.Name+secondaryCRSeparator))
 	return isHyperConverged, nil
 }
 
+// getSecondaryCRRequest returns the request of a modification of a secondary CR. The name of the request is the
+// placeholder name, followed by the group kind, the namespace and the name of the secondary CR.
+func getSecondaryCRRequest(placeholder types.NamespacedName, gk schema.GroupKind, obj client.Object) reconcile.Request {
+	return reconcile.Request{
+		NamespacedName: types.NamespacedName{
+			Namespace: placeholder.Namespace,
+			Name:      strings.Join([]string{placeholder.Name, gk.String(), obj.GetNamespace(), obj.GetName()}, secondaryCRSeparator),
+		},
+	}
+}
+
+// getTriggeringObject returns the secondary CR that triggered a request, as encoded by getSecondaryCRRequest; nil if
+// the request does not identify the secondary CR.
+func getTriggeringObject(request reconcile.Request) (*common.TriggeringObject, error) {
+	placeholder, err := getSecondaryCRPlaceholder()
+	if err != nil {
+		return nil, err
+	}
+
+	if !strings.HasPrefix(request.Name, placeholder.Name+secondaryCRSeparator) {
+		return nil, nil
+	}
+
+	parts := strings.SplitN(strings.TrimPrefix(request.Name, placeholder.Name+secondaryCRSeparator), secondaryCRSeparator, 3)
+	if len(parts) != 3 {
+		return nil, nil
+	}
+
+	return &common.TriggeringObject{
+		GroupKind: schema.ParseGroupKind(parts[0]),
+		Namespace: parts[1],
+		Name:      parts[2],
+	}, nil
+}
+
+// isStatusReadByHCO checks if HCO reads the status of a secondary resource; i.e. if the resource is an operand CR
+func isStatusReadByHCO(resource client.Object) bool {
+	switch resource.(type) {
+	case *kubevirtv1.KubeVirt, *cdiv1beta1.CDI, *networkaddonsv1.NetworkAddonsConfig, *sspv1beta1.SSP:
+		return true
+	case *unstructured.Unstructured:
+		// the HostPathProvisioner CR
+		return true
+	case *appsv1.Deployment:
+		// the node maintenance operator deployment, that has no operand CR
+		return true
+	}
+	return false
+}
+
+// ignoreStatusUpdatesPredicate drops the update events that only modified the status of the resource
+func ignoreStatusUpdatesPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			if e.ObjectOld == nil || e.ObjectNew == nil {
+				return true
+			}
+
+			oldObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(e.ObjectOld)
+			if err != nil {
+				return true
+			}
+			newObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(e.ObjectNew)
+			if err != nil {
+				return true
+			}
+
+			return !reflect.DeepEqual(withoutStatus(oldObj), withoutStatus(newObj))
+		},
+	}
+}
+
+// withoutStatus removes the status, and the metadata fields that are modified with any update, from an unstructured
+// object
+func withoutStatus(obj map[string]interface{}) map[string]interface{} {
+	delete(obj, "status")
+	unstructured.RemoveNestedField(obj, "metadata", "resourceVersion")
+	unstructured.RemoveNestedField(obj, "metadata", "managedFields")
+	return obj
+}
+
 func (r *ReconcileHyperConverged) doReconcile(req *common.HcoRequest) (reconcile.Result, error) {
 
 	valid, err := r.validateNamespace(req)
@@ -339,6 +447,7 @@ func (r *ReconcileHyperConverged) doReconcile(req *common.HcoRequest) (reconcile
 	}
 
 	r.setLabels(req)
+	r.removeForceWorkloadsPlacementChangeAnnotation(req)
 
 	updateStatusGeneration(req)
 
@@ -368,13 +477,27 @@ func (r *ReconcileHyperConverged) doReconcile(req *common.HcoRequest) (reconcile
 
 		r.upgradeMode = true
 
-		r.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeNormal, "UpgradeHCO", "Upgrading the HyperConverged to version "+r.ownVersion)
-		req.Logger.Info(fmt.Sprintf("Start upgrading from version %s to version %s", knownHcoVersion, r.ownVersion))
+		if operands.IsUpgradeStarted(req.Instance, r.ownVersion) {
+			// the operator was restarted during the upgrade; resume the upgrade from its record in the status
+			upgrade := req.Instance.Status.Upgrade
+			r.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeNormal, "UpgradeHCO", "Resuming the upgrade of the HyperConverged to version "+r.ownVersion)
+			req.Logger.Info(fmt.Sprintf("Resume upgrading from version %s to version %s", upgrade.SourceVersion, r.ownVersion),
+				"completed migrations", upgrade.CompletedMigrations, "current step", upgrade.CurrentStep)
+		} else {
+			r.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeNormal, "UpgradeHCO", "Upgrading the HyperConverged to version "+r.ownVersion)
+			req.Logger.Info(fmt.Sprintf("Start upgrading from version %s to version %s", knownHcoVersion, r.ownVersion))
+		}
 	}
 
 	req.SetUpgradeMode(r.upgradeMode)
 
 	if r.upgradeMode {
+		if operands.StartUpgrade(req.Instance, knownHcoVersion, r.ownVersion) {
+			req.StatusDirty = true
+		}
+
+		// the stored versions are checked in each reconciliation, because v1beta1 can be removed from them only after
+		// the storage version of the CRD is updated
 		crdStatusUpdated, err := r.updateCrdStoredVersions(req)
 		if err != nil {
 			return reconcile.Result{Requeue: true}, err
@@ -398,13 +521,19 @@ func (r *ReconcileHyperConverged) doReconcile(req *common.HcoRequest) (reconcile
 		// the removal of the old CSV (vm-import-operator deployment has
 		// an owner reference on it) once the upgrade successfully completed.
 
-		cdrRemover := newCRDremover(r.client)
-		err = cdrRemover.Remove(req)
+		gc := newObsoleteResourcesGC(r.client, r.eventEmitter, req.Instance)
+		var removing bool
+		if gc.dryRun {
+			// the obsolete resources are only reported, so the removal is not completed
+			removing, err = gc.collect(req)
+		} else {
+			removing, err = runUpgradeMigration(req, oldCrdsRemovalMigration, gc.collect)
+		}
 		if err != nil {
 			return reconcile.Result{Requeue: init}, err
 		}
 		// if we still have something to remove, requeue to retry
-		if !cdrRemover.Done() {
+		if removing {
 			return reconcile.Result{Requeue: true}, nil
 		}
 
@@ -423,7 +552,7 @@ func (r *ReconcileHyperConverged) doReconcile(req *common.HcoRequest) (reconcile
 }
 
 func (r *ReconcileHyperConverged) EnsureOperandAndComplete(req *common.HcoRequest, init bool) (reconcile.Result, error) {
-	if err := r.operandHandler.Ensure(req); err != nil {
+	if err := r.ensureOperands(req, init); err != nil {
 		r.updateConditions(req)
 		return reconcile.Result{Requeue: init}, nil
 	}
@@ -437,9 +566,30 @@ func (r *ReconcileHyperConverged) EnsureOperandAndComplete(req *common.HcoReques
 
 	r.completeReconciliation(req)
 
+	if r.upgradeMode {
+		// make sure the timeout of the current upgrade step will be detected
+		if timeLeft, ok := operands.GetUpgradeStepTimeLeft(req.Instance); ok {
+			return reconcile.Result{RequeueAfter: timeLeft}, nil
+		}
+	}
+
 	return reconcile.Result{}, nil
 }
 
+// ensureOperands ensures only the operand of the secondary CR that triggered the request, if it is known. All the
+// operands are ensured if the request was triggered by the HyperConverged CR, on initialization, and during the upgrade.
+func (r *ReconcileHyperConverged) ensureOperands(req *common.HcoRequest, init bool) error {
+	if req.TriggeredBy != nil && !init && !r.upgradeMode {
+		ensured, err := r.operandHandler.EnsureOperand(req, *req.TriggeredBy)
+		if ensured || err != nil {
+			return err
+		}
+		req.Logger.Info("the secondary CR is not deployed by any operand; ensuring all the operands")
+	}
+
+	return r.operandHandler.Ensure(req)
+}
+
 func updateStatusGeneration(req *common.HcoRequest) {
 	if req.Instance.ObjectMeta.Generation != req.Instance.Status.ObservedGeneration {
 		req.Instance.Status.ObservedGeneration = req.Instance.ObjectMeta.Generation
@@ -726,7 +876,8 @@ func (r *ReconcileHyperConverged) aggregateComponentConditions(req *common.HcoRe
 			and here we are simply writing it back to the server.
 			One shortcoming is that only one failure of a particular condition can be
 			captured at one time (ie. if KubeVirt and CDI are both reporting !Available,
-		    you will only see CDI as it updates last).
+		    you will only see CDI as it updates last). The state of each one of the
+			components is reported in status.components.
 	*/
 	allComponentsAreUp := req.Conditions.IsEmpty()
 	req.Conditions.SetStatusCondition(metav1.Condition{
@@ -899,10 +1050,16 @@ func (r *ReconcileHyperConverged) updateConditions(req *common.HcoRequest) {
 	// Detect a "TaintedConfiguration" state, and raise a corresponding event
 	r.detectTaintedConfiguration(req, &conditions)
 
+	r.detectNotEnforcedOperands(req, &conditions)
+
+	r.detectVersionMismatch(req, &conditions)
+
 	if !reflect.DeepEqual(conditions, req.Instance.Status.Conditions) {
 		req.Instance.Status.Conditions = conditions
 		req.StatusDirty = true
 	}
+
+	metrics.HcoMetrics.SetConditions(conditions)
 }
 
 func (r *ReconcileHyperConverged) setLabels(req *common.HcoRequest) {
@@ -915,6 +1072,17 @@ func (r *ReconcileHyperConverged) setLabels(req *common.HcoRequest) {
 	}
 }
 
+// removeForceWorkloadsPlacementChangeAnnotation removes the annotation that forced a workloads placement change. The
+// webhook already accepted the change that came together with the annotation, so the annotation is removed, to not
+// force any later change.
+func (r *ReconcileHyperConverged) removeForceWorkloadsPlacementChangeAnnotation(req *common.HcoRequest) {
+	if _, found := req.Instance.ObjectMeta.Annotations[hcoutil.ForceWorkloadsPlacementChangeAnnotation]; found {
+		delete(req.Instance.ObjectMeta.Annotations, hcoutil.ForceWorkloadsPlacementChangeAnnotation)
+		req.Logger.Info("Removing the annotation that forced the workloads placement change", "annotation", hcoutil.ForceWorkloadsPlacementChangeAnnotation)
+		req.Dirty = true
+	}
+}
+
 func (r *ReconcileHyperConverged) detectTaintedConfiguration(req *common.HcoRequest, conditions *[]metav1.Condition) {
 	conditionExists := apimetav1.IsStatusConditionTrue(req.Instance.Status.Conditions, hcov1beta1.ConditionTaintedConfiguration)
 
@@ -957,6 +1125,70 @@ func (r *ReconcileHyperConverged) detectTaintedConfiguration(req *common.HcoRequ
 	}
 }
 
+// detectNotEnforcedOperands raises the OperandsNotEnforced condition if the reconcile policy of any operand is not
+// Enforce, so a paused operand won't be forgotten
+func (r *ReconcileHyperConverged) detectNotEnforcedOperands(req *common.HcoRequest, conditions *[]metav1.Condition) {
+	policies := make(map[string]string)
+	var descriptions []string
+	reason := string(hcov1beta1.ReconcilePolicyWarnOnly)
+	for _, policy := range req.Instance.Spec.ReconcilePolicies {
+		if policy.ReconcilePolicy == hcov1beta1.ReconcilePolicyEnforce {
+			continue
+		}
+		policies[policy.Kind] = string(policy.ReconcilePolicy)
+		descriptions = append(descriptions, fmt.Sprintf("%s (%s)", policy.Kind, policy.ReconcilePolicy))
+		if policy.ReconcilePolicy == hcov1beta1.ReconcilePolicyPaused {
+			reason = string(hcov1beta1.ReconcilePolicyPaused)
+		}
+	}
+	metrics.HcoMetrics.SetNotEnforcedOperands(policies)
+
+	if len(descriptions) == 0 {
+		apimetav1.RemoveStatusCondition(conditions, hcov1beta1.ConditionOperandsNotEnforced)
+		return
+	}
+
+	apimetav1.SetStatusCondition(conditions, metav1.Condition{
+		Type:               hcov1beta1.ConditionOperandsNotEnforced,
+		Status:             metav1.ConditionTrue,
+		Reason:             reason,
+		Message:            fmt.Sprintf(operandsNotEnforcedMessage, strings.Join(descriptions, ", ")),
+		ObservedGeneration: req.Instance.ObjectMeta.Generation,
+	})
+}
+
+// detectVersionMismatch raises the ComponentVersionMismatch condition if any operand runs a version other than the
+// one HCO ships. During an upgrade, the operands are expected to run the previous versions, so the condition is not
+// raised.
+func (r *ReconcileHyperConverged) detectVersionMismatch(req *common.HcoRequest, conditions *[]metav1.Condition) {
+	var versions []metrics.ComponentVersion
+	var descriptions []string
+	for _, version := range req.Instance.Status.Versions {
+		// only the versions of the operands have an expected version
+		if version.ExpectedVersion == "" {
+			continue
+		}
+		versions = append(versions, metrics.ComponentVersion{Name: version.Name, Expected: version.ExpectedVersion, Observed: version.Version})
+		if version.Version != "" && version.Version != version.ExpectedVersion {
+			descriptions = append(descriptions, fmt.Sprintf("%s (%s, expected %s)", version.Name, version.Version, version.ExpectedVersion))
+		}
+	}
+	metrics.HcoMetrics.SetComponentVersions(versions)
+
+	if len(descriptions) == 0 || req.UpgradeMode {
+		apimetav1.RemoveStatusCondition(conditions, hcov1beta1.ConditionComponentVersionMismatch)
+		return
+	}
+
+	apimetav1.SetStatusCondition(conditions, metav1.Condition{
+		Type:               hcov1beta1.ConditionComponentVersionMismatch,
+		Status:             metav1.ConditionTrue,
+		Reason:             versionMismatchReason,
+		Message:            fmt.Sprintf(versionMismatchMessage, strings.Join(descriptions, ", ")),
+		ObservedGeneration: req.Instance.ObjectMeta.Generation,
+	})
+}
+
 func getNumOfChangesJSONPatch(jsonPatch string) int {
 	patches, err := jsonpatch.DecodePatch([]byte(jsonPatch))
 	if err != nil {
@@ -998,24 +1230,15 @@ func (r *ReconcileHyperConverged) setOperatorUpgradeableStatus(request *common.H
 	return nil
 }
 
-// This function performs migrations before starting the upgrade process
-// return true if the HyperConverged CR was modified; else, return false
-//
-// If the kubevirt-config configMap exists:
-// 1. create a backup of the configMap, if not exists
-// 2. if the configMap includes the live migration configurations, and they are not match to the default values,
-//    update the HyperConverged CR
-// 3. remove the kubevirt-config configMap
-// 4. return true if the CR was modified in #2
 const (
-	kvCmName         = "kubevirt-config"
-	backupKvCmName   = kvCmName + "-backup"
-	liveMigrationKey = "migrations"
-	crdName          = "hyperconvergeds.hco.kubevirt.io"
+	crdName = "hyperconvergeds.hco.kubevirt.io"
+
+	// oldCrdsRemovalMigration is the name of the removal of the obsolete resources, as recorded in the upgrade status
+	oldCrdsRemovalMigration = "OldCrdsRemoval"
 )
 
 func (r *ReconcileHyperConverged) updateCrdStoredVersions(req *common.HcoRequest) (bool, error) {
-	versionsToBeRemoved := []string{"v1alpha1"}
+	versionsToBeRemoved := []string{hcoutil.APIVersionAlpha}
 
 	found := &apiextensionsv1.CustomResourceDefinition{}
 	key := client.ObjectKey{Namespace: hcoutil.UndefinedNamespace, Name: crdName}
@@ -1025,18 +1248,35 @@ func (r *ReconcileHyperConverged) updateCrdStoredVersions(req *common.HcoRequest
 		return false, err
 	}
 
+	// v1beta1 can be removed from the stored versions only once the CRD stores the HyperConverged CR as v1; the CR
+	// is rewritten first, so it won't remain stored as v1beta1
+	storageVersionUpdated := getCrdStorageVersion(found) == hcoutil.StorageAPIVersion
+	if storageVersionUpdated {
+		versionsToBeRemoved = append(versionsToBeRemoved, hcoutil.APIVersionBeta)
+	}
+
 	needsUpdate := false
+	migrationNeeded := false
 	var newStoredVersions []string
-	for _, vToBeRemoved := range versionsToBeRemoved {
-		for _, sVersion := range found.Status.StoredVersions {
-			if vToBeRemoved != sVersion {
-				newStoredVersions = append(newStoredVersions, sVersion)
-			} else {
-				needsUpdate = true
-			}
+	for _, sVersion := range found.Status.StoredVersions {
+		if !hcoutil.ContainsString(versionsToBeRemoved, sVersion) {
+			newStoredVersions = append(newStoredVersions, sVersion)
+			continue
+		}
+
+		needsUpdate = true
+		if sVersion == hcoutil.APIVersionBeta {
+			migrationNeeded = true
 		}
 	}
+
 	if needsUpdate {
+		if migrationNeeded {
+			if err = r.migrateStorageVersion(req); err != nil {
+				return false, err
+			}
+		}
+
 		found.Status.StoredVersions = newStoredVersions
 		err = r.client.Status().Update(req.Ctx, found)
 		if err != nil {
@@ -1050,238 +1290,33 @@ func (r *ReconcileHyperConverged) updateCrdStoredVersions(req *common.HcoRequest
 	return false, nil
 }
 
-func (r *ReconcileHyperConverged) migrateBeforeUpgrade(req *common.HcoRequest) (bool, error) {
-	kvConfigModified, err := r.migrateKvConfigurations(req)
-	if err != nil {
-		return false, err
-	}
-
-	cdiConfigModified, err := r.migrateCdiConfigurations(req)
-	if err != nil {
-		return false, err
-	}
-
-	defaultsAmended, err := r.amendBadDefaults(req)
-	if err != nil {
-		return false, err
-	}
-
-	removeOldQuickStartGuides(req, r.client, r.operandHandler.GetQuickStartNames())
-
-	return kvConfigModified || cdiConfigModified || defaultsAmended, nil
-}
-
-func (r ReconcileHyperConverged) migrateKvConfigurations(req *common.HcoRequest) (bool, error) {
-	cm, err := r.getCm(kvCmName, req)
-	if err != nil {
-		return false, err
-	} else if cm == nil {
-		return false, nil
-	}
-
-	if err = r.makeCmBackup(cm, backupKvCmName, req); err != nil {
-		return false, err
-	}
-
-	modified := adoptOldKvConfigs(req, cm)
-
-	if !modified {
-		err = r.removeConfigMap(req, cm)
-		if err != nil {
-			return false, err
-		}
-	}
-
-	return modified, nil
-}
-
-func (r ReconcileHyperConverged) migrateCdiConfigurations(req *common.HcoRequest) (bool, error) {
-	cdi := operands.NewCDIWithNameOnly(req.Instance)
-
-	err := hcoutil.GetRuntimeObject(req.Ctx, r.client, cdi, req.Logger)
-	if err != nil {
-		if apierrors.IsNotFound(err) {
-			return false, nil
-		}
-		return false, err
-	}
-
-	return adoptCdiConfigs(req, cdi.Spec.Config), nil
-}
-
-func (r ReconcileHyperConverged) amendBadDefaults(req *common.HcoRequest) (bool, error) {
-	modified := false
-	if req.Instance.Spec.LiveMigrationConfig.BandwidthPerMigration != nil && *req.Instance.Spec.LiveMigrationConfig.BandwidthPerMigration == "64Mi" {
-		req.Logger.Info("rejecting spec.livemigrationconfig.bandwidthpermigration==64Mi because it was a bad default value")
-		req.Instance.Spec.LiveMigrationConfig.BandwidthPerMigration = nil
-		modified = true
-		req.Dirty = true
-	}
-	return modified, nil
-}
-
-func (r *ReconcileHyperConverged) removeConfigMap(req *common.HcoRequest, cm *corev1.ConfigMap) error {
-	req.Logger.Info("removing the kubevirt configMap")
-	err := hcoutil.ComponentResourceRemoval(req.Ctx, r.client, cm, req.Name, req.Logger, false, true)
-	if err != nil {
+// migrateStorageVersion rewrites the HyperConverged CR, so the API server will store it with the current storage
+// version. There is no need to modify the CR; any update is stored with the storage version.
+func (r *ReconcileHyperConverged) migrateStorageVersion(req *common.HcoRequest) error {
+	hc := &hcov1beta1.HyperConverged{}
+	if err := r.client.Get(req.Ctx, req.NamespacedName, hc); err != nil {
+		req.Logger.Error(err, "failed to read the HyperConverged CR for the storage version migration")
 		return err
 	}
 
-	r.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeNormal, "Killing", fmt.Sprintf("Removed ConfigMap %s", cm.Name))
-
-	removeRelatedObject(req, "ConfigMap", cm.Name, cm.Namespace)
-
-	return nil
-}
-
-func (r *ReconcileHyperConverged) getCm(cmName string, req *common.HcoRequest) (*corev1.ConfigMap, error) {
-	cm := &corev1.ConfigMap{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      cmName,
-			Namespace: req.Namespace,
-		},
-	}
-
-	if err := hcoutil.GetRuntimeObject(req.Ctx, r.client, cm, req.Logger); err != nil {
-		if apierrors.IsNotFound(err) {
-			req.Logger.Info(fmt.Sprintf("%s configmap already removed", cmName))
-			return nil, nil
-		}
-		req.Logger.Info(fmt.Sprintf("failed to get %s configmap", cmName), "error", err.Error())
-		return nil, err
-	}
-
-	return cm, nil
-}
-
-func (r *ReconcileHyperConverged) makeCmBackup(cm *corev1.ConfigMap, backupName string, req *common.HcoRequest) error {
-	backupCm := &corev1.ConfigMap{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      backupName,
-			Namespace: cm.Namespace,
-			Labels:    cm.Labels,
-		},
-		Data: cm.Data,
-	}
-
-	req.Logger.Info(fmt.Sprintf("creating %s configmap backup", backupName))
-	if err := r.client.Create(req.Ctx, backupCm); err != nil {
-		if apierrors.IsAlreadyExists(err) {
-			req.Logger.Info(fmt.Sprintf("%s configmap backup already exists", backupName))
-		} else {
-			req.Logger.Info(fmt.Sprintf("failed to create %s configmap backup", backupName), "error", err.Error())
-			return err
-		}
-	} else {
-		r.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeNormal, "Created", fmt.Sprintf("Created ConfigMap %s", backupName))
+	if err := r.client.Update(req.Ctx, hc); err != nil {
+		req.Logger.Error(err, "failed to migrate the HyperConverged CR to the storage version", "version", hcoutil.StorageAPIVersion)
+		return err
 	}
+	// the CR content was not modified; keep the request instance up to date, so it could still be updated
+	req.Instance.ResourceVersion = hc.ResourceVersion
 
+	req.Logger.Info("migrated the HyperConverged CR to the storage version", "version", hcoutil.StorageAPIVersion)
 	return nil
 }
 
-// Read the old KubeVit configuration from the config map, and move them to the HyperConverged CR
-//
-// In case of wrong foramt of the configmap, the HCO ignores this error (but print it to the log) in order to prevent
-// an infinite loop (returning error will cause the same error again and again, and the only way to stop the loop
-// is to manually fix or delete the wrong configMap).
-func adoptOldKvConfigs(req *common.HcoRequest, cm *corev1.ConfigMap) bool {
-	modified := false
-	kvLiveMigrationConfig, ok := cm.Data[liveMigrationKey]
-	if !ok {
-		return false
-	}
-	kvCmLiveMigrationConfig := hcov1beta1.LiveMigrationConfigurations{}
-	req.Instance.Spec.LiveMigrationConfig.DeepCopyInto(&kvCmLiveMigrationConfig)
-	err := yaml.NewYAMLOrJSONDecoder(strings.NewReader(kvLiveMigrationConfig), 1024).Decode(&kvCmLiveMigrationConfig)
-	if err != nil {
-		req.Logger.Error(err, "Failed to read the KubeVirt ConfigMap, and its content was ignored. This ConfigMap will be deleted. The backup ConfigMap called "+backupKvCmName)
-		return false
-	}
-
-	if !reflect.DeepEqual(req.Instance.Spec.LiveMigrationConfig, kvCmLiveMigrationConfig) {
-		req.Logger.Info("updating the HyperConverged CR from the KubeVirt configMap")
-		kvConfigMapToHyperConvergedCr(req, kvCmLiveMigrationConfig)
-
-		modified = true
-		req.Dirty = true
-	}
-
-	return modified
-}
-
-func adoptCdiConfigs(req *common.HcoRequest, cdiCfg *cdiv1beta1.CDIConfigSpec) bool {
-	modified := false
-	if cdiCfg != nil {
-		if req.Instance.Spec.ScratchSpaceStorageClass == nil && cdiCfg.ScratchSpaceStorageClass != nil {
-			req.Instance.Spec.ScratchSpaceStorageClass = new(string)
-			*req.Instance.Spec.ScratchSpaceStorageClass = *cdiCfg.ScratchSpaceStorageClass
-			modified = true
-		}
-
-		if cdiCfg.PodResourceRequirements != nil {
-			if req.Instance.Spec.ResourceRequirements == nil {
-				req.Instance.Spec.ResourceRequirements = &hcov1beta1.OperandResourceRequirements{}
-			}
-
-			if req.Instance.Spec.ResourceRequirements.StorageWorkloads == nil {
-				req.Instance.Spec.ResourceRequirements.StorageWorkloads = cdiCfg.PodResourceRequirements.DeepCopy()
-				modified = true
-			}
+func getCrdStorageVersion(crd *apiextensionsv1.CustomResourceDefinition) string {
+	for _, version := range crd.Spec.Versions {
+		if version.Storage {
+			return version.Name
 		}
 	}
-
-	if modified {
-		req.Dirty = true
-	}
-
-	return modified
-}
-
-func kvConfigMapToHyperConvergedCr(req *common.HcoRequest, kvCmLMConfig hcov1beta1.LiveMigrationConfigurations) {
-	if kvCmLMConfig.BandwidthPerMigration != nil {
-		req.Instance.Spec.LiveMigrationConfig.BandwidthPerMigration = kvCmLMConfig.BandwidthPerMigration
-	}
-	if kvCmLMConfig.CompletionTimeoutPerGiB != nil {
-		req.Instance.Spec.LiveMigrationConfig.CompletionTimeoutPerGiB = kvCmLMConfig.CompletionTimeoutPerGiB
-	}
-	if kvCmLMConfig.ParallelMigrationsPerCluster != nil {
-		req.Instance.Spec.LiveMigrationConfig.ParallelMigrationsPerCluster = kvCmLMConfig.ParallelMigrationsPerCluster
-	}
-	if kvCmLMConfig.ParallelOutboundMigrationsPerNode != nil {
-		req.Instance.Spec.LiveMigrationConfig.ParallelOutboundMigrationsPerNode = kvCmLMConfig.ParallelOutboundMigrationsPerNode
-	}
-	if kvCmLMConfig.ProgressTimeout != nil {
-		req.Instance.Spec.LiveMigrationConfig.ProgressTimeout = kvCmLMConfig.ProgressTimeout
-	}
-}
-
-func removeOldQuickStartGuides(req *common.HcoRequest, cl client.Client, requiredQSList []string) {
-	existingQSList := &consolev1.ConsoleQuickStartList{}
-	req.Logger.Info("reading quickstart guides")
-	err := cl.List(req.Ctx, existingQSList, client.MatchingLabels{hcoutil.AppLabelManagedBy: hcoutil.OperatorName})
-	if err != nil {
-		req.Logger.Error(err, "failed to read list of quickstart guides")
-		return
-	}
-
-	var existingQSNames map[string]consolev1.ConsoleQuickStart
-	if len(existingQSList.Items) > 0 {
-		existingQSNames = make(map[string]consolev1.ConsoleQuickStart)
-		for _, qs := range existingQSList.Items {
-			existingQSNames[qs.Name] = qs
-		}
-
-		for name, existQs := range existingQSNames {
-			if !hcoutil.ContainsString(requiredQSList, name) {
-				req.Logger.Info("deleting ConsoleQuickStart", "name", name)
-				if err = hcoutil.EnsureDeleted(req.Ctx, cl, &existQs, req.Instance.Name, req.Logger, false, false); err != nil {
-					req.Logger.Error(err, "failed to delete ConsoleQuickStart", "name", name)
-				}
-			}
-		}
-
-		removeRelatedQSObjects(req, requiredQSList)
-	}
+	return ""
 }
 
 func removeRelatedObject(req *common.HcoRequest, kind, name, namespace string) {
@@ -1301,28 +1336,6 @@ func removeRelatedObject(req *common.HcoRequest, kind, name, namespace string) {
 	}
 }
 
-// removeRelatedQSObjects removes old quickstart from the related object list
-// can't use the removeRelatedObject function because the status not get updated during each reconcile loop,
-// but the old qs already removed (above) so you loos track of it. That why we must re-check all the qs names
-func removeRelatedQSObjects(req *common.HcoRequest, requiredNames []string) {
-	refs := make([]corev1.ObjectReference, 0, len(req.Instance.Status.RelatedObjects))
-	foundOldQs := false
-
-	for _, obj := range req.Instance.Status.RelatedObjects {
-		if obj.Kind == "ConsoleQuickStart" && !hcoutil.ContainsString(requiredNames, obj.Name) {
-			foundOldQs = true
-			continue
-		}
-		refs = append(refs, obj)
-	}
-
-	if foundOldQs {
-		req.Instance.Status.RelatedObjects = refs
-		req.StatusDirty = true
-	}
-
-}
-
 // getHyperConvergedNamespacedName returns the name/namespace of the HyperConverged resource
 func getHyperConvergedNamespacedName() (types.NamespacedName, error) {
 	hco := types.NamespacedName{
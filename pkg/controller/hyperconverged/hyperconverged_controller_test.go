@@ -28,6 +28,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/yaml"
 	"k8s.io/client-go/tools/reference"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1beta1"
@@ -127,7 +128,7 @@ var _ = Describe("HyperconvergedController", func() {
 			It("should create all managed resources", func() {
 				hco := commonTestUtils.NewHco()
 				hco.Spec.FeatureGates = hcov1beta1.HyperConvergedFeatureGates{
-					WithHostPassthroughCPU: true,
+					WithHostPassthroughCPU: boolPtr(true),
 				}
 
 				cl := commonTestUtils.InitClient([]runtime.Object{hco})
@@ -370,6 +371,16 @@ var _ = Describe("HyperconvergedController", func() {
 
 				Expect(foundResource.Spec.CommonTemplates.Namespace).To(Equal(expected.hco.Namespace), "common-templates namespace should be "+expected.hco.Namespace)
 			})
+			It("should remove the annotation that forced a workloads placement change", func() {
+				expected := getBasicDeployment()
+				expected.hco.Annotations = map[string]string{hcoutil.ForceWorkloadsPlacementChangeAnnotation: "true"}
+
+				cl := expected.initClient()
+				foundResource, _, _ := doReconcile(cl, expected.hco, nil)
+
+				Expect(foundResource.Annotations).ToNot(HaveKey(hcoutil.ForceWorkloadsPlacementChangeAnnotation))
+			})
+
 			It("should complete when components are finished", func() {
 				expected := getBasicDeployment()
 
@@ -950,14 +961,20 @@ var _ = Describe("HyperconvergedController", func() {
 
 				cl := expected.initClient()
 				foundResource, reconciler, requeue := doReconcile(cl, expected.hco, nil)
-				Expect(requeue).To(BeTrue())
+				// the rest of the operands wait for the CDI upgrade step, so nothing else is modified
+				Expect(requeue).To(BeFalse())
 				checkAvailability(foundResource, metav1.ConditionFalse)
 				// check that the HCO version is not set, because upgrade is not completed
 				ver, ok := foundResource.Status.GetVersion(hcoVersionName)
 				Expect(ok).To(BeTrue())
 				Expect(ver).Should(Equal(oldVersion))
 
-				// Call again - requeue
+				Expect(foundResource.Status.Upgrade).ToNot(BeNil())
+				Expect(foundResource.Status.Upgrade.TargetVersion).Should(Equal(newVersion))
+				Expect(foundResource.Status.Upgrade.Phase).Should(Equal(hcov1beta1.UpgradePhaseInProgress))
+				Expect(foundResource.Status.Upgrade.CurrentStep).Should(Equal("CDI"))
+
+				// Call again
 				foundResource, reconciler, requeue = doReconcile(cl, expected.hco, reconciler)
 				Expect(requeue).To(BeFalse())
 				checkAvailability(foundResource, metav1.ConditionFalse)
@@ -995,6 +1012,12 @@ var _ = Describe("HyperconvergedController", func() {
 				Expect(cond.Status).Should(BeEquivalentTo(metav1.ConditionFalse))
 				validateOperatorCondition(reconciler, metav1.ConditionTrue, hcoutil.UpgradeableAllowReason, hcoutil.UpgradeableAllowMessage)
 
+				Expect(foundResource.Status.Upgrade.Phase).Should(Equal(hcov1beta1.UpgradePhaseCompleted))
+				Expect(foundResource.Status.Upgrade.FinishedAt).ToNot(BeNil())
+				for _, step := range foundResource.Status.Upgrade.Steps {
+					Expect(step.Phase).Should(Equal(hcov1beta1.UpgradeStepCompleted), "step %s is not completed", step.Name)
+				}
+
 				// Call again, to start complete the upgrade
 				// check that the image Id is set, now, when upgrade is completed
 				_, _, requeue = doReconcile(cl, expected.hco, reconciler)
@@ -1009,7 +1032,7 @@ var _ = Describe("HyperconvergedController", func() {
 
 				cl := expected.initClient()
 				foundResource, reconciler, requeue := doReconcile(cl, expected.hco, nil)
-				Expect(requeue).To(BeTrue())
+				Expect(requeue).To(BeFalse())
 				checkAvailability(foundResource, metav1.ConditionFalse)
 
 				expected.hco = foundResource
@@ -1028,6 +1051,12 @@ var _ = Describe("HyperconvergedController", func() {
 				expected.cdi.Status.Conditions = getGenericCompletedConditions()
 				expected.hco = foundResource
 				cl = expected.initClient()
+				// the operands that waited for CDI are now reconciled
+				foundResource, reconciler, requeue = doReconcile(cl, expected.hco, reconciler)
+				Expect(requeue).To(BeTrue())
+				_, ok = foundResource.Status.GetVersion(hcoVersionName)
+				Expect(ok).To(BeFalse())
+
 				foundResource, _, requeue = doReconcile(cl, expected.hco, reconciler)
 				Expect(requeue).To(BeFalse())
 				checkAvailability(foundResource, metav1.ConditionTrue)
@@ -1045,6 +1074,52 @@ var _ = Describe("HyperconvergedController", func() {
 				Expect(cond.Status).Should(BeEquivalentTo(metav1.ConditionFalse))
 			})
 
+			It("should resume the upgrade from the upgrade record in the status", func() {
+				expected.hco.Status.UpdateVersion(hcoVersionName, oldVersion)
+
+				// emulate an upgrade that was started before the operator was restarted
+				Expect(operands.StartUpgrade(expected.hco, oldVersion, newVersion)).To(BeTrue())
+				startedAt := metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+				expected.hco.Status.Upgrade.StartedAt = startedAt
+				expected.hco.Status.Upgrade.CompletedMigrations = []string{oldCrdsRemovalMigration, kvConfigMigration}
+
+				// the migration of the kubevirt-config configMap was already completed, so it won't be repeated
+				kvCM := &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      kvCmName,
+						Namespace: namespace,
+					},
+					Data: map[string]string{
+						liveMigrationKey: "bandwidthPerMigration: 128Mi",
+					},
+				}
+
+				cl := commonTestUtils.InitClient(append(expected.toArray(), kvCM))
+				foundResource, reconciler, _ := doReconcile(cl, expected.hco, nil)
+				Expect(reconciler.upgradeMode).To(BeTrue())
+
+				events := reconciler.eventEmitter.(*commonTestUtils.EventEmitterMock)
+				Expect(events.CheckEvents([]commonTestUtils.MockEvent{
+					{
+						EventType: corev1.EventTypeNormal,
+						Reason:    "UpgradeHCO",
+						Msg:       "Resuming the upgrade of the HyperConverged to version " + newVersion,
+					},
+				})).To(BeTrue())
+
+				foundKvCm, foundBackup := searchKvConfigMaps(cl)
+				Expect(foundKvCm).To(BeTrue())
+				Expect(foundBackup).To(BeFalse())
+				Expect(foundResource.Spec.LiveMigrationConfig.BandwidthPerMigration).To(BeNil())
+
+				upgrade := foundResource.Status.Upgrade
+				Expect(upgrade).ToNot(BeNil())
+				Expect(upgrade.SourceVersion).To(Equal(oldVersion))
+				Expect(upgrade.TargetVersion).To(Equal(newVersion))
+				Expect(upgrade.StartedAt.Equal(&startedAt)).To(BeTrue())
+				Expect(upgrade.CompletedMigrations).To(ContainElements(oldCrdsRemovalMigration, kvConfigMigration, cdiConfigMigration, badDefaultsMigration))
+			})
+
 			DescribeTable(
 				"don't complete upgrade if a component version is not match to the component's version env ver",
 				func(makeComponentNotReady, makeComponentReady, updateComponentVersion func()) {
@@ -1052,12 +1127,15 @@ var _ = Describe("HyperconvergedController", func() {
 
 					// old HCO Version is set
 					expected.hco.Status.UpdateVersion(hcoVersionName, oldVersion)
+					// the deployOVS annotation is already set, so the HyperConverged CR is not modified when the
+					// upgrade step of CNA starts
+					expected.hco.Annotations = map[string]string{"deployOVS": "false"}
 
 					makeComponentNotReady()
 
 					cl := expected.initClient()
 					foundResource, reconciler, requeue := doReconcile(cl, expected.hco, nil)
-					Expect(requeue).To(BeTrue())
+					Expect(requeue).To(BeFalse())
 					checkAvailability(foundResource, metav1.ConditionFalse)
 
 					expected.hco = foundResource
@@ -1249,7 +1327,8 @@ progressTimeout: 150`,
 
 					By("Run reconcile again")
 					foundResource, _, requeue = doReconcile(cl, expected.hco, reconciler)
-					Expect(requeue).To(BeTrue())
+					// the operand was just updated, so the operands of the next upgrade steps are not reconciled yet
+					Expect(requeue).To(BeFalse())
 					checkAvailability(foundResource, metav1.ConditionTrue)
 
 					By("Check that KV's MigrationConfiguration field contains the configmap values")
@@ -1328,7 +1407,8 @@ progressTimeout: 300`,
 
 					By("Run reconcile again")
 					foundResource, _, requeue = doReconcile(cl, expected.hco, reconciler)
-					Expect(requeue).To(BeTrue())
+					// the operand was just updated, so the operands of the next upgrade steps are not reconciled yet
+					Expect(requeue).To(BeFalse())
 					checkAvailability(foundResource, metav1.ConditionTrue)
 
 					By("Check that KV's MigrationConfiguration field contains the configmap values")
@@ -1400,7 +1480,8 @@ progressTimeout: 300`,
 
 					By("Run reconcile again")
 					foundResource, _, requeue = doReconcile(cl, expected.hco, reconciler)
-					Expect(requeue).To(BeTrue())
+					// the operand was just updated, so the operands of the next upgrade steps are not reconciled yet
+					Expect(requeue).To(BeFalse())
 					checkAvailability(foundResource, metav1.ConditionTrue)
 
 					By("Check that KV's MigrationConfiguration field contains the configmap values")
@@ -1451,7 +1532,8 @@ progressTimeout: 150`,
 
 					cl := commonTestUtils.InitClient(resources)
 					foundResource, _, requeue := doReconcile(cl, expected.hco, nil)
-					Expect(requeue).To(BeTrue())
+					// the operand was just updated, so the operands of the next upgrade steps are not reconciled yet
+					Expect(requeue).To(BeFalse())
 					checkAvailability(foundResource, metav1.ConditionTrue)
 
 					By("Check that the LifeMigrationConfig field contains the configmap values")
@@ -1518,7 +1600,8 @@ progressTimeout: 150`,
 
 					By("Run reconcile again")
 					foundHC, _, requeue = doReconcile(cl, expected.hco, reconciler)
-					Expect(requeue).To(BeTrue())
+					// the operand was just updated, so the operands of the next upgrade steps are not reconciled yet
+					Expect(requeue).To(BeFalse())
 					checkAvailability(foundHC, metav1.ConditionTrue)
 
 					By("Check that CDI's still contains the expected values")
@@ -1561,7 +1644,8 @@ progressTimeout: 150`,
 
 					cl := commonTestUtils.InitClient(expected.toArray())
 					foundHC, _, requeue := doReconcile(cl, expected.hco, nil)
-					Expect(requeue).To(BeTrue())
+					// the operand was just updated, so the operands of the next upgrade steps are not reconciled yet
+					Expect(requeue).To(BeFalse())
 					checkAvailability(foundHC, metav1.ConditionTrue)
 
 					By("Check that the spec.ScratchSpaceStorageClass is now populated")
@@ -1600,7 +1684,8 @@ progressTimeout: 150`,
 
 					cl := commonTestUtils.InitClient(expected.toArray())
 					foundHC, _, requeue := doReconcile(cl, expected.hco, nil)
-					Expect(requeue).To(BeTrue())
+					// the operand was just updated, so the operands of the next upgrade steps are not reconciled yet
+					Expect(requeue).To(BeFalse())
 					checkAvailability(foundHC, metav1.ConditionTrue)
 
 					By("Check that the spec.ScratchSpaceStorageClass is now populated")
@@ -1660,6 +1745,40 @@ progressTimeout: 150`,
 					Expect(ver).Should(Equal(newVersion))
 				})
 
+				It("should migrate the HyperConverged CR and remove v1beta1 from .status.storedVersions, when v1 is the storage version", func() {
+					// Simulate ongoing upgrade
+					expected.hco.Status.UpdateVersion(hcoVersionName, oldVersion)
+
+					expected.hcoCRD.Spec.Versions = []apiextensionsv1.CustomResourceDefinitionVersion{
+						{Name: "v1beta1", Served: true, Storage: false},
+						{Name: "v1", Served: true, Storage: true},
+					}
+					expected.hcoCRD.Status.StoredVersions = []string{"v1beta1", "v1"}
+
+					cl := expected.initClient()
+					hcUpdated := false
+					cl.InitiateUpdateErrors(func(obj client.Object) error {
+						if _, ok := obj.(*hcov1beta1.HyperConverged); ok {
+							hcUpdated = true
+						}
+						return nil
+					})
+
+					_, _, requeue := doReconcile(cl, expected.hco, nil)
+					Expect(requeue).To(BeTrue())
+
+					foundCrd := &apiextensionsv1.CustomResourceDefinition{}
+					Expect(
+						cl.Get(context.TODO(),
+							client.ObjectKeyFromObject(expected.hcoCRD),
+							foundCrd),
+					).To(BeNil())
+					Expect(foundCrd.Status.StoredVersions).Should(Equal([]string{"v1"}))
+
+					By("Check that the HyperConverged CR was rewritten")
+					Expect(hcUpdated).To(BeTrue())
+				})
+
 				It("should not update .status.storedVersions on the HCO CRD if not in upgrade mode", func() {
 					expected.hcoCRD.Status.StoredVersions = []string{"v1alpha1", "v1beta1", "v1"}
 
@@ -1726,61 +1845,17 @@ progressTimeout: 150`,
 						},
 					}
 					oldCRDs = []*apiextensionsv1.CustomResourceDefinition{
-						{
-							ObjectMeta: metav1.ObjectMeta{
-								Name: "vmimportconfigs.v2v.kubevirt.io",
-							},
-						},
-						{
-							ObjectMeta: metav1.ObjectMeta{
-								Name: "v2vvmwares.v2v.kubevirt.io",
-							},
-						},
-						{
-							ObjectMeta: metav1.ObjectMeta{
-								Name: "ovirtproviders.v2v.kubevirt.io",
-							},
-						},
-						{
-							ObjectMeta: metav1.ObjectMeta{
-								Name: "kubevirtcommontemplatesbundles.ssp.kubevirt.io",
-							},
-						},
-						{
-							ObjectMeta: metav1.ObjectMeta{
-								Name: "kubevirtmetricsaggregations.ssp.kubevirt.io",
-							},
-						},
-						{
-							ObjectMeta: metav1.ObjectMeta{
-								Name: "kubevirtnodelabellerbundles.ssp.kubevirt.io",
-							},
-						},
-						{
-							ObjectMeta: metav1.ObjectMeta{
-								Name: "kubevirttemplatevalidators.ssp.kubevirt.io",
-							},
-						},
-						{
-							ObjectMeta: metav1.ObjectMeta{
-								Name: "kubevirtcommontemplatesbundles.kubevirt.io",
-							},
-						},
-						{
-							ObjectMeta: metav1.ObjectMeta{
-								Name: "kubevirtmetricsaggregations.kubevirt.io",
-							},
-						},
-						{
-							ObjectMeta: metav1.ObjectMeta{
-								Name: "kubevirtnodelabellerbundles.kubevirt.io",
-							},
-						},
-						{
-							ObjectMeta: metav1.ObjectMeta{
-								Name: "kubevirttemplatevalidators.kubevirt.io",
-							},
-						},
+						newCRD("vmimportconfigs", "v2v.kubevirt.io", "VMImportConfig"),
+						newCRD("v2vvmwares", "v2v.kubevirt.io", "V2VVmware"),
+						newCRD("ovirtproviders", "v2v.kubevirt.io", "OVirtProvider"),
+						newCRD("kubevirtcommontemplatesbundles", "ssp.kubevirt.io", "KubevirtCommonTemplatesBundle"),
+						newCRD("kubevirtmetricsaggregations", "ssp.kubevirt.io", "KubevirtMetricsAggregation"),
+						newCRD("kubevirtnodelabellerbundles", "ssp.kubevirt.io", "KubevirtNodeLabellerBundle"),
+						newCRD("kubevirttemplatevalidators", "ssp.kubevirt.io", "KubevirtTemplateValidator"),
+						newCRD("kubevirtcommontemplatesbundles", "kubevirt.io", "KubevirtCommonTemplatesBundle"),
+						newCRD("kubevirtmetricsaggregations", "kubevirt.io", "KubevirtMetricsAggregation"),
+						newCRD("kubevirtnodelabellerbundles", "kubevirt.io", "KubevirtNodeLabellerBundle"),
+						newCRD("kubevirttemplatevalidators", "kubevirt.io", "KubevirtTemplateValidator"),
 					}
 					oldCRDRelatedObjects = []corev1.ObjectReference{
 						{
@@ -2006,6 +2081,9 @@ progressTimeout: 150`,
 
 					cl := expected.initClient()
 					_, reconciler, requeue := doReconcile(cl, expected.hco, nil)
+					// KubeVirt was just updated, so the operands of the next upgrade steps are not reconciled yet
+					Expect(requeue).To(BeFalse())
+					_, _, requeue = doReconcile(cl, expected.hco, reconciler)
 					Expect(requeue).To(BeTrue())
 					foundResource, _, requeue := doReconcile(cl, expected.hco, reconciler)
 					Expect(requeue).To(BeFalse())
@@ -2055,6 +2133,36 @@ progressTimeout: 150`,
 					Expect(searchInRelatedObjects(foundResource.Status.RelatedObjects, "ConsoleQuickStart", oldQSName)).To(BeFalse())
 				})
 
+				It("should drop old quickstart guide also if upgrade isn't in progress", func() {
+					const oldQSName = "old-quickstart-guide"
+
+					oldQs := &consolev1.ConsoleQuickStart{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: oldQSName,
+							Labels: map[string]string{
+								hcoutil.AppLabel:          expected.hco.Name,
+								hcoutil.AppLabelManagedBy: hcoutil.OperatorName,
+							},
+						},
+					}
+
+					oldQsRef, err := reference.GetReference(commonTestUtils.GetScheme(), oldQs)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(v1.SetObjectReference(&expected.hco.Status.RelatedObjects, *oldQsRef)).ToNot(HaveOccurred())
+
+					resources := append(expected.toArray(), oldQs)
+
+					cl := commonTestUtils.InitClient(resources)
+					foundResource, _, requeue := doReconcile(cl, expected.hco, nil)
+					Expect(requeue).To(BeFalse())
+					checkAvailability(foundResource, metav1.ConditionTrue)
+
+					foundOldQs := &consolev1.ConsoleQuickStart{}
+					Expect(cl.Get(context.Background(), client.ObjectKeyFromObject(oldQs), foundOldQs)).To(HaveOccurred())
+
+					Expect(searchInRelatedObjects(foundResource.Status.RelatedObjects, "ConsoleQuickStart", oldQSName)).To(BeFalse())
+				})
+
 			})
 		})
 
@@ -2356,6 +2464,228 @@ progressTimeout: 150`,
 			})
 		})
 
+		Context("Targeted reconciliation", func() {
+			It("should encode the triggering secondary CR in the request", func() {
+				ph, err := getSecondaryCRPlaceholder()
+				Expect(err).ToNot(HaveOccurred())
+
+				cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "some-cm", Namespace: namespace}}
+				rq := getSecondaryCRRequest(ph, schema.GroupKind{Kind: "ConfigMap"}, cm)
+
+				hcoTriggered, err := isTriggeredByHyperConverged(rq)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(hcoTriggered).To(BeFalse())
+
+				trigger, err := getTriggeringObject(rq)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(trigger).To(Equal(&common.TriggeringObject{
+					GroupKind: schema.GroupKind{Kind: "ConfigMap"},
+					Namespace: namespace,
+					Name:      "some-cm",
+				}))
+
+				By("a cluster scoped resource of a group")
+				pc := operands.NewKubeVirtPriorityClass(commonTestUtils.NewHco())
+				rq = getSecondaryCRRequest(ph, schema.GroupKind{Group: "scheduling.k8s.io", Kind: "PriorityClass"}, pc)
+				trigger, err = getTriggeringObject(rq)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(trigger).To(Equal(&common.TriggeringObject{
+					GroupKind: schema.GroupKind{Group: "scheduling.k8s.io", Kind: "PriorityClass"},
+					Name:      pc.Name,
+				}))
+
+				By("the placeholder does not identify the secondary CR")
+				hcoTriggered, err = isTriggeredByHyperConverged(reconcile.Request{NamespacedName: ph})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(hcoTriggered).To(BeFalse())
+				trigger, err = getTriggeringObject(reconcile.Request{NamespacedName: ph})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(trigger).To(BeNil())
+
+				By("a request of the HyperConverged CR")
+				hcoTriggered, err = isTriggeredByHyperConverged(request)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(hcoTriggered).To(BeTrue())
+			})
+
+			It("should ensure only the operand of the triggering secondary CR", func() {
+				expected := getBasicDeployment()
+				cl := expected.initClient()
+				r := initReconciler(cl, nil)
+
+				// a full reconciliation, to learn which operand deployed each resource
+				res, err := r.Reconcile(context.TODO(), request)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res).Should(Equal(reconcile.Result{}))
+
+				foundKubevirt := &kubevirtv1.KubeVirt{}
+				Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(expected.kv), foundKubevirt)).To(Succeed())
+				foundKubevirt.Spec.UninstallStrategy = kubevirtv1.KubeVirtUninstallStrategyRemoveWorkloads
+				Expect(cl.Update(context.TODO(), foundKubevirt)).To(Succeed())
+				Expect(cl.Delete(context.TODO(), expected.cdi)).To(Succeed())
+
+				ph, err := getSecondaryCRPlaceholder()
+				Expect(err).ToNot(HaveOccurred())
+				res, err = r.Reconcile(context.TODO(), getSecondaryCRRequest(ph, kubevirtv1.KubeVirtGroupVersionKind.GroupKind(), foundKubevirt))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res).Should(Equal(reconcile.Result{}))
+
+				Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(expected.kv), foundKubevirt)).To(Succeed())
+				Expect(foundKubevirt.Spec.UninstallStrategy).To(Equal(kubevirtv1.KubeVirtUninstallStrategyBlockUninstallIfWorkloadsExist))
+				err = cl.Get(context.TODO(), client.ObjectKeyFromObject(expected.cdi), &cdiv1beta1.CDI{})
+				Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+				By("the CDI CR triggers its own reconciliation")
+				res, err = r.Reconcile(context.TODO(), getSecondaryCRRequest(ph, cdiv1beta1.SchemeGroupVersion.WithKind("CDI").GroupKind(), expected.cdi))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res).Should(Equal(reconcile.Result{}))
+				Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(expected.cdi), &cdiv1beta1.CDI{})).To(Succeed())
+			})
+
+			It("should ignore the status only updates of the resources that HCO does not read the status of", func() {
+				pred := ignoreStatusUpdatesPredicate()
+
+				oldSvc := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "svc", Namespace: namespace, ResourceVersion: "1"}}
+				newSvc := oldSvc.DeepCopy()
+				newSvc.ResourceVersion = "2"
+				newSvc.Status.LoadBalancer.Ingress = []corev1.LoadBalancerIngress{{IP: "1.2.3.4"}}
+				Expect(pred.Update(event.UpdateEvent{ObjectOld: oldSvc, ObjectNew: newSvc})).To(BeFalse())
+
+				newSvc.Labels = map[string]string{"key": "value"}
+				Expect(pred.Update(event.UpdateEvent{ObjectOld: oldSvc, ObjectNew: newSvc})).To(BeTrue())
+
+				Expect(isStatusReadByHCO(&corev1.Service{})).To(BeFalse())
+				Expect(isStatusReadByHCO(&kubevirtv1.KubeVirt{})).To(BeTrue())
+				Expect(isStatusReadByHCO(operands.NewEmptyHostPathProvisioner())).To(BeTrue())
+			})
+		})
+
+		Context("Reconcile metrics", func() {
+			It("should record the duration, the trigger and the conditions of the reconciliation", func() {
+				expected := getBasicDeployment()
+				cl := expected.initClient()
+				r := initReconciler(cl, nil)
+
+				durations, err := metrics.HcoMetrics.GetReconcileDurationCount()
+				Expect(err).ToNot(HaveOccurred())
+				hcoTriggers, err := metrics.HcoMetrics.GetReconcileTriggerCount(hcoutil.HyperConvergedKind)
+				Expect(err).ToNot(HaveOccurred())
+
+				res, err := r.Reconcile(context.TODO(), request)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res).Should(Equal(reconcile.Result{}))
+
+				Expect(metrics.HcoMetrics.GetReconcileDurationCount()).To(Equal(durations + 1))
+				Expect(metrics.HcoMetrics.GetReconcileTriggerCount(hcoutil.HyperConvergedKind)).To(Equal(hcoTriggers + 1))
+
+				foundResource := &hcov1beta1.HyperConverged{}
+				Expect(cl.Get(context.TODO(), request.NamespacedName, foundResource)).To(Succeed())
+				for _, condition := range foundResource.Status.Conditions {
+					Expect(metrics.HcoMetrics.GetCondition(condition.Type, condition.Status)).To(BeEquivalentTo(1))
+					for _, status := range []metav1.ConditionStatus{metav1.ConditionTrue, metav1.ConditionFalse, metav1.ConditionUnknown} {
+						if status != condition.Status {
+							Expect(metrics.HcoMetrics.GetCondition(condition.Type, status)).To(BeEquivalentTo(0))
+						}
+					}
+				}
+
+				By("counting the reconciliations triggered by a secondary CR, by its kind")
+				kvTriggers, err := metrics.HcoMetrics.GetReconcileTriggerCount("KubeVirt")
+				Expect(err).ToNot(HaveOccurred())
+
+				ph, err := getSecondaryCRPlaceholder()
+				Expect(err).ToNot(HaveOccurred())
+				_, err = r.Reconcile(context.TODO(), getSecondaryCRRequest(ph, schema.GroupKind{Group: "kubevirt.io", Kind: "KubeVirt"}, expected.kv))
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(metrics.HcoMetrics.GetReconcileTriggerCount("KubeVirt")).To(Equal(kvTriggers + 1))
+				Expect(metrics.HcoMetrics.GetReconcileTriggerCount(hcoutil.HyperConvergedKind)).To(Equal(hcoTriggers + 1))
+			})
+		})
+
+		Context("Component versions", func() {
+			const (
+				expectedVersion   = "v1.2.3"
+				unexpectedVersion = "v1.2.2"
+			)
+
+			var origVersion string
+
+			BeforeEach(func() {
+				origVersion = os.Getenv(hcoutil.KubevirtVersionEnvV)
+				Expect(os.Setenv(hcoutil.KubevirtVersionEnvV, expectedVersion)).To(Succeed())
+			})
+
+			AfterEach(func() {
+				Expect(os.Setenv(hcoutil.KubevirtVersionEnvV, origVersion)).To(Succeed())
+			})
+
+			getKvVersion := func(hco *hcov1beta1.HyperConverged) hcov1beta1.Version {
+				for _, v := range hco.Status.Versions {
+					if v.Name == "KubeVirt" {
+						return v
+					}
+				}
+				Fail("the version of KubeVirt is not reported")
+				return hcov1beta1.Version{}
+			}
+
+			It("should report the observed and the expected versions of the operands", func() {
+				expected := getBasicDeployment()
+				expected.kv.Status.ObservedKubeVirtVersion = expectedVersion
+				cl := expected.initClient()
+				r := initReconciler(cl, nil)
+
+				_, err := r.Reconcile(context.TODO(), request)
+				Expect(err).ToNot(HaveOccurred())
+
+				foundResource := &hcov1beta1.HyperConverged{}
+				Expect(cl.Get(context.TODO(), request.NamespacedName, foundResource)).To(Succeed())
+				Expect(getKvVersion(foundResource)).To(Equal(hcov1beta1.Version{Name: "KubeVirt", Version: expectedVersion, ExpectedVersion: expectedVersion}))
+				Expect(apimetav1.FindStatusCondition(foundResource.Status.Conditions, hcov1beta1.ConditionComponentVersionMismatch)).To(BeNil())
+
+				kvVersion := metrics.ComponentVersion{Name: "KubeVirt", Expected: expectedVersion, Observed: expectedVersion}
+				Expect(metrics.HcoMetrics.GetComponentVersion(kvVersion)).To(BeEquivalentTo(1))
+			})
+
+			It("should raise a condition if an operand runs a version other than the one HCO ships", func() {
+				expected := getBasicDeployment()
+				expected.kv.Status.ObservedKubeVirtVersion = unexpectedVersion
+				cl := expected.initClient()
+				r := initReconciler(cl, nil)
+
+				_, err := r.Reconcile(context.TODO(), request)
+				Expect(err).ToNot(HaveOccurred())
+
+				foundResource := &hcov1beta1.HyperConverged{}
+				Expect(cl.Get(context.TODO(), request.NamespacedName, foundResource)).To(Succeed())
+				Expect(getKvVersion(foundResource)).To(Equal(hcov1beta1.Version{Name: "KubeVirt", Version: unexpectedVersion, ExpectedVersion: expectedVersion}))
+
+				cond := apimetav1.FindStatusCondition(foundResource.Status.Conditions, hcov1beta1.ConditionComponentVersionMismatch)
+				Expect(cond).ToNot(BeNil())
+				Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+				Expect(cond.Reason).To(Equal(versionMismatchReason))
+				Expect(cond.Message).To(ContainSubstring("KubeVirt (v1.2.2, expected v1.2.3)"))
+
+				kvVersion := metrics.ComponentVersion{Name: "KubeVirt", Expected: expectedVersion, Observed: unexpectedVersion}
+				Expect(metrics.HcoMetrics.GetComponentVersion(kvVersion)).To(BeEquivalentTo(1))
+
+				By("removing the condition when the operand runs the expected version")
+				kv := &kubevirtv1.KubeVirt{}
+				Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(expected.kv), kv)).To(Succeed())
+				kv.Status.ObservedKubeVirtVersion = expectedVersion
+				Expect(cl.Update(context.TODO(), kv)).To(Succeed())
+
+				_, err = r.Reconcile(context.TODO(), request)
+				Expect(err).ToNot(HaveOccurred())
+
+				foundResource = &hcov1beta1.HyperConverged{}
+				Expect(cl.Get(context.TODO(), request.NamespacedName, foundResource)).To(Succeed())
+				Expect(getKvVersion(foundResource).Version).To(Equal(expectedVersion))
+				Expect(apimetav1.FindStatusCondition(foundResource.Status.Conditions, hcov1beta1.ConditionComponentVersionMismatch)).To(BeNil())
+			})
+		})
+
 		Context("Update Conflict Error", func() {
 			It("Should requeue in case of update conflict", func() {
 				expected := getBasicDeployment()
@@ -2404,6 +2734,77 @@ progressTimeout: 150`,
 			})
 		})
 
+		Context("Detection of not enforced operands", func() {
+			var (
+				hco *hcov1beta1.HyperConverged
+			)
+			BeforeEach(func() {
+				hco = commonTestUtils.NewHco()
+				hco.Status.UpdateVersion(hcoVersionName, version.Version)
+				_ = os.Setenv(hcoutil.HcoKvIoVersionName, version.Version)
+			})
+
+			It("Raises an OperandsNotEnforced condition if an operand is not enforced", func() {
+				hco.Spec.ReconcilePolicies = []hcov1beta1.OperandReconcilePolicy{
+					{Kind: "KubeVirt", ReconcilePolicy: hcov1beta1.ReconcilePolicyWarnOnly},
+					{Kind: "CDI", ReconcilePolicy: hcov1beta1.ReconcilePolicyPaused},
+					{Kind: "SSP", ReconcilePolicy: hcov1beta1.ReconcilePolicyEnforce},
+				}
+
+				cl := commonTestUtils.InitClient([]runtime.Object{hco})
+				r := initReconciler(cl, nil)
+
+				_, err := r.Reconcile(context.TODO(), request)
+				Expect(err).ToNot(HaveOccurred())
+
+				foundResource := &hcov1beta1.HyperConverged{}
+				Expect(
+					cl.Get(context.TODO(),
+						types.NamespacedName{Name: hco.Name, Namespace: hco.Namespace},
+						foundResource),
+				).To(Succeed())
+
+				Expect(foundResource.Status.Conditions).To(ContainElement(commonTestUtils.RepresentCondition(metav1.Condition{
+					Type:    hcov1beta1.ConditionOperandsNotEnforced,
+					Status:  metav1.ConditionTrue,
+					Reason:  string(hcov1beta1.ReconcilePolicyPaused),
+					Message: fmt.Sprintf(operandsNotEnforcedMessage, "KubeVirt (WarnOnly), CDI (Paused)"),
+				})))
+
+				Expect(metrics.HcoMetrics.GetNotEnforcedOperand("CDI", "Paused")).To(BeEquivalentTo(1))
+				Expect(metrics.HcoMetrics.GetNotEnforcedOperand("KubeVirt", "WarnOnly")).To(BeEquivalentTo(1))
+
+				By("the paused operand should not be created", func() {
+					cdi := operands.NewCDIWithNameOnly(hco)
+					err := cl.Get(context.TODO(), types.NamespacedName{Name: cdi.Name, Namespace: cdi.Namespace}, cdi)
+					Expect(apierrors.IsNotFound(err)).To(BeTrue())
+				})
+			})
+
+			It("Removes the OperandsNotEnforced condition if all the operands are enforced", func() {
+				hco.Status.Conditions = append(hco.Status.Conditions, metav1.Condition{
+					Type:   hcov1beta1.ConditionOperandsNotEnforced,
+					Status: metav1.ConditionTrue,
+					Reason: string(hcov1beta1.ReconcilePolicyPaused),
+				})
+
+				cl := commonTestUtils.InitClient([]runtime.Object{hco})
+				r := initReconciler(cl, nil)
+
+				_, err := r.Reconcile(context.TODO(), request)
+				Expect(err).ToNot(HaveOccurred())
+
+				foundResource := &hcov1beta1.HyperConverged{}
+				Expect(
+					cl.Get(context.TODO(),
+						types.NamespacedName{Name: hco.Name, Namespace: hco.Namespace},
+						foundResource),
+				).To(Succeed())
+
+				Expect(apimetav1.FindStatusCondition(foundResource.Status.Conditions, hcov1beta1.ConditionOperandsNotEnforced)).To(BeNil())
+			})
+		})
+
 		Context("Detection of a tainted configuration", func() {
 			var (
 				hco *hcov1beta1.HyperConverged
@@ -3751,3 +4152,7 @@ func searchInRelatedObjects(relatedObjects []corev1.ObjectReference, kind, name
 	}
 	return false
 }
+
+func boolPtr(b bool) *bool {
+	return &b
+}
@@ -0,0 +1,258 @@
+package hyperconverged
+
+import (
+	"fmt"
+
+	objectreferencesv1 "github.com/openshift/custom-resource-status/objectreferences/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimetav1 "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/controller/common"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+// ObsoleteResourcesDryRunAnnotation is the annotation of the HyperConverged CR, that makes HCO only report the obsolete
+// resources that it would remove during the upgrade, instead of removing them
+const ObsoleteResourcesDryRunAnnotation = "hco.kubevirt.io/obsoleteResourcesDryRun"
+
+// obsoleteResources are resources that HCO does not use anymore, and so are removed during the upgrade
+type obsoleteResources struct {
+	// reason describes why the resources are obsolete
+	reason string
+	// obsoleteSince is the first HCO version that does not use the resources; they are removed when upgrading from an
+	// older version. Empty if the resources are removed in any upgrade
+	obsoleteSince string
+	// crds are the kinds whose CRDs are removed. Removing a CRD also removes its CRs, and so, once the CRD is removed,
+	// its kind is also removed from the related objects
+	crds []schema.GroupKind
+	// objects are the named objects to remove. An object is removed only if it was deployed by HCO
+	objects []obsoleteObject
+	// relatedObjects are the kinds to remove from the related objects, in addition to the kinds of the removed CRDs
+	relatedObjects []schema.GroupKind
+}
+
+// obsoleteObject is a named object to remove
+type obsoleteObject struct {
+	gvk schema.GroupVersionKind
+	// namespace is empty for a cluster-scoped object, or for an object in the namespace of HCO
+	namespace string
+	name      string
+}
+
+// obsoleteResourcesInventory is the list of the obsolete resources. To retire a resource, add it here.
+var obsoleteResourcesInventory = []obsoleteResources{
+	{
+		reason: "v2v was replaced by MTV",
+		crds: []schema.GroupKind{
+			{Group: v2vGroup, Kind: "V2VVmware"},
+			{Group: v2vGroup, Kind: "OVirtProvider"},
+			{Group: v2vGroup, Kind: "VMImportConfig"},
+		},
+	},
+	{
+		reason: `the 2nd generation SSP CRDs, with the "ssp.kubevirt.io" group, were replaced by the SSP CRD`,
+		crds: []schema.GroupKind{
+			{Group: prevSspGroup, Kind: "KubevirtCommonTemplatesBundle"},
+			{Group: prevSspGroup, Kind: "KubevirtNodeLabellerBundle"},
+			{Group: prevSspGroup, Kind: "KubevirtTemplateValidator"},
+			{Group: prevSspGroup, Kind: "KubevirtMetricsAggregation"},
+		},
+	},
+	{
+		reason: `the original SSP CRDs, with the "kubevirt.io" group, were replaced by the SSP CRD`,
+		crds: []schema.GroupKind{
+			{Group: origSspGroup, Kind: "KubevirtCommonTemplatesBundle"},
+			{Group: origSspGroup, Kind: "KubevirtNodeLabellerBundle"},
+			{Group: origSspGroup, Kind: "KubevirtTemplateValidator"},
+			{Group: origSspGroup, Kind: "KubevirtMetricsAggregation"},
+		},
+	},
+}
+
+// obsoleteResourcesGC removes the obsolete resources of the inventory that apply to the source version of the upgrade
+type obsoleteResourcesGC struct {
+	client       client.Client
+	eventEmitter hcoutil.EventEmitter
+	inventory    []obsoleteResources
+	// dryRun only reports the obsolete resources that exist, without removing them
+	dryRun bool
+}
+
+// newObsoleteResourcesGC returns a new obsoleteResourcesGC of the obsolete resources inventory
+func newObsoleteResourcesGC(cl client.Client, eventEmitter hcoutil.EventEmitter, hc *hcov1beta1.HyperConverged) *obsoleteResourcesGC {
+	return &obsoleteResourcesGC{
+		client:       cl,
+		eventEmitter: eventEmitter,
+		inventory:    obsoleteResourcesInventory,
+		dryRun:       hc.GetAnnotations()[ObsoleteResourcesDryRunAnnotation] == "true",
+	}
+}
+
+// collect removes the obsolete resources. Returns true if there is still something to remove, or to validate that it
+// was removed, in the next reconciliation; e.g. if failed to remove a resource. In dry-run mode, nothing is removed,
+// and so there is nothing to wait for.
+func (gc *obsoleteResourcesGC) collect(req *common.HcoRequest) (bool, error) {
+	sourceVersion := getUpgradeSourceVersion(req)
+
+	pending := false
+	removedKinds := make(map[schema.GroupKind]bool)
+	for _, resources := range gc.inventory {
+		if !isVersionInRange(sourceVersion, "", resources.obsoleteSince) {
+			continue
+		}
+
+		for _, gk := range resources.crds {
+			if gc.removeCRD(req, gk, resources.reason) {
+				removedKinds[gk] = true
+			} else {
+				// we'll retry in the next reconciliation loop
+				pending = true
+			}
+		}
+
+		for _, obj := range resources.objects {
+			if !gc.removeObject(req, obj, resources.reason) {
+				pending = true
+			}
+		}
+
+		for _, gk := range resources.relatedObjects {
+			removedKinds[gk] = true
+		}
+	}
+
+	if gc.dryRun {
+		return false, nil
+	}
+
+	// The kinds that were removed from the related objects list are kept around, so that the next reconciliation loop
+	// can validate that they were actually removed, and not lost in some failing status update.
+	removedRelatedObjects, err := removeRelatedObjectsOfKinds(req, removedKinds)
+	if err != nil {
+		return true, err
+	}
+
+	return pending || removedRelatedObjects, nil
+}
+
+// removeCRD removes the CRD of a kind. Returns true if the CRD does not exist, or if its deletion succeeded.
+func (gc *obsoleteResourcesGC) removeCRD(req *common.HcoRequest, gk schema.GroupKind, reason string) bool {
+	// the discovery knows the kind only if its CRD exists
+	mapping, err := gc.client.RESTMapper().RESTMapping(gk)
+	if err != nil {
+		if apimetav1.IsNoMatchError(err) {
+			return true
+		}
+		req.Logger.Error(err, fmt.Sprintf("failed to read the resource name of the %s kind", gk.String()))
+		return false
+	}
+
+	crdName := fmt.Sprintf("%s.%s", mapping.Resource.Resource, gk.Group)
+	found := &apiextensionsv1.CustomResourceDefinition{}
+	key := client.ObjectKey{Namespace: hcoutil.UndefinedNamespace, Name: crdName}
+	if err = gc.client.Get(req.Ctx, key, found); err != nil {
+		if apierrors.IsNotFound(err) {
+			return true
+		}
+		req.Logger.Error(err, fmt.Sprintf("failed to read the %s CRD; %s", crdName, err.Error()))
+		return false
+	}
+
+	return gc.remove(req, found, "CustomResourceDefinition", reason)
+}
+
+// removeObject removes a named object, if it was deployed by HCO. Returns true if the object does not exist, or if it
+// was not deployed by HCO, or if its deletion succeeded.
+func (gc *obsoleteResourcesGC) removeObject(req *common.HcoRequest, obj obsoleteObject, reason string) bool {
+	found := &unstructured.Unstructured{}
+	found.SetGroupVersionKind(obj.gvk)
+	key := client.ObjectKey{Namespace: obj.namespace, Name: obj.name}
+	if key.Namespace == "" && !gc.isClusterScoped(obj.gvk) {
+		key.Namespace = req.Namespace
+	}
+
+	if err := gc.client.Get(req.Ctx, key, found); err != nil {
+		if apierrors.IsNotFound(err) || apimetav1.IsNoMatchError(err) {
+			return true
+		}
+		req.Logger.Error(err, fmt.Sprintf("failed to read the %s %s", obj.gvk.Kind, obj.name))
+		return false
+	}
+
+	if found.GetLabels()[hcoutil.AppLabel] != req.Name {
+		req.Logger.Info("Existing obsolete resource wasn't deployed by HCO, ignoring", "Kind", obj.gvk.Kind, "name", obj.name)
+		return true
+	}
+
+	return gc.remove(req, found, obj.gvk.Kind, reason)
+}
+
+func (gc *obsoleteResourcesGC) isClusterScoped(gvk schema.GroupVersionKind) bool {
+	mapping, err := gc.client.RESTMapper().RESTMapping(gvk.GroupKind(), gvk.Version)
+	return err == nil && mapping.Scope.Name() == apimetav1.RESTScopeNameRoot
+}
+
+// remove removes an existing obsolete resource, or only reports it in dry-run mode. Returns true if the deletion
+// succeeded.
+func (gc *obsoleteResourcesGC) remove(req *common.HcoRequest, obj client.Object, kind, reason string) bool {
+	name := obj.GetName()
+	if obj.GetNamespace() != "" {
+		name = obj.GetNamespace() + "/" + name
+	}
+
+	if gc.dryRun {
+		req.Logger.Info("dry run: found an obsolete resource", "Kind", kind, "name", name, "reason", reason)
+		gc.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeNormal, "ObsoleteResource", fmt.Sprintf("Dry run: would remove %s %s, because %s", kind, name, reason))
+		return true
+	}
+
+	if err := gc.client.Delete(req.Ctx, obj); err != nil && !apierrors.IsNotFound(err) {
+		req.Logger.Error(err, fmt.Sprintf("failed to remove the %s %s; %s", kind, name, err.Error()))
+		return false
+	}
+
+	req.Logger.Info("successfully removed an obsolete resource", "Kind", kind, "name", name, "reason", reason)
+	gc.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeNormal, "Killing", fmt.Sprintf("Removed %s %s", kind, name))
+	return true
+}
+
+// removeRelatedObjectsOfKinds removes the objects of the given kinds from the list of related objects in HCO status.
+// Returns true if any of them was removed.
+func removeRelatedObjectsOfKinds(req *common.HcoRequest, kinds map[schema.GroupKind]bool) (bool, error) {
+	if len(kinds) == 0 {
+		return false, nil
+	}
+
+	objRefsToRemove := make([]corev1.ObjectReference, 0, len(kinds))
+	for _, objRef := range req.Instance.Status.RelatedObjects {
+		if kinds[objRef.GroupVersionKind().GroupKind()] {
+			objRefsToRemove = append(objRefsToRemove, objRef)
+		}
+	}
+
+	if len(objRefsToRemove) == 0 {
+		return false, nil
+	}
+
+	req.StatusDirty = true
+	for _, objRef := range objRefsToRemove {
+		err := objectreferencesv1.RemoveObjectReference(&req.Instance.Status.RelatedObjects, objRef)
+		if err != nil {
+			// This shouldn't really happen, but...
+			req.Logger.Error(err, "Failed removing object reference from HCO.Status.RelatedObjects",
+				"ObjectReference.Name", objRef.Name,
+				"ObjectReference.Namespace", objRef.Namespace,
+				"ObjectReference.Kind", objRef.Kind,
+				"ObjectReference.APIVersion", objRef.APIVersion)
+			return true, err
+		}
+	}
+
+	return true, nil
+}
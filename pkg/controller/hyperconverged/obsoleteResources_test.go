@@ -0,0 +1,229 @@
+package hyperconverged
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/controller/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/controller/commonTestUtils"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+var _ = Describe("Obsolete Resources GC", func() {
+	const obsoleteGroup = "obsolete.kubevirt.io"
+
+	var (
+		hco          *hcov1beta1.HyperConverged
+		req          *common.HcoRequest
+		eventEmitter *commonTestUtils.EventEmitterMock
+
+		policyGK     = schema.GroupKind{Group: obsoleteGroup, Kind: "ObsoletePolicy"}
+		obsoleteCRD  *apiextensionsv1.CustomResourceDefinition
+		obsoleteCm   *corev1.ConfigMap
+		policyObjRef = corev1.ObjectReference{
+			APIVersion: obsoleteGroup + "/v1",
+			Kind:       "ObsoletePolicy",
+			Name:       "obsolete-policy",
+			Namespace:  namespace,
+		}
+		configMapObjRef = corev1.ObjectReference{
+			APIVersion: "v1",
+			Kind:       "ConfigMap",
+			Name:       "obsolete-cm",
+			Namespace:  namespace,
+		}
+		otherObjRef = corev1.ObjectReference{
+			APIVersion: "v1",
+			Kind:       "Service",
+			Name:       "other-service",
+			Namespace:  namespace,
+		}
+	)
+
+	newGC := func(cl client.Client, inventory ...obsoleteResources) *obsoleteResourcesGC {
+		gc := newObsoleteResourcesGC(cl, eventEmitter, hco)
+		gc.inventory = inventory
+		return gc
+	}
+
+	crdExists := func(cl client.Client, name string) bool {
+		err := cl.Get(context.TODO(), client.ObjectKey{Name: name}, &apiextensionsv1.CustomResourceDefinition{})
+		if apierrors.IsNotFound(err) {
+			return false
+		}
+		Expect(err).ToNot(HaveOccurred())
+		return true
+	}
+
+	cmExists := func(cl client.Client) bool {
+		err := cl.Get(context.TODO(), client.ObjectKey{Name: obsoleteCm.Name, Namespace: namespace}, &corev1.ConfigMap{})
+		if apierrors.IsNotFound(err) {
+			return false
+		}
+		Expect(err).ToNot(HaveOccurred())
+		return true
+	}
+
+	BeforeEach(func() {
+		hco = commonTestUtils.NewHco()
+		hco.Status.RelatedObjects = []corev1.ObjectReference{policyObjRef, configMapObjRef, otherObjRef}
+		req = commonTestUtils.NewReq(hco)
+		eventEmitter = commonTestUtils.NewEventEmitterMock()
+
+		// the plural of the kind is not the kind with an "s" suffix
+		obsoleteCRD = newCRD("obsoletepolicies", obsoleteGroup, "ObsoletePolicy")
+		obsoleteCm = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "obsolete-cm",
+				Namespace: namespace,
+				Labels: map[string]string{
+					hcoutil.AppLabel: hco.Name,
+				},
+			},
+		}
+	})
+
+	It("should remove a CRD by the resource name of its kind, and then remove its kind from the related objects", func() {
+		cl := commonTestUtils.InitClient([]runtime.Object{obsoleteCRD})
+		gc := newGC(cl, obsoleteResources{reason: "testing", crds: []schema.GroupKind{policyGK}})
+
+		pending, err := gc.collect(req)
+		Expect(err).ToNot(HaveOccurred())
+		// the next reconciliation validates the status update
+		Expect(pending).To(BeTrue())
+
+		Expect(crdExists(cl, obsoleteCRD.Name)).To(BeFalse())
+		Expect(hco.Status.RelatedObjects).To(Equal([]corev1.ObjectReference{configMapObjRef, otherObjRef}))
+		Expect(req.StatusDirty).To(BeTrue())
+		Expect(eventEmitter.CheckEvents([]commonTestUtils.MockEvent{
+			{
+				EventType: corev1.EventTypeNormal,
+				Reason:    "Killing",
+				Msg:       "Removed CustomResourceDefinition obsoletepolicies.obsolete.kubevirt.io",
+			},
+		})).To(BeTrue())
+
+		By("run again")
+		pending, err = gc.collect(req)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pending).To(BeFalse())
+	})
+
+	It("should retry to remove a CRD if the deletion failed", func() {
+		cl := commonTestUtils.InitClient([]runtime.Object{obsoleteCRD})
+		cl.InitiateDeleteErrors(func(obj client.Object) error {
+			return errors.New("fake delete error")
+		})
+		gc := newGC(cl, obsoleteResources{reason: "testing", crds: []schema.GroupKind{policyGK}})
+
+		pending, err := gc.collect(req)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pending).To(BeTrue())
+
+		Expect(crdExists(cl, obsoleteCRD.Name)).To(BeTrue())
+		Expect(hco.Status.RelatedObjects).To(ContainElement(policyObjRef))
+	})
+
+	It("should remove a named object that was deployed by HCO", func() {
+		cl := commonTestUtils.InitClient([]runtime.Object{obsoleteCm})
+		gc := newGC(cl, obsoleteResources{
+			reason: "testing",
+			objects: []obsoleteObject{
+				{gvk: corev1.SchemeGroupVersion.WithKind("ConfigMap"), name: obsoleteCm.Name},
+			},
+			relatedObjects: []schema.GroupKind{{Kind: "ConfigMap"}},
+		})
+
+		pending, err := gc.collect(req)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pending).To(BeTrue())
+
+		Expect(cmExists(cl)).To(BeFalse())
+		Expect(hco.Status.RelatedObjects).To(Equal([]corev1.ObjectReference{policyObjRef, otherObjRef}))
+		Expect(eventEmitter.CheckEvents([]commonTestUtils.MockEvent{
+			{
+				EventType: corev1.EventTypeNormal,
+				Reason:    "Killing",
+				Msg:       "Removed ConfigMap " + namespace + "/obsolete-cm",
+			},
+		})).To(BeTrue())
+	})
+
+	It("should not remove a named object that was not deployed by HCO", func() {
+		obsoleteCm.Labels = nil
+		cl := commonTestUtils.InitClient([]runtime.Object{obsoleteCm})
+		gc := newGC(cl, obsoleteResources{
+			reason: "testing",
+			objects: []obsoleteObject{
+				{gvk: corev1.SchemeGroupVersion.WithKind("ConfigMap"), name: obsoleteCm.Name},
+			},
+		})
+
+		pending, err := gc.collect(req)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pending).To(BeFalse())
+
+		Expect(cmExists(cl)).To(BeTrue())
+	})
+
+	It("should not remove resources that are still used by the source version of the upgrade", func() {
+		hco.Status.Upgrade = &hcov1beta1.UpgradeStatus{SourceVersion: "1.6.0", TargetVersion: "1.7.0"}
+		cl := commonTestUtils.InitClient([]runtime.Object{obsoleteCRD})
+		gc := newGC(cl,
+			obsoleteResources{reason: "testing", obsoleteSince: "1.7.0", crds: []schema.GroupKind{policyGK}},
+			obsoleteResources{reason: "testing", obsoleteSince: "1.6.0", relatedObjects: []schema.GroupKind{{Kind: "ConfigMap"}}},
+		)
+
+		pending, err := gc.collect(req)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pending).To(BeTrue())
+
+		Expect(crdExists(cl, obsoleteCRD.Name)).To(BeFalse())
+		Expect(hco.Status.RelatedObjects).To(Equal([]corev1.ObjectReference{configMapObjRef, otherObjRef}))
+	})
+
+	It("should only report the obsolete resources in dry-run mode", func() {
+		hco.Annotations = map[string]string{ObsoleteResourcesDryRunAnnotation: "true"}
+		cl := commonTestUtils.InitClient([]runtime.Object{obsoleteCRD, obsoleteCm})
+		gc := newGC(cl, obsoleteResources{
+			reason: "testing",
+			crds:   []schema.GroupKind{policyGK},
+			objects: []obsoleteObject{
+				{gvk: corev1.SchemeGroupVersion.WithKind("ConfigMap"), name: obsoleteCm.Name},
+			},
+		})
+		Expect(gc.dryRun).To(BeTrue())
+
+		pending, err := gc.collect(req)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pending).To(BeFalse())
+
+		Expect(crdExists(cl, obsoleteCRD.Name)).To(BeTrue())
+		Expect(cmExists(cl)).To(BeTrue())
+		Expect(hco.Status.RelatedObjects).To(Equal([]corev1.ObjectReference{policyObjRef, configMapObjRef, otherObjRef}))
+		Expect(req.StatusDirty).To(BeFalse())
+		Expect(eventEmitter.CheckEvents([]commonTestUtils.MockEvent{
+			{
+				EventType: corev1.EventTypeNormal,
+				Reason:    "ObsoleteResource",
+				Msg:       "Dry run: would remove CustomResourceDefinition obsoletepolicies.obsolete.kubevirt.io, because testing",
+			},
+			{
+				EventType: corev1.EventTypeNormal,
+				Reason:    "ObsoleteResource",
+				Msg:       "Dry run: would remove ConfigMap " + namespace + "/obsolete-cm, because testing",
+			},
+		})).To(BeTrue())
+	})
+})
@@ -21,6 +21,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	networkaddonsv1 "github.com/kubevirt/cluster-network-addons-operator/pkg/apis/networkaddonsoperator/v1"
@@ -52,10 +53,12 @@ func initReconciler(client client.Client, old *ReconcileHyperConverged) *Reconci
 	upgradeMode := false
 	firstLoop := true
 	upgradeableCondition := newStubOperatorCondition()
+	health := hcoutil.NewHealthChecker(hcoutil.DefaultReconcileDeadline)
 	if old != nil {
 		upgradeMode = old.upgradeMode
 		firstLoop = old.firstLoop
 		upgradeableCondition = old.upgradeableCondition
+		health = old.health
 	}
 	// Create a ReconcileHyperConverged object with the scheme and fake client
 	return &ReconcileHyperConverged{
@@ -67,6 +70,7 @@ func initReconciler(client client.Client, old *ReconcileHyperConverged) *Reconci
 		ownVersion:           version.Version,
 		upgradeMode:          upgradeMode,
 		upgradeableCondition: upgradeableCondition,
+		health:               health,
 	}
 }
 
@@ -194,6 +198,7 @@ func getBasicDeployment() *BasicExpected {
 	ExpectWithOffset(1, err).ToNot(HaveOccurred())
 
 	expectedKV.ObjectMeta.SelfLink = fmt.Sprintf("/apis/v1/namespaces/%s/kubevirts/%s", expectedKV.Namespace, expectedKV.Name)
+	ExpectWithOffset(1, controllerutil.SetControllerReference(hco, expectedKV, commonTestUtils.GetScheme())).To(Succeed())
 	expectedKV.Status.Conditions = []kubevirtv1.KubeVirtCondition{
 		{
 			Type:   kubevirtv1.KubeVirtConditionAvailable,
@@ -249,6 +254,26 @@ func getBasicDeployment() *BasicExpected {
 	return res
 }
 
+// newCRD returns a CRD of a namespaced kind, with a single version
+func newCRD(plural, group, kind string) *apiextensionsv1.CustomResourceDefinition {
+	return &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: plural + "." + group,
+		},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Group: group,
+			Names: apiextensionsv1.CustomResourceDefinitionNames{
+				Plural: plural,
+				Kind:   kind,
+			},
+			Scope: apiextensionsv1.NamespaceScoped,
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+				{Name: "v1", Served: true, Storage: true},
+			},
+		},
+	}
+}
+
 // returns the HCO after reconcile, and the returned requeue
 func doReconcile(cl client.Client, hco *hcov1beta1.HyperConverged, old *ReconcileHyperConverged) (*hcov1beta1.HyperConverged, *ReconcileHyperConverged, bool) {
 	r := initReconciler(cl, old)
@@ -0,0 +1,362 @@
+package hyperconverged
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/blang/semver/v4"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	cdiv1beta1 "kubevirt.io/containerized-data-importer/pkg/apis/core/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/controller/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/controller/operands"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+// upgradeMigration is a data migration that runs during the upgrade of HCO, if the upgrade is from a version that the
+// migration applies to. A migration must be idempotent, because it runs again if the operator is restarted before its
+// completion is recorded in the upgrade status.
+type upgradeMigration struct {
+	// name identifies the migration in the completedMigrations field of the upgrade status
+	name string
+	// fromVersion is the oldest HCO version that the migration applies to; empty for any version
+	fromVersion string
+	// beforeVersion is the first HCO version that the migration does not apply to anymore; empty for any version
+	beforeVersion string
+	// migrate runs the migration. It returns true if it modified something; then the migration runs again in the next
+	// reconciliation. Otherwise, the migration is completed.
+	migrate func(m *migrator, req *common.HcoRequest) (bool, error)
+}
+
+// upgradeMigrations are the data migrations of the upgrade, in the order they run. To add a migration, add it here.
+var upgradeMigrations = []upgradeMigration{
+	{
+		name:          kvConfigMigration,
+		beforeVersion: "1.6.0",
+		migrate:       migrateKvConfigurations,
+	},
+	{
+		name:          cdiConfigMigration,
+		beforeVersion: "1.6.0",
+		migrate:       migrateCdiConfigurations,
+	},
+	{
+		name:          badDefaultsMigration,
+		beforeVersion: "1.6.0",
+		migrate:       amendBadDefaults,
+	},
+}
+
+// the names of the migrations, as recorded in the upgrade status
+const (
+	kvConfigMigration    = "KubeVirtConfigMap"
+	cdiConfigMigration   = "CdiConfig"
+	badDefaultsMigration = "BadDefaults"
+)
+
+const (
+	kvCmName         = "kubevirt-config"
+	backupKvCmName   = kvCmName + "-backup"
+	liveMigrationKey = "migrations"
+)
+
+// migrator runs the upgrade migrations, and provides them with the resources they need
+type migrator struct {
+	client       client.Client
+	eventEmitter hcoutil.EventEmitter
+}
+
+// migrateBeforeUpgrade performs the data migrations before starting the upgrade process.
+// Returns true if the HyperConverged CR was modified; else, return false
+func (r *ReconcileHyperConverged) migrateBeforeUpgrade(req *common.HcoRequest) (bool, error) {
+	m := &migrator{
+		client:       r.client,
+		eventEmitter: r.eventEmitter,
+	}
+
+	return m.run(req, upgradeMigrations)
+}
+
+// run runs the migrations that apply to the source version of the upgrade. Returns true if any of them modified
+// something.
+func (m *migrator) run(req *common.HcoRequest, migrations []upgradeMigration) (bool, error) {
+	sourceVersion := getUpgradeSourceVersion(req)
+
+	modified := false
+	for _, migration := range migrations {
+		if !migration.appliesTo(sourceVersion) {
+			req.Logger.Info(fmt.Sprintf("the %s migration does not apply to version %s; skipping it", migration.name, sourceVersion))
+			continue
+		}
+
+		migrate := migration.migrate
+		migrationModified, err := runUpgradeMigration(req, migration.name, func(req *common.HcoRequest) (bool, error) {
+			return migrate(m, req)
+		})
+		if err != nil {
+			return false, err
+		}
+		modified = modified || migrationModified
+	}
+
+	return modified, nil
+}
+
+// appliesTo checks if the migration applies to an upgrade from the source version. If the source version is unknown,
+// the migration applies.
+func (migration upgradeMigration) appliesTo(sourceVersion string) bool {
+	return isVersionInRange(sourceVersion, migration.fromVersion, migration.beforeVersion)
+}
+
+// isVersionInRange checks if the source version of the upgrade is in the range of [fromVersion, beforeVersion). An
+// empty bound is unlimited. If the source version is unknown, it is considered as in the range.
+func isVersionInRange(sourceVersion, fromVersion, beforeVersion string) bool {
+	if sourceVersion == "" {
+		return true
+	}
+
+	version, err := semver.ParseTolerant(sourceVersion)
+	if err != nil {
+		return true
+	}
+	// compare the release versions only, so a pre-release of a version is considered as this version
+	version.Pre = nil
+	version.Build = nil
+
+	if fromVersion != "" && version.LT(semver.MustParse(fromVersion)) {
+		return false
+	}
+
+	if beforeVersion != "" && version.GTE(semver.MustParse(beforeVersion)) {
+		return false
+	}
+
+	return true
+}
+
+// getUpgradeSourceVersion returns the version that HCO is upgraded from, or an empty string if it is unknown
+func getUpgradeSourceVersion(req *common.HcoRequest) string {
+	if req.Instance.Status.Upgrade == nil {
+		return ""
+	}
+	return req.Instance.Status.Upgrade.SourceVersion
+}
+
+// runUpgradeMigration runs a migration of the upgrade, unless it was already completed during this upgrade; e.g.
+// before the operator was restarted. The migration returns true if it modified something, and so it should run again
+// in the next reconciliation; otherwise, it is completed.
+func runUpgradeMigration(req *common.HcoRequest, migration string, migrate func(*common.HcoRequest) (bool, error)) (bool, error) {
+	if operands.IsMigrationCompleted(req.Instance, migration) {
+		req.Logger.Info(fmt.Sprintf("the %s migration was already completed; skipping it", migration))
+		return false, nil
+	}
+
+	modified, err := migrate(req)
+	if err != nil || modified {
+		return modified, err
+	}
+
+	if operands.SetMigrationCompleted(req.Instance, migration) {
+		req.Logger.Info(fmt.Sprintf("the %s migration is completed", migration))
+		req.StatusDirty = true
+	}
+	return false, nil
+}
+
+// migrateKvConfigurations adopts the configurations of the kubevirt-config configMap.
+//
+// If the kubevirt-config configMap exists:
+// 1. create a backup of the configMap, if not exists
+// 2. if the configMap includes the live migration configurations, and they are not match to the default values,
+//    update the HyperConverged CR
+// 3. remove the kubevirt-config configMap
+// 4. return true if the CR was modified in #2
+func migrateKvConfigurations(m *migrator, req *common.HcoRequest) (bool, error) {
+	cm, err := m.getCm(kvCmName, req)
+	if err != nil {
+		return false, err
+	} else if cm == nil {
+		return false, nil
+	}
+
+	if err = m.makeCmBackup(cm, backupKvCmName, req); err != nil {
+		return false, err
+	}
+
+	modified := adoptOldKvConfigs(req, cm)
+
+	if !modified {
+		err = m.removeConfigMap(req, cm)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	return modified, nil
+}
+
+func migrateCdiConfigurations(m *migrator, req *common.HcoRequest) (bool, error) {
+	cdi := operands.NewCDIWithNameOnly(req.Instance)
+
+	err := hcoutil.GetRuntimeObject(req.Ctx, m.client, cdi, req.Logger)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return adoptCdiConfigs(req, cdi.Spec.Config), nil
+}
+
+func amendBadDefaults(_ *migrator, req *common.HcoRequest) (bool, error) {
+	modified := false
+	if req.Instance.Spec.LiveMigrationConfig.BandwidthPerMigration != nil && *req.Instance.Spec.LiveMigrationConfig.BandwidthPerMigration == "64Mi" {
+		req.Logger.Info("rejecting spec.livemigrationconfig.bandwidthpermigration==64Mi because it was a bad default value")
+		req.Instance.Spec.LiveMigrationConfig.BandwidthPerMigration = nil
+		modified = true
+		req.Dirty = true
+	}
+	return modified, nil
+}
+
+func (m *migrator) removeConfigMap(req *common.HcoRequest, cm *corev1.ConfigMap) error {
+	req.Logger.Info("removing the kubevirt configMap")
+	err := hcoutil.ComponentResourceRemoval(req.Ctx, m.client, cm, req.Name, req.Logger, false, true)
+	if err != nil {
+		return err
+	}
+
+	m.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeNormal, "Killing", fmt.Sprintf("Removed ConfigMap %s", cm.Name))
+
+	removeRelatedObject(req, "ConfigMap", cm.Name, cm.Namespace)
+
+	return nil
+}
+
+func (m *migrator) getCm(cmName string, req *common.HcoRequest) (*corev1.ConfigMap, error) {
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      cmName,
+			Namespace: req.Namespace,
+		},
+	}
+
+	if err := hcoutil.GetRuntimeObject(req.Ctx, m.client, cm, req.Logger); err != nil {
+		if apierrors.IsNotFound(err) {
+			req.Logger.Info(fmt.Sprintf("%s configmap already removed", cmName))
+			return nil, nil
+		}
+		req.Logger.Info(fmt.Sprintf("failed to get %s configmap", cmName), "error", err.Error())
+		return nil, err
+	}
+
+	return cm, nil
+}
+
+func (m *migrator) makeCmBackup(cm *corev1.ConfigMap, backupName string, req *common.HcoRequest) error {
+	backupCm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      backupName,
+			Namespace: cm.Namespace,
+			Labels:    cm.Labels,
+		},
+		Data: cm.Data,
+	}
+
+	req.Logger.Info(fmt.Sprintf("creating %s configmap backup", backupName))
+	if err := m.client.Create(req.Ctx, backupCm); err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			req.Logger.Info(fmt.Sprintf("%s configmap backup already exists", backupName))
+		} else {
+			req.Logger.Info(fmt.Sprintf("failed to create %s configmap backup", backupName), "error", err.Error())
+			return err
+		}
+	} else {
+		m.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeNormal, "Created", fmt.Sprintf("Created ConfigMap %s", backupName))
+	}
+
+	return nil
+}
+
+// Read the old KubeVit configuration from the config map, and move them to the HyperConverged CR
+//
+// In case of wrong foramt of the configmap, the HCO ignores this error (but print it to the log) in order to prevent
+// an infinite loop (returning error will cause the same error again and again, and the only way to stop the loop
+// is to manually fix or delete the wrong configMap).
+func adoptOldKvConfigs(req *common.HcoRequest, cm *corev1.ConfigMap) bool {
+	modified := false
+	kvLiveMigrationConfig, ok := cm.Data[liveMigrationKey]
+	if !ok {
+		return false
+	}
+	kvCmLiveMigrationConfig := hcov1beta1.LiveMigrationConfigurations{}
+	req.Instance.Spec.LiveMigrationConfig.DeepCopyInto(&kvCmLiveMigrationConfig)
+	err := yaml.NewYAMLOrJSONDecoder(strings.NewReader(kvLiveMigrationConfig), 1024).Decode(&kvCmLiveMigrationConfig)
+	if err != nil {
+		req.Logger.Error(err, "Failed to read the KubeVirt ConfigMap, and its content was ignored. This ConfigMap will be deleted. The backup ConfigMap called "+backupKvCmName)
+		return false
+	}
+
+	if !reflect.DeepEqual(req.Instance.Spec.LiveMigrationConfig, kvCmLiveMigrationConfig) {
+		req.Logger.Info("updating the HyperConverged CR from the KubeVirt configMap")
+		kvConfigMapToHyperConvergedCr(req, kvCmLiveMigrationConfig)
+
+		modified = true
+		req.Dirty = true
+	}
+
+	return modified
+}
+
+func adoptCdiConfigs(req *common.HcoRequest, cdiCfg *cdiv1beta1.CDIConfigSpec) bool {
+	modified := false
+	if cdiCfg != nil {
+		if req.Instance.Spec.ScratchSpaceStorageClass == nil && cdiCfg.ScratchSpaceStorageClass != nil {
+			req.Instance.Spec.ScratchSpaceStorageClass = new(string)
+			*req.Instance.Spec.ScratchSpaceStorageClass = *cdiCfg.ScratchSpaceStorageClass
+			modified = true
+		}
+
+		if cdiCfg.PodResourceRequirements != nil {
+			if req.Instance.Spec.ResourceRequirements == nil {
+				req.Instance.Spec.ResourceRequirements = &hcov1beta1.OperandResourceRequirements{}
+			}
+
+			if req.Instance.Spec.ResourceRequirements.StorageWorkloads == nil {
+				req.Instance.Spec.ResourceRequirements.StorageWorkloads = cdiCfg.PodResourceRequirements.DeepCopy()
+				modified = true
+			}
+		}
+	}
+
+	if modified {
+		req.Dirty = true
+	}
+
+	return modified
+}
+
+func kvConfigMapToHyperConvergedCr(req *common.HcoRequest, kvCmLMConfig hcov1beta1.LiveMigrationConfigurations) {
+	if kvCmLMConfig.BandwidthPerMigration != nil {
+		req.Instance.Spec.LiveMigrationConfig.BandwidthPerMigration = kvCmLMConfig.BandwidthPerMigration
+	}
+	if kvCmLMConfig.CompletionTimeoutPerGiB != nil {
+		req.Instance.Spec.LiveMigrationConfig.CompletionTimeoutPerGiB = kvCmLMConfig.CompletionTimeoutPerGiB
+	}
+	if kvCmLMConfig.ParallelMigrationsPerCluster != nil {
+		req.Instance.Spec.LiveMigrationConfig.ParallelMigrationsPerCluster = kvCmLMConfig.ParallelMigrationsPerCluster
+	}
+	if kvCmLMConfig.ParallelOutboundMigrationsPerNode != nil {
+		req.Instance.Spec.LiveMigrationConfig.ParallelOutboundMigrationsPerNode = kvCmLMConfig.ParallelOutboundMigrationsPerNode
+	}
+	if kvCmLMConfig.ProgressTimeout != nil {
+		req.Instance.Spec.LiveMigrationConfig.ProgressTimeout = kvCmLMConfig.ProgressTimeout
+	}
+}
@@ -0,0 +1,183 @@
+package hyperconverged
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/controller/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/controller/commonTestUtils"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+var _ = Describe("Upgrade Migrations", func() {
+
+	Context("appliesTo", func() {
+		migration := upgradeMigration{name: "test", fromVersion: "1.4.0", beforeVersion: "1.6.0"}
+
+		DescribeTable("should check the version range of the migration",
+			func(sourceVersion string, expected bool) {
+				Expect(migration.appliesTo(sourceVersion)).To(Equal(expected))
+			},
+			Entry("unknown source version", "", true),
+			Entry("unparsable source version", "not-a-version", true),
+			Entry("older than the range", "1.3.2", false),
+			Entry("the first version of the range", "1.4.0", true),
+			Entry("inside the range", "1.5.1", true),
+			Entry("a pre-release inside the range", "1.5.0-unstable", true),
+			Entry("the first version after the range", "1.6.0", false),
+			Entry("a pre-release of the first version after the range", "1.6.0-unstable", false),
+			Entry("newer than the range", "1.7.0", false),
+		)
+
+		It("should apply to any version if there is no range", func() {
+			Expect(upgradeMigration{name: "test"}.appliesTo("0.0.1")).To(BeTrue())
+			Expect(upgradeMigration{name: "test"}.appliesTo("100.0.0")).To(BeTrue())
+		})
+	})
+
+	Context("run", func() {
+		var (
+			hco  *hcov1beta1.HyperConverged
+			req  *common.HcoRequest
+			m    *migrator
+			runs []string
+		)
+
+		newMigration := func(name, beforeVersion string, modified bool, err error) upgradeMigration {
+			return upgradeMigration{
+				name:          name,
+				beforeVersion: beforeVersion,
+				migrate: func(_ *migrator, _ *common.HcoRequest) (bool, error) {
+					runs = append(runs, name)
+					return modified, err
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			hco = commonTestUtils.NewHco()
+			hco.Status.Upgrade = &hcov1beta1.UpgradeStatus{
+				SourceVersion: "1.5.0",
+				TargetVersion: "1.6.0",
+			}
+			req = commonTestUtils.NewReq(hco)
+			m = &migrator{}
+			runs = nil
+		})
+
+		It("should run the migrations in order, and record their completion", func() {
+			modified, err := m.run(req, []upgradeMigration{
+				newMigration("first", "", false, nil),
+				newMigration("second", "", false, nil),
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(modified).To(BeFalse())
+			Expect(runs).To(Equal([]string{"first", "second"}))
+			Expect(hco.Status.Upgrade.CompletedMigrations).To(Equal([]string{"first", "second"}))
+			Expect(req.StatusDirty).To(BeTrue())
+		})
+
+		It("should not record the completion of a migration that modified something", func() {
+			modified, err := m.run(req, []upgradeMigration{
+				newMigration("first", "", true, nil),
+				newMigration("second", "", false, nil),
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(modified).To(BeTrue())
+			Expect(runs).To(Equal([]string{"first", "second"}))
+			Expect(hco.Status.Upgrade.CompletedMigrations).To(Equal([]string{"second"}))
+		})
+
+		It("should skip the completed migrations", func() {
+			hco.Status.Upgrade.CompletedMigrations = []string{"first"}
+
+			modified, err := m.run(req, []upgradeMigration{
+				newMigration("first", "", true, nil),
+				newMigration("second", "", false, nil),
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(modified).To(BeFalse())
+			Expect(runs).To(Equal([]string{"second"}))
+			Expect(hco.Status.Upgrade.CompletedMigrations).To(Equal([]string{"first", "second"}))
+		})
+
+		It("should skip the migrations that do not apply to the source version", func() {
+			modified, err := m.run(req, []upgradeMigration{
+				newMigration("old", "1.5.0", true, nil),
+				newMigration("new", "1.6.0", false, nil),
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(modified).To(BeFalse())
+			Expect(runs).To(Equal([]string{"new"}))
+			Expect(hco.Status.Upgrade.CompletedMigrations).To(Equal([]string{"new"}))
+		})
+
+		It("should run all the migrations if the source version is unknown", func() {
+			hco.Status.Upgrade = nil
+
+			modified, err := m.run(req, []upgradeMigration{
+				newMigration("old", "1.5.0", false, nil),
+				newMigration("new", "1.6.0", false, nil),
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(modified).To(BeFalse())
+			Expect(runs).To(Equal([]string{"old", "new"}))
+		})
+
+		It("should stop on a failed migration", func() {
+			modified, err := m.run(req, []upgradeMigration{
+				newMigration("first", "", false, errors.New("fake error")),
+				newMigration("second", "", false, nil),
+			})
+
+			Expect(err).To(HaveOccurred())
+			Expect(modified).To(BeFalse())
+			Expect(runs).To(Equal([]string{"first"}))
+			Expect(hco.Status.Upgrade.CompletedMigrations).To(BeEmpty())
+		})
+	})
+
+	Context("migrateBeforeUpgrade", func() {
+		It("should not adopt the kubevirt-config configMap when upgrading from a version that does not use it", func() {
+			expected := getBasicDeployment()
+			expected.hco.Status.Upgrade = &hcov1beta1.UpgradeStatus{
+				SourceVersion: "1.6.0",
+				TargetVersion: "1.7.0",
+			}
+			resources := append(expected.toArray(), &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      kvCmName,
+					Namespace: namespace,
+					Labels: map[string]string{
+						hcoutil.AppLabel: expected.hco.Name,
+					},
+				},
+			})
+			cl := commonTestUtils.InitClient(resources)
+
+			r := initReconciler(cl, nil)
+			req := commonTestUtils.NewReq(expected.hco)
+
+			modified, err := r.migrateBeforeUpgrade(req)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(modified).To(BeFalse())
+
+			foundKvCm, foundBackup := searchKvConfigMaps(cl)
+			Expect(foundKvCm).To(BeTrue())
+			Expect(foundBackup).To(BeFalse())
+
+			Expect(expected.hco.Status.Upgrade.CompletedMigrations).To(BeEmpty())
+		})
+	})
+})
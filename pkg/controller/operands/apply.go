@@ -0,0 +1,128 @@
+package operands
+
+import (
+	"fmt"
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/controller/common"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+// applyCr reconciles an operand CR using server-side apply. Only the fields that HCO sets are applied, so HCO owns just
+// these fields, and other managers (e.g. the defaulting webhook of the operand) can safely own the rest of them.
+// The API server does not modify the CR if the applied fields already have the required values, so the
+// resourceVersion of the CR tells if it was actually updated.
+func applyCr(req *common.HcoRequest, Client client.Client, crType string, exists client.Object, required client.Object) (bool, bool, error) {
+	applied, err := applyObject(req, Client, required)
+	if err != nil {
+		return false, false, err
+	}
+
+	// refresh the found CR, so the related objects will get its new resourceVersion. On dry-run, the found CR gets the
+	// content that would be applied.
+	origResourceVersion := exists.GetResourceVersion()
+	if err = copyFromUnstructured(applied, exists); err != nil {
+		return false, false, err
+	}
+
+	if applied.GetResourceVersion() == origResourceVersion {
+		return false, false, nil
+	}
+
+	if req.HCOTriggered {
+		req.Logger.Info(fmt.Sprintf("Updating existing %s's Spec to new opinionated values", crType))
+	} else {
+		req.Logger.Info(fmt.Sprintf("Reconciling an externally updated %s's Spec to its opinionated values", crType))
+	}
+
+	return true, !req.HCOTriggered, nil
+}
+
+// createCrWithApply creates a new operand CR using server-side apply, so HCO would own the fields that it sets, from
+// the start
+func createCrWithApply(req *common.HcoRequest, Client client.Client, required client.Object) error {
+	applied, err := applyObject(req, Client, required)
+	if err != nil {
+		return err
+	}
+
+	return copyFromUnstructured(applied, required)
+}
+
+func applyObject(req *common.HcoRequest, Client client.Client, obj client.Object) (*unstructured.Unstructured, error) {
+	applyConfig, err := newApplyConfig(Client.Scheme(), obj)
+	if err != nil {
+		return nil, err
+	}
+
+	err = Client.Patch(req.Ctx, applyConfig, client.Apply, client.FieldOwner(hcoutil.HCOFieldManager), client.ForceOwnership)
+	if err != nil {
+		return nil, err
+	}
+
+	return applyConfig, nil
+}
+
+// newApplyConfig builds the configuration to apply from the required CR; that is the identity of the CR, the
+// metadata fields that HCO sets and the spec. The status, and any field that is not set, are dropped.
+func newApplyConfig(scheme *runtime.Scheme, obj client.Object) (*unstructured.Unstructured, error) {
+	gvk, err := apiutil.GVKForObject(obj, scheme)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	applyConfig := &unstructured.Unstructured{Object: map[string]interface{}{}}
+	applyConfig.SetGroupVersionKind(gvk)
+	applyConfig.SetName(obj.GetName())
+	applyConfig.SetNamespace(obj.GetNamespace())
+	applyConfig.SetLabels(obj.GetLabels())
+	applyConfig.SetAnnotations(obj.GetAnnotations())
+	applyConfig.SetOwnerReferences(obj.GetOwnerReferences())
+
+	if spec, ok := content["spec"]; ok && spec != nil {
+		applyConfig.Object["spec"] = pruneNulls(runtime.DeepCopyJSONValue(spec))
+	}
+
+	return applyConfig, nil
+}
+
+// pruneNulls removes the null values from an unstructured value. A field that HCO does not set should not be applied
+// at all; applying null would remove the value that another manager set.
+func pruneNulls(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, val := range v {
+			if val == nil {
+				delete(v, key)
+			} else {
+				v[key] = pruneNulls(val)
+			}
+		}
+	case []interface{}:
+		for i, val := range v {
+			v[i] = pruneNulls(val)
+		}
+	}
+	return value
+}
+
+func copyFromUnstructured(src *unstructured.Unstructured, dst client.Object) error {
+	if u, ok := dst.(*unstructured.Unstructured); ok {
+		u.Object = runtime.DeepCopyJSON(src.Object)
+		return nil
+	}
+	// the converter does not clear the fields that are missing in src
+	dstValue := reflect.ValueOf(dst).Elem()
+	dstValue.Set(reflect.Zero(dstValue.Type()))
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(src.Object, dst)
+}
@@ -0,0 +1,165 @@
+package operands
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	kubevirtv1 "kubevirt.io/client-go/api/v1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/controller/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/controller/commonTestUtils"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+var _ = Describe("Server-side apply", func() {
+	var hco *hcov1beta1.HyperConverged
+	var req *common.HcoRequest
+
+	BeforeEach(func() {
+		hco = commonTestUtils.NewHco()
+		req = commonTestUtils.NewReq(hco)
+	})
+
+	getKubeVirt := func(cl *commonTestUtils.HcoTestClient) *kubevirtv1.KubeVirt {
+		kv := &kubevirtv1.KubeVirt{}
+		Expect(
+			cl.Get(context.TODO(),
+				types.NamespacedName{Name: "kubevirt-" + hco.Name, Namespace: commonTestUtils.Namespace},
+				kv),
+		).To(Succeed())
+		return kv
+	}
+
+	Context("applyCr", func() {
+		It("should not update the CR if another manager set a field that HCO does not set", func() {
+			cl := commonTestUtils.InitClient([]runtime.Object{hco})
+			handler := (*genericOperand)(newKubevirtHandler(cl, commonTestUtils.GetScheme()))
+			res := handler.ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Created).To(BeTrue())
+
+			// emulate the defaulting of the operand
+			kv := getKubeVirt(cl)
+			kv.Spec.ImagePullPolicy = corev1.PullAlways
+			Expect(cl.Update(context.TODO(), kv)).To(Succeed())
+
+			req = commonTestUtils.NewReq(hco)
+			req.HCOTriggered = false
+			res = handler.ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Updated).To(BeFalse())
+			Expect(res.Overwritten).To(BeFalse())
+
+			Expect(getKubeVirt(cl).Spec.ImagePullPolicy).To(Equal(corev1.PullAlways))
+		})
+
+		It("should revert only the modified fields that HCO sets", func() {
+			cl := commonTestUtils.InitClient([]runtime.Object{hco})
+			handler := (*genericOperand)(newKubevirtHandler(cl, commonTestUtils.GetScheme()))
+			res := handler.ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Created).To(BeTrue())
+
+			kv := getKubeVirt(cl)
+			kv.Spec.ImagePullPolicy = corev1.PullAlways
+			kv.Spec.UninstallStrategy = kubevirtv1.KubeVirtUninstallStrategyRemoveWorkloads
+			kv.Labels["other-label"] = "value"
+			Expect(cl.Update(context.TODO(), kv)).To(Succeed())
+
+			req = commonTestUtils.NewReq(hco)
+			req.HCOTriggered = false
+			res = handler.ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Updated).To(BeTrue())
+			Expect(res.Overwritten).To(BeTrue())
+			Expect(res.Drift).ToNot(BeNil())
+			Expect(res.Drift.Changes).To(Equal([]hcov1beta1.DriftChange{
+				{
+					Path:     "/spec/uninstallStrategy",
+					Observed: `"RemoveWorkloads"`,
+					Desired:  `"BlockUninstallIfWorkloadsExist"`,
+				},
+			}))
+
+			kv = getKubeVirt(cl)
+			Expect(kv.Spec.UninstallStrategy).To(Equal(kubevirtv1.KubeVirtUninstallStrategyBlockUninstallIfWorkloadsExist))
+			Expect(kv.Spec.ImagePullPolicy).To(Equal(corev1.PullAlways))
+			Expect(kv.Labels).To(HaveKeyWithValue("other-label", "value"))
+			Expect(kv.Labels).To(HaveKeyWithValue(hcoutil.AppLabel, commonTestUtils.Name))
+		})
+
+		It("should not update an unstructured CR if another manager set a field that HCO does not set", func() {
+			hco.Spec.Storage = &hcov1beta1.HyperConvergedStorageConfig{
+				HostPathProvisioner: &hcov1beta1.HostPathProvisionerConfig{
+					PathConfig: hcov1beta1.HostPathProvisionerPathConfig{Path: "/var/hpvolumes"},
+				},
+			}
+			req = commonTestUtils.NewReq(hco)
+			cl := commonTestUtils.InitClient([]runtime.Object{hco})
+			handler := newHppHandler(cl, commonTestUtils.GetScheme())
+			res := handler.ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Created).To(BeTrue())
+
+			hpp := NewEmptyHostPathProvisioner()
+			key := types.NamespacedName{Name: hppName}
+			Expect(cl.Get(context.TODO(), key, hpp)).To(Succeed())
+			Expect(unstructured.SetNestedField(hpp.Object, "value", "spec", "otherField")).To(Succeed())
+			Expect(cl.Update(context.TODO(), hpp)).To(Succeed())
+
+			req = commonTestUtils.NewReq(hco)
+			req.HCOTriggered = false
+			res = handler.ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Updated).To(BeFalse())
+			Expect(res.Overwritten).To(BeFalse())
+
+			hpp = NewEmptyHostPathProvisioner()
+			Expect(cl.Get(context.TODO(), key, hpp)).To(Succeed())
+			otherField, _, err := unstructured.NestedString(hpp.Object, "spec", "otherField")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(otherField).To(Equal("value"))
+		})
+	})
+
+	Context("newApplyConfig", func() {
+		It("should contain only the fields that HCO sets", func() {
+			kv, err := NewKubeVirt(hco, commonTestUtils.Namespace)
+			Expect(err).ToNot(HaveOccurred())
+			kv.ResourceVersion = "1234"
+			kv.Status.ObservedKubeVirtVersion = "v1.2.3"
+
+			applyConfig, err := newApplyConfig(commonTestUtils.GetScheme(), kv)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(applyConfig.GroupVersionKind()).To(Equal(kubevirtv1.KubeVirtGroupVersionKind))
+			Expect(applyConfig.GetName()).To(Equal(kv.Name))
+			Expect(applyConfig.GetNamespace()).To(Equal(kv.Namespace))
+			Expect(applyConfig.GetLabels()).To(Equal(kv.Labels))
+			Expect(applyConfig.GetResourceVersion()).To(BeEmpty())
+			Expect(applyConfig.Object).ToNot(HaveKey("status"))
+
+			uninstallStrategy, _, err := unstructured.NestedString(applyConfig.Object, "spec", "uninstallStrategy")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(uninstallStrategy).To(BeEquivalentTo(kubevirtv1.KubeVirtUninstallStrategyBlockUninstallIfWorkloadsExist))
+		})
+
+		It("should drop the null values", func() {
+			value := map[string]interface{}{
+				"a": nil,
+				"b": map[string]interface{}{"c": nil, "d": "e"},
+				"f": []interface{}{map[string]interface{}{"g": nil}},
+			}
+
+			Expect(pruneNulls(value)).To(Equal(map[string]interface{}{
+				"b": map[string]interface{}{"d": "e"},
+				"f": []interface{}{map[string]interface{}{}},
+			}))
+		})
+	})
+})
@@ -2,6 +2,7 @@ package operands
 
 import (
 	"errors"
+	"os"
 	"reflect"
 
 	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1beta1"
@@ -20,6 +21,10 @@ const (
 	cdiRoleName                   = "hco.kubevirt.io:config-reader"
 	HonorWaitForFirstConsumerGate = "HonorWaitForFirstConsumer"
 	cdiConfigAuthorityAnnotation  = "cdi.kubevirt.io/configAuthority"
+
+	// the CDI importer reads the VDDK init image from this ConfigMap, in the namespace of CDI
+	vddkConfigMapName = "v2v-vmware"
+	vddkInitImageKey  = "vddk-init-image"
 )
 
 type cdiHandler genericOperand
@@ -57,9 +62,11 @@ func (h cdiHooks) getEmptyCr() client.Object { return &cdiv1beta1.CDI{} }
 func (h cdiHooks) getConditions(cr runtime.Object) []metav1.Condition {
 	return osConditionsToK8s(cr.(*cdiv1beta1.CDI).Status.Conditions)
 }
-func (h cdiHooks) checkComponentVersion(cr runtime.Object) bool {
-	found := cr.(*cdiv1beta1.CDI)
-	return checkComponentVersion(hcoutil.CdiVersionEnvV, found.Status.ObservedVersion)
+func (h cdiHooks) getObservedVersion(cr runtime.Object) string {
+	return cr.(*cdiv1beta1.CDI).Status.ObservedVersion
+}
+func (h cdiHooks) getExpectedVersion() string {
+	return os.Getenv(hcoutil.CdiVersionEnvV)
 }
 func (h cdiHooks) getObjectMeta(cr runtime.Object) *metav1.ObjectMeta {
 	return &cr.(*cdiv1beta1.CDI).ObjectMeta
@@ -74,25 +81,7 @@ func (h *cdiHooks) updateCr(req *common.HcoRequest, Client client.Client, exists
 	if !ok1 || !ok2 {
 		return false, false, errors.New("can't convert to CDI")
 	}
-
-	if !reflect.DeepEqual(found.Spec, cdi.Spec) ||
-		!reflect.DeepEqual(found.Labels, cdi.Labels) {
-		overwritten := false
-		if req.HCOTriggered {
-			req.Logger.Info("Updating existing CDI's Spec to new opinionated values")
-		} else {
-			req.Logger.Info("Reconciling an externally updated CDI's Spec to its opinionated values")
-			overwritten = true
-		}
-		util.DeepCopyLabels(&cdi.ObjectMeta, &found.ObjectMeta)
-		cdi.Spec.DeepCopyInto(&found.Spec)
-		err := Client.Update(req.Ctx, found)
-		if err != nil {
-			return false, false, err
-		}
-		return true, overwritten, nil
-	}
-	return false, false, nil
+	return applyCr(req, Client, "CDI", found, cdi)
 }
 
 func getDefaultFeatureGates() []string {
@@ -124,12 +113,12 @@ func NewCDI(hc *hcov1beta1.HyperConverged, opts ...string) (*cdiv1beta1.CDI, err
 		}
 	}
 
-	if hc.Spec.Infra.NodePlacement != nil {
-		hc.Spec.Infra.NodePlacement.DeepCopyInto(&spec.Infra)
+	if infra := getInfraConfig(hc, hcoutil.AppComponentStorage); infra.NodePlacement != nil {
+		infra.NodePlacement.DeepCopyInto(&spec.Infra)
 	}
 
-	if hc.Spec.Workloads.NodePlacement != nil {
-		hc.Spec.Workloads.NodePlacement.DeepCopyInto(&spec.Workloads)
+	if workloads := getWorkloadsConfig(hc, hcoutil.AppComponentStorage); workloads.NodePlacement != nil {
+		workloads.NodePlacement.DeepCopyInto(&spec.Workloads)
 	}
 
 	certConfig := hc.Spec.CertConfig
@@ -249,6 +238,95 @@ func NewKubeVirtStorageConfigForCR(cr *hcov1beta1.HyperConverged, namespace stri
 	}
 }
 
+// ************** CDI VDDK Config Handler **************
+
+// vddkConfigHandler deploys the ConfigMap that the CDI importer reads the VDDK init image from, when the image is set
+// in the HyperConverged CR. When the image is removed, the ConfigMap is not required anymore, so it is swept.
+type vddkConfigHandler struct {
+	genericOperand
+}
+
+func newVddkConfigHandler(Client client.Client, Scheme *runtime.Scheme) *vddkConfigHandler {
+	return &vddkConfigHandler{
+		genericOperand: genericOperand{
+			Client:                 Client,
+			Scheme:                 Scheme,
+			crType:                 "VddkConfigmap",
+			removeExistingOwner:    false,
+			setControllerReference: true,
+			hooks:                  &vddkConfigHooks{},
+		},
+	}
+}
+
+func (h *vddkConfigHandler) ensure(req *common.HcoRequest) *EnsureResult {
+	if isVddkInitImageSet(req.Instance) {
+		return h.genericOperand.ensure(req)
+	}
+
+	return NewEnsureResult(&corev1.ConfigMap{}).SetUpgradeDone(req.ComponentUpgradeInProgress)
+}
+
+type vddkConfigHooks struct{}
+
+func (h vddkConfigHooks) getFullCr(hc *hcov1beta1.HyperConverged) (client.Object, error) {
+	return NewVddkConfigForCR(hc, hc.Namespace), nil
+}
+func (h vddkConfigHooks) getEmptyCr() client.Object { return &corev1.ConfigMap{} }
+func (h vddkConfigHooks) getObjectMeta(cr runtime.Object) *metav1.ObjectMeta {
+	return &cr.(*corev1.ConfigMap).ObjectMeta
+}
+func (h *vddkConfigHooks) updateCr(req *common.HcoRequest, Client client.Client, exists runtime.Object, required runtime.Object) (bool, bool, error) {
+	vddkConfig, ok1 := required.(*corev1.ConfigMap)
+	found, ok2 := exists.(*corev1.ConfigMap)
+	if !ok1 || !ok2 {
+		return false, false, errors.New("can't convert to a ConfigMap")
+	}
+
+	// only the init image is managed; other keys that were added to the ConfigMap are kept
+	if found.Data[vddkInitImageKey] != vddkConfig.Data[vddkInitImageKey] ||
+		!reflect.DeepEqual(found.Labels, vddkConfig.Labels) {
+
+		req.Logger.Info("Updating existing VDDK Configmap to its default values")
+
+		if found.Data == nil {
+			found.Data = make(map[string]string)
+		}
+		found.Data[vddkInitImageKey] = vddkConfig.Data[vddkInitImageKey]
+		util.DeepCopyLabels(&vddkConfig.ObjectMeta, &found.ObjectMeta)
+
+		err := Client.Update(req.Ctx, found)
+		if err != nil {
+			return false, false, err
+		}
+		return true, !req.HCOTriggered, nil
+	}
+
+	return false, false, nil
+}
+
+func isVddkInitImageSet(hc *hcov1beta1.HyperConverged) bool {
+	return hc.Spec.VddkInitImage != nil && *hc.Spec.VddkInitImage != ""
+}
+
+// NewVddkConfigForCR builds the ConfigMap that the CDI importer reads the VDDK init image from
+func NewVddkConfigForCR(cr *hcov1beta1.HyperConverged, namespace string) *corev1.ConfigMap {
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      vddkConfigMapName,
+			Labels:    getLabels(cr, hcoutil.AppComponentStorage),
+			Namespace: namespace,
+		},
+		Data: map[string]string{},
+	}
+
+	if isVddkInitImageSet(cr) {
+		cm.Data[vddkInitImageKey] = *cr.Spec.VddkInitImage
+	}
+
+	return cm
+}
+
 // ************** Config Reader Role Handler **************
 type configReaderRoleHandler genericOperand
 
@@ -329,6 +407,7 @@ func newConfigReaderRoleBindingHandler(Client client.Client, Scheme *runtime.Sch
 		removeExistingOwner:    false,
 		setControllerReference: true,
 		hooks:                  &configReaderRoleBindingHooks{},
+		dependsOn:              []string{"Role"},
 	}
 }
 
@@ -54,7 +54,9 @@ var _ = Describe("CDI Operand", func() {
 			Expect(foundResource.Name).To(Equal(expectedResource.Name))
 			Expect(foundResource.Labels).Should(HaveKeyWithValue(hcoutil.AppLabel, commonTestUtils.Name))
 			Expect(foundResource.Namespace).To(Equal(expectedResource.Namespace))
-			Expect(foundResource.Annotations).To(Equal(map[string]string{cdiConfigAuthorityAnnotation: ""}))
+			Expect(foundResource.Annotations).To(HaveLen(2))
+			Expect(foundResource.Annotations).To(HaveKeyWithValue(cdiConfigAuthorityAnnotation, ""))
+			Expect(foundResource.Annotations).To(HaveKey(hcoutil.DesiredStateHashAnnotation))
 		})
 
 		It("should find if present", func() {
@@ -1244,4 +1246,92 @@ var _ = Describe("CDI Operand", func() {
 			Expect(expectedResource.Data["local.volumeMode"]).To(Equal("Filesystem"))
 		})
 	})
+
+	Context("VDDK Configmap", func() {
+		const vddkInitImage = "quay.io/kubevirt/vddk:v7.0.2"
+
+		var (
+			hco *hcov1beta1.HyperConverged
+			req *common.HcoRequest
+		)
+
+		BeforeEach(func() {
+			hco = commonTestUtils.NewHco()
+			image := vddkInitImage
+			hco.Spec.VddkInitImage = &image
+			req = commonTestUtils.NewReq(hco)
+		})
+
+		getVddkConfig := func(cl *commonTestUtils.HcoTestClient) (*corev1.ConfigMap, error) {
+			foundResource := &corev1.ConfigMap{}
+			err := cl.Get(context.TODO(), types.NamespacedName{Name: vddkConfigMapName, Namespace: commonTestUtils.Namespace}, foundResource)
+			return foundResource, err
+		}
+
+		It("should create if not present", func() {
+			cl := commonTestUtils.InitClient([]runtime.Object{})
+			handler := newVddkConfigHandler(cl, commonTestUtils.GetScheme())
+			res := handler.ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Created).To(BeTrue())
+			Expect(res.Name).To(Equal(vddkConfigMapName))
+
+			foundResource, err := getVddkConfig(cl)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(foundResource.Labels).Should(HaveKeyWithValue(hcoutil.AppLabel, commonTestUtils.Name))
+			Expect(foundResource.Data).To(HaveKeyWithValue(vddkInitImageKey, vddkInitImage))
+
+			// ObjectReference should have been added, once the ConfigMap is found
+			Expect(handler.ensure(req).Err).ToNot(HaveOccurred())
+			foundResource, err = getVddkConfig(cl)
+			Expect(err).ToNot(HaveOccurred())
+			objectRef, err := reference.GetReference(handler.Scheme, foundResource)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(hco.Status.RelatedObjects).To(ContainElement(*objectRef))
+		})
+
+		It("should not create the ConfigMap if the VDDK init image is not set", func() {
+			hco.Spec.VddkInitImage = nil
+			cl := commonTestUtils.InitClient([]runtime.Object{})
+			handler := newVddkConfigHandler(cl, commonTestUtils.GetScheme())
+			res := handler.ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Created).To(BeFalse())
+			Expect(res.Name).To(BeEmpty())
+
+			_, err := getVddkConfig(cl)
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should update the VDDK init image according to HCO CR, and keep the other keys", func() {
+			outdatedResource := NewVddkConfigForCR(hco, commonTestUtils.Namespace)
+			outdatedResource.Data[vddkInitImageKey] = "quay.io/kubevirt/vddk:v6.7"
+			outdatedResource.Data["other-key"] = "other-value"
+
+			cl := commonTestUtils.InitClient([]runtime.Object{hco, outdatedResource})
+			handler := newVddkConfigHandler(cl, commonTestUtils.GetScheme())
+			res := handler.ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Updated).To(BeTrue())
+
+			foundResource, err := getVddkConfig(cl)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(foundResource.Data).To(HaveKeyWithValue(vddkInitImageKey, vddkInitImage))
+			Expect(foundResource.Data).To(HaveKeyWithValue("other-key", "other-value"))
+		})
+
+		It("should sweep the ConfigMap when the VDDK init image is removed from HCO CR", func() {
+			cl := commonTestUtils.InitClient([]runtime.Object{hco})
+			handler := NewOperandHandler(cl, commonTestUtils.GetScheme(), false, commonTestUtils.NewEventEmitterMock())
+			Expect(handler.Ensure(req)).To(Succeed())
+			_, err := getVddkConfig(cl)
+			Expect(err).ToNot(HaveOccurred())
+
+			hco.Spec.VddkInitImage = nil
+			req = commonTestUtils.NewReq(hco)
+			Expect(handler.Ensure(req)).To(Succeed())
+			_, err = getVddkConfig(cl)
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+	})
 })
@@ -203,6 +203,7 @@ func newCliDownloadsRouteHandler(Client client.Client, Scheme *runtime.Scheme) *
 		removeExistingOwner:    false,
 		setControllerReference: false,
 		hooks:                  &cliDownloadsRouteHooks{},
+		dependsOn:              []string{"Service"},
 	}
 }
 
@@ -60,22 +60,23 @@ func (h dashboardHooks) getObjectMeta(cr runtime.Object) *metav1.ObjectMeta {
 
 func (h dashboardHooks) reset() { /* no implementation */ }
 
-func (h dashboardHooks) updateCr(req *common.HcoRequest, Client client.Client, exists runtime.Object, _ runtime.Object) (bool, bool, error) {
-	found, ok := exists.(*v1.ConfigMap)
+func (h dashboardHooks) updateCr(req *common.HcoRequest, Client client.Client, exists runtime.Object, required runtime.Object) (bool, bool, error) {
+	cm, ok1 := required.(*v1.ConfigMap)
+	found, ok2 := exists.(*v1.ConfigMap)
 
-	if !ok {
+	if !ok1 || !ok2 {
 		return false, false, errors.New("can't convert to Configmap")
 	}
 
-	if !reflect.DeepEqual(found.Data, h.required.Data) ||
-		!reflect.DeepEqual(found.Labels, h.required.Labels) {
+	if !reflect.DeepEqual(found.Data, cm.Data) ||
+		!reflect.DeepEqual(found.Labels, cm.Labels) {
 		if req.HCOTriggered {
-			req.Logger.Info("Updating existing Configmap to new opinionated values", "name", h.required.Name)
+			req.Logger.Info("Updating existing Configmap to new opinionated values", "name", cm.Name)
 		} else {
-			req.Logger.Info("Reconciling an externally updated Configmap to its opinionated values", "name", h.required.Name)
+			req.Logger.Info("Reconciling an externally updated Configmap to its opinionated values", "name", cm.Name)
 		}
-		util.DeepCopyLabels(&h.required.ObjectMeta, &found.ObjectMeta)
-		h.required.DeepCopyInto(found)
+		util.DeepCopyLabels(&cm.ObjectMeta, &found.ObjectMeta)
+		cm.DeepCopyInto(found)
 		err := Client.Update(req.Ctx, found)
 		if err != nil {
 			return false, false, err
@@ -0,0 +1,211 @@
+package operands
+
+import (
+	"fmt"
+
+	objectreferencesv1 "github.com/openshift/custom-resource-status/objectreferences/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/controller/common"
+)
+
+// defaultEnsureParallelism is the maximum number of operands that are ensured concurrently
+const defaultEnsureParallelism = 4
+
+// dependentOperand is an operand that declares the types of the operands it depends on. An operand is ensured only
+// after the operands it depends on were ensured successfully, and is not ensured at all if any of them failed. An
+// operand may only depend on operands that are listed before it.
+type dependentOperand interface {
+	getType() string
+	getDependencies() []string
+}
+
+func (h *genericOperand) getType() string {
+	return h.crType
+}
+
+func (h *genericOperand) getDependencies() []string {
+	return h.dependsOn
+}
+
+// ensureNode is the state of an operand during a full ensure
+type ensureNode struct {
+	operand Operand
+	// the indexes of the operands this operand depends on
+	deps []int
+	// the request of the operand; a copy of the reconciliation request, so operands can be ensured concurrently
+	req *common.HcoRequest
+	// the related objects, as copied to the request of the operand
+	relatedObjects []corev1.ObjectReference
+	res            *EnsureResult
+	started        bool
+	done           bool
+}
+
+// buildEnsureGraph resolves the dependencies of the operands into the indexes of the operands they depend on
+func (h OperandHandler) buildEnsureGraph() []*ensureNode {
+	nodes := make([]*ensureNode, len(h.operands))
+	byType := make(map[string][]int)
+	for i, operand := range h.operands {
+		nodes[i] = &ensureNode{operand: operand}
+
+		dep, ok := operand.(dependentOperand)
+		if !ok {
+			continue
+		}
+
+		for _, depType := range dep.getDependencies() {
+			nodes[i].deps = append(nodes[i].deps, byType[depType]...)
+		}
+		byType[dep.getType()] = append(byType[dep.getType()], i)
+	}
+
+	return nodes
+}
+
+// ensureOperands ensures the operands concurrently, up to the parallelism of the handler, so that an operand is
+// ensured only after the operands it depends on. During an upgrade, the operands are ensured one by one, in their
+// order, so each operand sees the upgrade steps of the previous ones. The results are processed in the order of the
+// operands, regardless of the order they were completed in. The result of an operand that was not ensured because an
+// operand it depends on failed, holds the error.
+func (h OperandHandler) ensureOperands(req *common.HcoRequest, process func(Operand, *EnsureResult)) {
+	nodes := h.buildEnsureGraph()
+
+	parallelism := h.parallelism
+	if req.UpgradeMode || parallelism < 1 {
+		parallelism = 1
+	}
+
+	completed := make(chan int)
+	running, processed := 0, 0
+	for processed < len(nodes) {
+		for i, node := range nodes {
+			if running >= parallelism {
+				break
+			}
+
+			if node.started || !isEnsureNodeReady(nodes, i) || (req.UpgradeMode && processed != i) {
+				continue
+			}
+
+			node.started = true
+			if failed, found := getFailedDependency(nodes, i); found {
+				node.res = &EnsureResult{Type: getOperandType(node.operand), Err: fmt.Errorf("can't ensure %s, because %s failed", getOperandType(node.operand), failed)}
+				node.done = true
+				continue
+			}
+
+			node.req = newOperandRequest(req)
+			node.relatedObjects = append([]corev1.ObjectReference(nil), node.req.Instance.Status.RelatedObjects...)
+			running++
+			go func(i int, node *ensureNode) {
+				node.res = ensureWithMetrics(node.operand, node.req)
+				completed <- i
+			}(i, node)
+		}
+
+		for processed < len(nodes) && nodes[processed].done {
+			node := nodes[processed]
+			if node.req != nil {
+				mergeOperandRequest(req, node)
+			}
+			process(node.operand, node.res)
+			processed++
+		}
+
+		if running == 0 {
+			continue
+		}
+
+		i := <-completed
+		nodes[i].done = true
+		running--
+	}
+}
+
+// isEnsureNodeReady checks if all the operands that an operand depends on, are done
+func isEnsureNodeReady(nodes []*ensureNode, i int) bool {
+	for _, dep := range nodes[i].deps {
+		if !nodes[dep].done {
+			return false
+		}
+	}
+	return true
+}
+
+// getFailedDependency returns the type of the first failed operand that an operand depends on, if there is such
+func getFailedDependency(nodes []*ensureNode, i int) (string, bool) {
+	for _, dep := range nodes[i].deps {
+		if nodes[dep].res.Err != nil {
+			return getOperandType(nodes[dep].operand), true
+		}
+	}
+	return "", false
+}
+
+func getOperandType(operand Operand) string {
+	if dep, ok := operand.(dependentOperand); ok {
+		return dep.getType()
+	}
+	return fmt.Sprintf("%T", operand)
+}
+
+// newOperandRequest copies the reconciliation request for a single operand. The operand modifies only its own copy of
+// the HyperConverged CR and of the in-memory conditions.
+func newOperandRequest(req *common.HcoRequest) *common.HcoRequest {
+	operandReq := *req
+	operandReq.Instance = req.Instance.DeepCopy()
+	operandReq.Conditions = common.NewHcoConditions()
+	operandReq.Dirty = false
+	operandReq.StatusDirty = false
+	return &operandReq
+}
+
+// mergeOperandRequest merges the modifications of an operand, in its copy of the request, back to the reconciliation
+// request
+func mergeOperandRequest(req *common.HcoRequest, node *ensureNode) {
+	operandReq := node.req
+
+	for _, condition := range operandReq.Conditions {
+		req.Conditions.SetStatusCondition(condition)
+	}
+
+	// only the references the operand set are merged, so the references that other operands set meanwhile are kept
+	for _, ref := range operandReq.Instance.Status.RelatedObjects {
+		if containsObjectReference(node.relatedObjects, ref) {
+			continue
+		}
+		if err := objectreferencesv1.SetObjectReference(&req.Instance.Status.RelatedObjects, ref); err != nil {
+			req.Logger.Error(err, "failed to set a related object", "Kind", ref.Kind, "name", ref.Name)
+		}
+	}
+
+	for i, override := range operandReq.Instance.Status.Overrides {
+		if i < len(req.Instance.Status.Overrides) && override.State != hcov1beta1.OverrideStateIgnored {
+			req.Instance.Status.Overrides[i] = override
+		}
+	}
+
+	if operandReq.Dirty {
+		// the operand modified the metadata of the HyperConverged CR
+		for key, value := range operandReq.Instance.Annotations {
+			if req.Instance.Annotations == nil {
+				req.Instance.Annotations = make(map[string]string)
+			}
+			req.Instance.Annotations[key] = value
+		}
+		req.Dirty = true
+	}
+
+	req.StatusDirty = req.StatusDirty || operandReq.StatusDirty
+}
+
+func containsObjectReference(refs []corev1.ObjectReference, ref corev1.ObjectReference) bool {
+	for _, r := range refs {
+		if r == ref {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,248 @@
+package operands
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	networkaddonsv1 "github.com/kubevirt/cluster-network-addons-operator/pkg/apis/networkaddonsoperator/v1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	sspv1beta1 "kubevirt.io/ssp-operator/api/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/controller/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/controller/commonTestUtils"
+)
+
+type fakeOperand struct {
+	crType    string
+	dependsOn []string
+	ensureFn  func(req *common.HcoRequest) *EnsureResult
+}
+
+func (o *fakeOperand) ensure(req *common.HcoRequest) *EnsureResult {
+	return o.ensureFn(req)
+}
+
+func (o *fakeOperand) reset() {}
+
+func (o *fakeOperand) getType() string {
+	return o.crType
+}
+
+func (o *fakeOperand) getDependencies() []string {
+	return o.dependsOn
+}
+
+var _ = Describe("Test the dependency graph of the operands", func() {
+	var (
+		hco *hcov1beta1.HyperConverged
+		req *common.HcoRequest
+	)
+
+	BeforeEach(func() {
+		hco = commonTestUtils.NewHco()
+		req = commonTestUtils.NewReq(hco)
+	})
+
+	Context("with fake operands", func() {
+		var (
+			lock  sync.Mutex
+			order []string
+		)
+
+		newOperand := func(crType string, err error, dependsOn ...string) *fakeOperand {
+			return &fakeOperand{
+				crType:    crType,
+				dependsOn: dependsOn,
+				ensureFn: func(_ *common.HcoRequest) *EnsureResult {
+					lock.Lock()
+					order = append(order, crType)
+					lock.Unlock()
+					return &EnsureResult{Type: crType, Err: err}
+				},
+			}
+		}
+
+		ensureAll := func(handler *OperandHandler) []*EnsureResult {
+			var results []*EnsureResult
+			handler.ensureOperands(req, func(_ Operand, res *EnsureResult) {
+				results = append(results, res)
+			})
+			return results
+		}
+
+		BeforeEach(func() {
+			order = nil
+		})
+
+		It("should ensure an operand only after the operands it depends on", func() {
+			handler := &OperandHandler{
+				operands: []Operand{
+					newOperand("first", nil),
+					newOperand("second", nil, "first"),
+					newOperand("third", nil, "second"),
+				},
+				parallelism: 4,
+			}
+
+			results := ensureAll(handler)
+			Expect(order).To(Equal([]string{"first", "second", "third"}))
+			Expect(results).To(HaveLen(3))
+		})
+
+		It("should process the results in the order of the operands", func() {
+			slow := &fakeOperand{
+				crType: "slow",
+				ensureFn: func(_ *common.HcoRequest) *EnsureResult {
+					time.Sleep(50 * time.Millisecond)
+					return &EnsureResult{Type: "slow"}
+				},
+			}
+			handler := &OperandHandler{
+				operands:    []Operand{slow, newOperand("fast", nil)},
+				parallelism: 4,
+			}
+
+			results := ensureAll(handler)
+			Expect(results).To(HaveLen(2))
+			Expect(results[0].Type).To(Equal("slow"))
+			Expect(results[1].Type).To(Equal("fast"))
+		})
+
+		It("should not ensure the operands that depend on a failed operand", func() {
+			handler := &OperandHandler{
+				operands: []Operand{
+					newOperand("failed", errors.New("fake error")),
+					newOperand("dependent", nil, "failed"),
+					newOperand("transitive", nil, "dependent"),
+					newOperand("independent", nil),
+				},
+				parallelism: 4,
+			}
+
+			results := ensureAll(handler)
+			Expect(order).To(ConsistOf("failed", "independent"))
+			Expect(results).To(HaveLen(4))
+			Expect(results[0].Err).To(MatchError("fake error"))
+			Expect(results[1].Err).To(MatchError("can't ensure dependent, because failed failed"))
+			Expect(results[2].Err).To(MatchError("can't ensure transitive, because dependent failed"))
+			Expect(results[3].Err).ToNot(HaveOccurred())
+		})
+
+		It("should ignore a dependency on an operand that is listed after the dependent operand", func() {
+			handler := &OperandHandler{
+				operands: []Operand{
+					newOperand("first", nil, "second"),
+					newOperand("second", nil),
+				},
+			}
+
+			nodes := handler.buildEnsureGraph()
+			Expect(nodes[0].deps).To(BeEmpty())
+		})
+
+		It("should not ensure more operands concurrently than the parallelism", func() {
+			var running, maxRunning int32
+			operands := make([]Operand, 10)
+			for i := range operands {
+				operands[i] = &fakeOperand{
+					crType: "concurrent",
+					ensureFn: func(_ *common.HcoRequest) *EnsureResult {
+						current := atomic.AddInt32(&running, 1)
+						for {
+							prev := atomic.LoadInt32(&maxRunning)
+							if current <= prev || atomic.CompareAndSwapInt32(&maxRunning, prev, current) {
+								break
+							}
+						}
+						time.Sleep(10 * time.Millisecond)
+						atomic.AddInt32(&running, -1)
+						return &EnsureResult{Type: "concurrent"}
+					},
+				}
+			}
+			handler := &OperandHandler{operands: operands, parallelism: 3}
+
+			Expect(ensureAll(handler)).To(HaveLen(10))
+			Expect(atomic.LoadInt32(&maxRunning)).To(BeNumerically("<=", 3))
+			Expect(atomic.LoadInt32(&maxRunning)).To(BeNumerically(">", 1))
+		})
+
+		It("should ensure the operands one by one during an upgrade", func() {
+			req.SetUpgradeMode(true)
+			var running, maxRunning int32
+			operand := func(crType string) *fakeOperand {
+				return &fakeOperand{
+					crType: crType,
+					ensureFn: func(_ *common.HcoRequest) *EnsureResult {
+						if current := atomic.AddInt32(&running, 1); current > atomic.LoadInt32(&maxRunning) {
+							atomic.StoreInt32(&maxRunning, current)
+						}
+						lock.Lock()
+						order = append(order, crType)
+						lock.Unlock()
+						time.Sleep(5 * time.Millisecond)
+						atomic.AddInt32(&running, -1)
+						return &EnsureResult{Type: crType}
+					},
+				}
+			}
+			handler := &OperandHandler{
+				operands:    []Operand{operand("a"), operand("b"), operand("c")},
+				parallelism: 4,
+			}
+
+			Expect(ensureAll(handler)).To(HaveLen(3))
+			Expect(order).To(Equal([]string{"a", "b", "c"}))
+			Expect(atomic.LoadInt32(&maxRunning)).To(BeEquivalentTo(1))
+		})
+	})
+
+	It("should keep ensuring the operands that do not depend on the failed ones", func() {
+		cli := commonTestUtils.InitClient([]runtime.Object{hco})
+		cli.InitiateCreateErrors(func(obj client.Object) error {
+			switch o := obj.(type) {
+			case *networkaddonsv1.NetworkAddonsConfig:
+				return errors.New("fake CNA create error")
+			case *corev1.Service:
+				if o.Name == "kubevirt-hyperconverged-operator-metrics" {
+					return errors.New("fake metrics service create error")
+				}
+			}
+			return nil
+		})
+
+		handler := NewOperandHandler(cli, commonTestUtils.GetScheme(), true, commonTestUtils.NewEventEmitterMock())
+		err := handler.Ensure(req)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("fake CNA create error"))
+		Expect(err.Error()).To(ContainSubstring("fake metrics service create error"))
+		Expect(err.Error()).To(ContainSubstring("can't ensure ServiceMonitor, because MetricsService failed"))
+
+		cond, found := req.Conditions[hcov1beta1.ConditionReconcileComplete]
+		Expect(found).To(BeTrue())
+		Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+		Expect(cond.Message).To(Equal("Error while reconciling: " + err.Error()))
+
+		By("the operands that do not depend on the failed ones are ensured")
+		sspList := &sspv1beta1.SSPList{}
+		Expect(cli.List(req.Ctx, sspList)).To(Succeed())
+		Expect(sspList.Items).To(HaveLen(1))
+		ruleList := &monitoringv1.PrometheusRuleList{}
+		Expect(cli.List(req.Ctx, ruleList)).To(Succeed())
+		Expect(ruleList.Items).To(HaveLen(1))
+
+		By("the operands that depend on the failed ones are not ensured")
+		monitorList := &monitoringv1.ServiceMonitorList{}
+		Expect(cli.List(req.Ctx, monitorList)).To(Succeed())
+		Expect(monitorList.Items).To(BeEmpty())
+	})
+})
@@ -0,0 +1,94 @@
+package operands
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/controller/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/metrics"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+// getDesiredStateHash returns the hash of the required resource, as generated from the HyperConverged CR
+func getDesiredStateHash(required client.Object) (string, error) {
+	content, err := json.Marshal(required)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%x", sha256.Sum256(content)), nil
+}
+
+// getObservedState returns the state of the resource, to be compared with its state when HCO last applied its desired
+// state. The status of an operand CR is frequently updated, so only its generation, that is modified only by the
+// updates of its spec, and its labels and annotations, are compared. The other resources have no status, so any update
+// of them is compared.
+func getObservedState(found client.Object, isOperand bool) string {
+	if isOperand && found.GetGeneration() != 0 {
+		// marshaling maps of strings can't fail
+		metadata, _ := json.Marshal(map[string]map[string]string{
+			"labels":      found.GetLabels(),
+			"annotations": found.GetAnnotations(),
+		})
+		return fmt.Sprintf("generation/%d/metadata/%x", found.GetGeneration(), sha256.Sum256(metadata))
+	}
+	return "resourceVersion/" + found.GetResourceVersion()
+}
+
+// isDesiredStateApplied checks if the resource holds the hash of the current desired state, and was not modified since
+// HCO last applied or compared it, so the resource does not need to be compared with the required one. The hash is read
+// from the resource, but its observed state is known only in memory, so after a restart, the resource is compared once.
+// During an upgrade, the resource is always compared.
+func (h *genericOperand) isDesiredStateApplied(req *common.HcoRequest, found client.Object, hash string) bool {
+	if req.UpgradeMode || h.appliedState == "" || found.GetAnnotations()[hcoutil.DesiredStateHashAnnotation] != hash {
+		return false
+	}
+
+	_, isOperand := h.hooks.(hcoOperandHooks)
+	return h.appliedState == getObservedState(found, isOperand)
+}
+
+// setDesiredStateApplied records the state of the resource after HCO applied, or compared, its desired state
+func (h *genericOperand) setDesiredStateApplied(found client.Object) {
+	_, isOperand := h.hooks.(hcoOperandHooks)
+	h.appliedState = getObservedState(found, isOperand)
+}
+
+// stampDesiredStateHash returns a copy of the required resource, with the hash of the desired state, so the same
+// create, update or apply that writes the resource also writes the hash. The hooks of the resources that are not
+// operand CRs write the found resource, so it is stamped as well. An existing operand CR without the hash, that an
+// older version of HCO created, is not stamped, so it is not written only to add the hash; it is compared on each
+// reconciliation, as before.
+func (h *genericOperand) stampDesiredStateHash(found client.Object, required client.Object, hash string) client.Object {
+	if found != nil {
+		if _, isOperand := h.hooks.(hcoOperandHooks); !isOperand {
+			setDesiredStateHash(found, hash)
+		} else if _, stamped := found.GetAnnotations()[hcoutil.DesiredStateHashAnnotation]; !stamped {
+			return required
+		}
+	}
+
+	stamped := required.DeepCopyObject().(client.Object)
+	setDesiredStateHash(stamped, hash)
+	return stamped
+}
+
+func setDesiredStateHash(obj client.Object, hash string) {
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[hcoutil.DesiredStateHashAnnotation] = hash
+	obj.SetAnnotations(annotations)
+}
+
+func countWrite(crType string, written bool) {
+	if written {
+		metrics.HcoMetrics.IncOperandWrites(crType, metrics.WritePerformed)
+	} else {
+		metrics.HcoMetrics.IncOperandWrites(crType, metrics.WriteUnchanged)
+	}
+}
@@ -0,0 +1,233 @@
+package operands
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubevirtv1 "kubevirt.io/client-go/api/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/controller/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/controller/commonTestUtils"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/metrics"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+var _ = Describe("Test the desired state hash of the managed resources", func() {
+	var (
+		hco *hcov1beta1.HyperConverged
+		req *common.HcoRequest
+	)
+
+	getWrites := func(crType, result string) float64 {
+		count, err := metrics.HcoMetrics.GetOperandWritesCount(crType, result)
+		Expect(err).ToNot(HaveOccurred())
+		return count
+	}
+
+	BeforeEach(func() {
+		hco = commonTestUtils.NewHco()
+		req = commonTestUtils.NewReq(hco)
+	})
+
+	Context("resources that are not operand CRs", func() {
+		var (
+			cl      *commonTestUtils.HcoTestClient
+			handler *genericOperand
+		)
+
+		getService := func() *corev1.Service {
+			svc := &corev1.Service{}
+			Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(NewMetricsService(hco, hco.Namespace)), svc)).To(Succeed())
+			return svc
+		}
+
+		BeforeEach(func() {
+			cl = commonTestUtils.InitClient([]runtime.Object{hco})
+			handler = (*genericOperand)(newMetricsServiceHandler(cl, commonTestUtils.GetScheme()))
+		})
+
+		It("should stamp the created resource with the hash of its desired state", func() {
+			res := handler.ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Created).To(BeTrue())
+
+			Expect(getService().Annotations).To(HaveKey(hcoutil.DesiredStateHashAnnotation))
+		})
+
+		It("should skip the comparison if neither the HyperConverged CR nor the resource were modified", func() {
+			Expect(handler.ensure(req).Err).ToNot(HaveOccurred())
+			resourceVersion := getService().ResourceVersion
+
+			skipped := getWrites("MetricsService", metrics.WriteSkipped)
+			res := handler.ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Updated).To(BeFalse())
+			Expect(getWrites("MetricsService", metrics.WriteSkipped)).To(Equal(skipped + 1))
+			Expect(getService().ResourceVersion).To(Equal(resourceVersion))
+		})
+
+		It("should revert an out-of-band modification of the resource", func() {
+			Expect(handler.ensure(req).Err).ToNot(HaveOccurred())
+
+			svc := getService()
+			svc.Spec.Ports[0].Port = 1234
+			Expect(cl.Update(context.TODO(), svc)).To(Succeed())
+
+			performed := getWrites("MetricsService", metrics.WritePerformed)
+			req.HCOTriggered = false
+			res := handler.ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Updated).To(BeTrue())
+			Expect(res.Overwritten).To(BeTrue())
+			Expect(getWrites("MetricsService", metrics.WritePerformed)).To(Equal(performed + 1))
+			Expect(getService().Spec.Ports[0].Port).To(Equal(int32(hcoutil.MetricsPort)))
+		})
+
+		It("should keep skipping the comparison after the caches of the handler were reset", func() {
+			Expect(handler.ensure(req).Err).ToNot(HaveOccurred())
+			handler.reset()
+
+			skipped := getWrites("MetricsService", metrics.WriteSkipped)
+			Expect(handler.ensure(req).Err).ToNot(HaveOccurred())
+			Expect(getWrites("MetricsService", metrics.WriteSkipped)).To(Equal(skipped + 1))
+		})
+
+		It("should compare the resource once after a restart, and then skip the comparison", func() {
+			Expect(handler.ensure(req).Err).ToNot(HaveOccurred())
+			resourceVersion := getService().ResourceVersion
+
+			// a new handler, as created when HCO restarts, reads the hash from the resource
+			handler = (*genericOperand)(newMetricsServiceHandler(cl, commonTestUtils.GetScheme()))
+			skipped := getWrites("MetricsService", metrics.WriteSkipped)
+			res := handler.ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Updated).To(BeFalse())
+			Expect(getWrites("MetricsService", metrics.WriteSkipped)).To(Equal(skipped))
+
+			Expect(handler.ensure(req).Err).ToNot(HaveOccurred())
+			Expect(getWrites("MetricsService", metrics.WriteSkipped)).To(Equal(skipped + 1))
+			Expect(getService().ResourceVersion).To(Equal(resourceVersion))
+		})
+
+		It("should compare the resource if its hash is not the hash of the desired state", func() {
+			Expect(handler.ensure(req).Err).ToNot(HaveOccurred())
+
+			svc := getService()
+			svc.Annotations[hcoutil.DesiredStateHashAnnotation] = "other"
+			Expect(cl.Update(context.TODO(), svc)).To(Succeed())
+			handler.setDesiredStateApplied(getService())
+
+			skipped := getWrites("MetricsService", metrics.WriteSkipped)
+			Expect(handler.ensure(req).Err).ToNot(HaveOccurred())
+			Expect(getWrites("MetricsService", metrics.WriteSkipped)).To(Equal(skipped))
+		})
+	})
+
+	Context("operand CRs", func() {
+		var (
+			cl      *commonTestUtils.HcoTestClient
+			handler *genericOperand
+		)
+
+		getKv := func() *kubevirtv1.KubeVirt {
+			kv := &kubevirtv1.KubeVirt{}
+			Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(NewKubeVirtWithNameOnly(hco)), kv)).To(Succeed())
+			return kv
+		}
+
+		BeforeEach(func() {
+			cl = commonTestUtils.InitClient([]runtime.Object{hco})
+			handler = (*genericOperand)(newKubevirtHandler(cl, commonTestUtils.GetScheme()))
+			Expect(handler.ensure(req).Err).ToNot(HaveOccurred())
+			Expect(getKv().Annotations).To(HaveKey(hcoutil.DesiredStateHashAnnotation))
+		})
+
+		It("should skip the comparison if only the status of the operand CR was modified", func() {
+			kv := getKv()
+			kv.Generation = 1
+			Expect(cl.Update(context.TODO(), kv)).To(Succeed())
+			Expect(handler.ensure(req).Err).ToNot(HaveOccurred())
+
+			kv = getKv()
+			kv.Status.ObservedKubeVirtVersion = "v1.2.3"
+			Expect(cl.Update(context.TODO(), kv)).To(Succeed())
+
+			skipped := getWrites("KubeVirt", metrics.WriteSkipped)
+			res := handler.ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Updated).To(BeFalse())
+			Expect(getWrites("KubeVirt", metrics.WriteSkipped)).To(Equal(skipped + 1))
+		})
+
+		It("should update the operand CR, and its hash, if the HyperConverged CR was modified", func() {
+			origHash := getKv().Annotations[hcoutil.DesiredStateHashAnnotation]
+			origResourceVersion, err := strconv.Atoi(getKv().ResourceVersion)
+			Expect(err).ToNot(HaveOccurred())
+
+			hco.Spec.CertConfig.CA.Duration = metav1.Duration{Duration: 96 * time.Hour}
+			req = commonTestUtils.NewReq(hco)
+			handler.reset()
+			res := handler.ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Updated).To(BeTrue())
+
+			kv := getKv()
+			Expect(kv.Spec.CertificateRotationStrategy.SelfSigned.CA.Duration.Duration).To(Equal(96 * time.Hour))
+			Expect(kv.Annotations).To(HaveKey(hcoutil.DesiredStateHashAnnotation))
+			Expect(kv.Annotations[hcoutil.DesiredStateHashAnnotation]).ToNot(Equal(origHash))
+			// the hash is written by the same apply
+			Expect(kv.ResourceVersion).To(Equal(strconv.Itoa(origResourceVersion + 1)))
+		})
+
+		It("should not write an operand CR without a hash, only to add the hash", func() {
+			kv := getKv()
+			delete(kv.Annotations, hcoutil.DesiredStateHashAnnotation)
+			Expect(cl.Update(context.TODO(), kv)).To(Succeed())
+			resourceVersion := getKv().ResourceVersion
+
+			handler.reset()
+			res := handler.ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Updated).To(BeFalse())
+
+			kv = getKv()
+			Expect(kv.ResourceVersion).To(Equal(resourceVersion))
+			Expect(kv.Annotations).ToNot(HaveKey(hcoutil.DesiredStateHashAnnotation))
+		})
+
+		It("should revert an out-of-band modification of the labels of the operand CR", func() {
+			kv := getKv()
+			kv.Generation = 1
+			Expect(cl.Update(context.TODO(), kv)).To(Succeed())
+			Expect(handler.ensure(req).Err).ToNot(HaveOccurred())
+
+			kv = getKv()
+			Expect(kv.Labels).To(HaveKeyWithValue(hcoutil.AppLabel, hco.Name))
+			kv.Labels[hcoutil.AppLabel] = "other"
+			Expect(cl.Update(context.TODO(), kv)).To(Succeed())
+
+			req.HCOTriggered = false
+			res := handler.ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Updated).To(BeTrue())
+			Expect(res.Overwritten).To(BeTrue())
+			Expect(getKv().Labels).To(HaveKeyWithValue(hcoutil.AppLabel, hco.Name))
+		})
+
+		It("should not skip the comparison during an upgrade", func() {
+			req.SetUpgradeMode(true)
+
+			skipped := getWrites("KubeVirt", metrics.WriteSkipped)
+			Expect(handler.ensure(req).Err).ToNot(HaveOccurred())
+			Expect(getWrites("KubeVirt", metrics.WriteSkipped)).To(Equal(skipped))
+		})
+	})
+})
@@ -2,8 +2,11 @@ package operands
 
 import (
 	"fmt"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"strings"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1beta1"
 )
 
 type EnsureResult struct {
@@ -14,9 +17,24 @@ type EnsureResult struct {
 	Err         error
 	Type        string
 	Name        string
+	Namespace   string
+	// Component is the observed state of the operand, to be reported in the HyperConverged status; nil if the
+	// resource is not an operand
+	Component *hcov1beta1.ComponentStatus
+	// Version is the observed and the expected versions of the operand, to be reported in the HyperConverged status;
+	// nil if the resource is not an operand
+	Version *hcov1beta1.Version
+	// Drift describes the out-of-band modification of the resource, if it was overwritten
+	Drift *hcov1beta1.DriftReport
+	// Warned is true if the resource was modified out-of-band, but was not overwritten due to its reconcile policy
+	Warned bool
 }
 
 func NewEnsureResult(resource runtime.Object) *EnsureResult {
+	if u, ok := resource.(*unstructured.Unstructured); ok {
+		return &EnsureResult{Type: u.GetKind()}
+	}
+
 	t := fmt.Sprintf("%T", resource)
 	p := strings.LastIndex(t, ".")
 	return &EnsureResult{Type: t[p+1:]}
@@ -51,3 +69,28 @@ func (r *EnsureResult) SetName(name string) *EnsureResult {
 	r.Name = name
 	return r
 }
+
+func (r *EnsureResult) SetNamespace(namespace string) *EnsureResult {
+	r.Namespace = namespace
+	return r
+}
+
+func (r *EnsureResult) SetComponent(component *hcov1beta1.ComponentStatus) *EnsureResult {
+	r.Component = component
+	return r
+}
+
+func (r *EnsureResult) SetVersion(version *hcov1beta1.Version) *EnsureResult {
+	r.Version = version
+	return r
+}
+
+func (r *EnsureResult) SetDrift(drift *hcov1beta1.DriftReport) *EnsureResult {
+	r.Drift = drift
+	return r
+}
+
+func (r *EnsureResult) SetWarned() *EnsureResult {
+	r.Warned = true
+	return r
+}
@@ -0,0 +1,161 @@
+package operands
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/controller/common"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+const (
+	hppName = "hostpath-provisioner"
+)
+
+// The hostpath provisioner API is not vendored; the HostPathProvisioner CR is handled as an unstructured object
+var HostPathProvisionerGVK = schema.GroupVersionKind{
+	Group:   "hostpathprovisioner.kubevirt.io",
+	Version: "v1beta1",
+	Kind:    "HostPathProvisioner",
+}
+
+// hppHandler deploys the HostPathProvisioner CR when it is configured in the HyperConverged CR, and removes it when
+// the configuration is removed.
+type hppHandler struct {
+	genericOperand
+}
+
+func newHppHandler(Client client.Client, Scheme *runtime.Scheme) *hppHandler {
+	return &hppHandler{
+		genericOperand: genericOperand{
+			Client:                 Client,
+			Scheme:                 Scheme,
+			crType:                 "HostPathProvisioner",
+			removeExistingOwner:    false,
+			setControllerReference: false,
+			hooks:                  &hppHooks{},
+		},
+	}
+}
+
+func (h *hppHandler) ensure(req *common.HcoRequest) *EnsureResult {
+	if isHppConfigured(req.Instance) {
+		return h.genericOperand.ensure(req)
+	}
+
+	// the hostpath provisioner is not configured; make sure it was not left behind by a previous configuration
+	hpp := NewHostPathProvisionerWithNameOnly(req.Instance)
+	res := NewEnsureResult(hpp).SetName(hpp.GetName()).SetUpgradeDone(true)
+
+	if GetReconcilePolicy(req.Instance, res.Type) == hcov1beta1.ReconcilePolicyPaused {
+		req.Logger.Info(h.crType + " is paused; not removing it")
+		return res
+	}
+
+	err := hcoutil.EnsureDeleted(req.Ctx, h.Client, hpp, req.Instance.Name, req.Logger, false, false)
+	if err != nil && !apierrors.IsNotFound(err) && !meta.IsNoMatchError(err) {
+		return res.Error(fmt.Errorf("failed to remove the %s; %w", h.crType, err))
+	}
+
+	return res
+}
+
+type hppHooks struct {
+	cache *unstructured.Unstructured
+}
+
+func (h *hppHooks) getFullCr(hc *hcov1beta1.HyperConverged) (client.Object, error) {
+	if h.cache == nil {
+		hpp, err := NewHostPathProvisioner(hc)
+		if err != nil {
+			return nil, err
+		}
+		h.cache = hpp
+	}
+	return h.cache, nil
+}
+
+func (h hppHooks) getEmptyCr() client.Object { return NewEmptyHostPathProvisioner() }
+
+func (h hppHooks) getConditions(cr runtime.Object) []metav1.Condition {
+	return getUnstructuredConditions(cr)
+}
+func (h hppHooks) getObservedVersion(cr runtime.Object) string {
+	return getUnstructuredObservedVersion(cr)
+}
+func (h hppHooks) getExpectedVersion() string {
+	return os.Getenv(hcoutil.HppoVersionEnvV)
+}
+func (h hppHooks) getObjectMeta(cr runtime.Object) *metav1.ObjectMeta {
+	return getUnstructuredObjectMeta(cr)
+}
+func (h *hppHooks) reset() {
+	h.cache = nil
+}
+
+func (h *hppHooks) updateCr(req *common.HcoRequest, Client client.Client, exists runtime.Object, required runtime.Object) (bool, bool, error) {
+	return updateUnstructuredSpec(req, Client, "HostPathProvisioner", exists, required)
+}
+
+func isHppConfigured(hc *hcov1beta1.HyperConverged) bool {
+	return hc.Spec.Storage != nil && hc.Spec.Storage.HostPathProvisioner != nil
+}
+
+// NewHostPathProvisioner builds the HostPathProvisioner CR from the HyperConverged storage configuration
+func NewHostPathProvisioner(hc *hcov1beta1.HyperConverged) (*unstructured.Unstructured, error) {
+	if !isHppConfigured(hc) {
+		return nil, errors.New("the hostpath provisioner is not configured")
+	}
+
+	hppConfig := hc.Spec.Storage.HostPathProvisioner
+
+	spec := map[string]interface{}{
+		"pathConfig": map[string]interface{}{
+			"path":            hppConfig.PathConfig.Path,
+			"useNamingPrefix": hppConfig.PathConfig.UseNamingPrefix,
+		},
+	}
+
+	if hppConfig.ImagePullPolicy != "" {
+		spec["imagePullPolicy"] = string(hppConfig.ImagePullPolicy)
+	}
+
+	if placement := getWorkloadsConfig(hc, hcoutil.AppComponentStorage).NodePlacement; placement != nil {
+		workload, err := runtime.DefaultUnstructuredConverter.ToUnstructured(placement)
+		if err != nil {
+			return nil, fmt.Errorf("can't convert the workloads node placement; %w", err)
+		}
+		spec["workload"] = workload
+	}
+
+	hpp := NewHostPathProvisionerWithNameOnly(hc)
+	hpp.SetLabels(getLabels(hc, hcoutil.AppComponentStorage))
+	hpp.Object["spec"] = spec
+
+	return hpp, nil
+}
+
+func NewHostPathProvisionerWithNameOnly(hc *hcov1beta1.HyperConverged) *unstructured.Unstructured {
+	hpp := NewEmptyHostPathProvisioner()
+	hpp.SetName(hppName)
+	hpp.SetLabels(map[string]string{hcoutil.AppLabel: hc.Name})
+	return hpp
+}
+
+// NewEmptyHostPathProvisioner returns an unstructured object with only the HostPathProvisioner GVK, to be used for
+// reading the HostPathProvisioner CR and for watching it
+func NewEmptyHostPathProvisioner() *unstructured.Unstructured {
+	hpp := &unstructured.Unstructured{}
+	hpp.SetGroupVersionKind(HostPathProvisionerGVK)
+	return hpp
+}
@@ -0,0 +1,198 @@
+package operands
+
+import (
+	"context"
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/controller/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/controller/commonTestUtils"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+var _ = Describe("HostPathProvisioner Operand", func() {
+
+	Context("HostPathProvisioner", func() {
+		var hco *hcov1beta1.HyperConverged
+		var req *common.HcoRequest
+
+		BeforeEach(func() {
+			hco = commonTestUtils.NewHco()
+			hco.Spec.Storage = &hcov1beta1.HyperConvergedStorageConfig{
+				HostPathProvisioner: &hcov1beta1.HostPathProvisionerConfig{
+					PathConfig: hcov1beta1.HostPathProvisionerPathConfig{
+						Path:            "/var/hpvolumes",
+						UseNamingPrefix: true,
+					},
+				},
+			}
+			req = commonTestUtils.NewReq(hco)
+		})
+
+		getHpp := func(cl *commonTestUtils.HcoTestClient) (*unstructured.Unstructured, error) {
+			foundResource := NewEmptyHostPathProvisioner()
+			err := cl.Get(context.TODO(), types.NamespacedName{Name: hppName}, foundResource)
+			return foundResource, err
+		}
+
+		It("should create if not present", func() {
+			cl := commonTestUtils.InitClient([]runtime.Object{})
+			handler := newHppHandler(cl, commonTestUtils.GetScheme())
+			res := handler.ensure(req)
+			Expect(res.Created).To(BeTrue())
+			Expect(res.Updated).To(BeFalse())
+			Expect(res.Overwritten).To(BeFalse())
+			Expect(res.UpgradeDone).To(BeFalse())
+			Expect(res.Err).To(BeNil())
+			Expect(res.Type).To(Equal("HostPathProvisioner"))
+
+			foundResource, err := getHpp(cl)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(foundResource.GetLabels()).Should(HaveKeyWithValue(hcoutil.AppLabel, commonTestUtils.Name))
+			Expect(foundResource.GetLabels()).Should(HaveKeyWithValue(hcoutil.AppLabelComponent, string(hcoutil.AppComponentStorage)))
+
+			path, _, err := unstructured.NestedString(foundResource.Object, "spec", "pathConfig", "path")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(path).To(Equal("/var/hpvolumes"))
+			useNamingPrefix, _, err := unstructured.NestedBool(foundResource.Object, "spec", "pathConfig", "useNamingPrefix")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(useNamingPrefix).To(BeTrue())
+		})
+
+		It("should not create if not configured", func() {
+			hco.Spec.Storage = nil
+			cl := commonTestUtils.InitClient([]runtime.Object{})
+			handler := newHppHandler(cl, commonTestUtils.GetScheme())
+			res := handler.ensure(req)
+			Expect(res.Created).To(BeFalse())
+			Expect(res.Updated).To(BeFalse())
+			Expect(res.UpgradeDone).To(BeTrue())
+			Expect(res.Err).To(BeNil())
+
+			_, err := getHpp(cl)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should remove the HostPathProvisioner when the configuration is removed", func() {
+			existingResource, err := NewHostPathProvisioner(hco)
+			Expect(err).ToNot(HaveOccurred())
+
+			hco.Spec.Storage.HostPathProvisioner = nil
+			cl := commonTestUtils.InitClient([]runtime.Object{hco, existingResource})
+			handler := newHppHandler(cl, commonTestUtils.GetScheme())
+			res := handler.ensure(req)
+			Expect(res.Err).To(BeNil())
+			Expect(res.UpgradeDone).To(BeTrue())
+
+			_, err = getHpp(cl)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reconcile to default", func() {
+			existingResource, err := NewHostPathProvisioner(hco)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(unstructured.SetNestedField(existingResource.Object, "/var/other", "spec", "pathConfig", "path")).To(Succeed())
+
+			req.HCOTriggered = false // mock a reconciliation triggered by a change in the HostPathProvisioner CR
+
+			cl := commonTestUtils.InitClient([]runtime.Object{hco, existingResource})
+			handler := newHppHandler(cl, commonTestUtils.GetScheme())
+			res := handler.ensure(req)
+			Expect(res.Created).To(BeFalse())
+			Expect(res.Updated).To(BeTrue())
+			Expect(res.Overwritten).To(BeTrue())
+			Expect(res.Err).To(BeNil())
+
+			foundResource, err := getHpp(cl)
+			Expect(err).ToNot(HaveOccurred())
+			path, _, err := unstructured.NestedString(foundResource.Object, "spec", "pathConfig", "path")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(path).To(Equal("/var/hpvolumes"))
+
+			Expect(hco.Status.RelatedObjects).To(HaveLen(1))
+			Expect(hco.Status.RelatedObjects[0].Kind).To(Equal("HostPathProvisioner"))
+		})
+
+		It("should add the workloads node placement", func() {
+			hco.Spec.Workloads.NodePlacement = commonTestUtils.NewNodePlacement()
+
+			cl := commonTestUtils.InitClient([]runtime.Object{hco})
+			handler := newHppHandler(cl, commonTestUtils.GetScheme())
+			res := handler.ensure(req)
+			Expect(res.Created).To(BeTrue())
+			Expect(res.Err).To(BeNil())
+
+			foundResource, err := getHpp(cl)
+			Expect(err).ToNot(HaveOccurred())
+			nodeSelector, _, err := unstructured.NestedStringMap(foundResource.Object, "spec", "workload", "nodeSelector")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(nodeSelector).To(Equal(hco.Spec.Workloads.NodePlacement.NodeSelector))
+		})
+
+		It("should handle conditions", func() {
+			expectedResource, err := NewHostPathProvisioner(hco)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(unstructured.SetNestedSlice(expectedResource.Object, []interface{}{
+				map[string]interface{}{"type": "Available", "status": string(corev1.ConditionFalse), "reason": "Foo", "message": "Bar"},
+				map[string]interface{}{"type": "Progressing", "status": string(corev1.ConditionTrue), "reason": "Foo", "message": "Bar"},
+				map[string]interface{}{"type": "Degraded", "status": string(corev1.ConditionTrue), "reason": "Foo", "message": "Bar"},
+			}, "status", "conditions")).To(Succeed())
+
+			cl := commonTestUtils.InitClient([]runtime.Object{hco, expectedResource})
+			handler := newHppHandler(cl, commonTestUtils.GetScheme())
+			res := handler.ensure(req)
+			Expect(res.UpgradeDone).To(BeFalse())
+			Expect(res.Err).To(BeNil())
+
+			Expect(req.Conditions[hcov1beta1.ConditionAvailable]).To(commonTestUtils.RepresentCondition(metav1.Condition{
+				Type:    hcov1beta1.ConditionAvailable,
+				Status:  metav1.ConditionFalse,
+				Reason:  "HostPathProvisionerNotAvailable",
+				Message: "HostPathProvisioner is not available: Bar",
+			}))
+			Expect(req.Conditions[hcov1beta1.ConditionProgressing]).To(commonTestUtils.RepresentCondition(metav1.Condition{
+				Type:    hcov1beta1.ConditionProgressing,
+				Status:  metav1.ConditionTrue,
+				Reason:  "HostPathProvisionerProgressing",
+				Message: "HostPathProvisioner is progressing: Bar",
+			}))
+			Expect(req.Conditions[hcov1beta1.ConditionDegraded]).To(commonTestUtils.RepresentCondition(metav1.Condition{
+				Type:    hcov1beta1.ConditionDegraded,
+				Status:  metav1.ConditionTrue,
+				Reason:  "HostPathProvisionerDegraded",
+				Message: "HostPathProvisioner is degraded: Bar",
+			}))
+		})
+
+		It("should complete the upgrade when the expected version is observed", func() {
+			const expectedVersion = "v1.2.3"
+			origVersion := os.Getenv(hcoutil.HppoVersionEnvV)
+			Expect(os.Setenv(hcoutil.HppoVersionEnvV, expectedVersion)).To(Succeed())
+			defer os.Setenv(hcoutil.HppoVersionEnvV, origVersion)
+
+			expectedResource, err := NewHostPathProvisioner(hco)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(unstructured.SetNestedSlice(expectedResource.Object, []interface{}{
+				map[string]interface{}{"type": "Available", "status": string(corev1.ConditionTrue)},
+				map[string]interface{}{"type": "Progressing", "status": string(corev1.ConditionFalse)},
+				map[string]interface{}{"type": "Degraded", "status": string(corev1.ConditionFalse)},
+			}, "status", "conditions")).To(Succeed())
+			Expect(unstructured.SetNestedField(expectedResource.Object, expectedVersion, "status", "observedVersion")).To(Succeed())
+
+			req.UpgradeMode = true
+			cl := commonTestUtils.InitClient([]runtime.Object{hco, expectedResource})
+			handler := newHppHandler(cl, commonTestUtils.GetScheme())
+			res := handler.ensure(req)
+			Expect(res.Err).To(BeNil())
+			Expect(res.UpgradeDone).To(BeTrue())
+		})
+	})
+})
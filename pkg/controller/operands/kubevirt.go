@@ -21,7 +21,6 @@ import (
 
 	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1beta1"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/controller/common"
-	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 )
 
@@ -167,6 +166,7 @@ func newKubevirtHandler(Client client.Client, Scheme *runtime.Scheme) *kubevirtH
 		removeExistingOwner:    false,
 		setControllerReference: true,
 		hooks:                  &kubevirtHooks{},
+		dependsOn:              []string{"KubeVirtPriorityClass"},
 	}
 }
 
@@ -189,9 +189,11 @@ func (h kubevirtHooks) getEmptyCr() client.Object { return &kubevirtv1.KubeVirt{
 func (h kubevirtHooks) getConditions(cr runtime.Object) []metav1.Condition {
 	return translateKubeVirtConds(cr.(*kubevirtv1.KubeVirt).Status.Conditions)
 }
-func (h kubevirtHooks) checkComponentVersion(cr runtime.Object) bool {
-	found := cr.(*kubevirtv1.KubeVirt)
-	return checkComponentVersion(hcoutil.KubevirtVersionEnvV, found.Status.ObservedKubeVirtVersion)
+func (h kubevirtHooks) getObservedVersion(cr runtime.Object) string {
+	return cr.(*kubevirtv1.KubeVirt).Status.ObservedKubeVirtVersion
+}
+func (h kubevirtHooks) getExpectedVersion() string {
+	return os.Getenv(hcoutil.KubevirtVersionEnvV)
 }
 func (h kubevirtHooks) getObjectMeta(cr runtime.Object) *metav1.ObjectMeta {
 	return &cr.(*kubevirtv1.KubeVirt).ObjectMeta
@@ -206,22 +208,7 @@ func (h *kubevirtHooks) updateCr(req *common.HcoRequest, Client client.Client, e
 	if !ok1 || !ok2 {
 		return false, false, errors.New("can't convert to KubeVirt")
 	}
-	if !reflect.DeepEqual(found.Spec, virt.Spec) ||
-		!reflect.DeepEqual(found.Labels, virt.Labels) {
-		if req.HCOTriggered {
-			req.Logger.Info("Updating existing KubeVirt's Spec to new opinionated values")
-		} else {
-			req.Logger.Info("Reconciling an externally updated KubeVirt's Spec to its opinionated values")
-		}
-		util.DeepCopyLabels(&virt.ObjectMeta, &found.ObjectMeta)
-		virt.Spec.DeepCopyInto(&found.Spec)
-		err := Client.Update(req.Ctx, found)
-		if err != nil {
-			return false, false, err
-		}
-		return true, !req.HCOTriggered, nil
-	}
-	return false, false, nil
+	return applyCr(req, Client, "KubeVirt", found, virt)
 }
 
 func NewKubeVirt(hc *hcov1beta1.HyperConverged, opts ...string) (*kubevirtv1.KubeVirt, error) {
@@ -234,8 +221,8 @@ func NewKubeVirt(hc *hcov1beta1.HyperConverged, opts ...string) (*kubevirtv1.Kub
 
 	spec := kubevirtv1.KubeVirtSpec{
 		UninstallStrategy:           kubevirtv1.KubeVirtUninstallStrategyBlockUninstallIfWorkloadsExist,
-		Infra:                       hcoConfig2KvConfig(hc.Spec.Infra),
-		Workloads:                   hcoConfig2KvConfig(hc.Spec.Workloads),
+		Infra:                       hcoConfig2KvConfig(getInfraConfig(hc, hcoutil.AppComponentCompute)),
+		Workloads:                   hcoConfig2KvConfig(getWorkloadsConfig(hc, hcoutil.AppComponentCompute)),
 		Configuration:               *config,
 		CertificateRotationStrategy: *kvCertConfig,
 		WorkloadUpdateStrategy:      hcWorkloadUpdateStrategyToKv(hc.Spec.WorkloadUpdateStrategy),
@@ -462,11 +449,11 @@ func hcoConfig2KvConfig(hcoConfig hcov1beta1.HyperConvergedConfig) *kubevirtv1.C
 func getFeatureGateChecks(featureGates *hcov1beta1.HyperConvergedFeatureGates) []string {
 	fgs := make([]string, 0, 2)
 
-	if featureGates.WithHostPassthroughCPU {
+	if featureGates.IsWithHostPassthroughCPUEnabled() {
 		fgs = append(fgs, kvWithHostPassthroughCPU)
 	}
 
-	if featureGates.SRIOVLiveMigration {
+	if featureGates.IsSRIOVLiveMigrationEnabled() {
 		fgs = append(fgs, kvSRIOVLiveMigration)
 	}
 
@@ -562,10 +549,11 @@ func translateKubeVirtConds(orig []kubevirtv1.KubeVirtCondition) []metav1.Condit
 
 	for i, origCond := range orig {
 		translated[i] = metav1.Condition{
-			Type:    string(origCond.Type),
-			Status:  metav1.ConditionStatus(origCond.Status),
-			Reason:  origCond.Reason,
-			Message: origCond.Message,
+			Type:               string(origCond.Type),
+			Status:             metav1.ConditionStatus(origCond.Status),
+			Reason:             origCond.Reason,
+			Message:            origCond.Message,
+			LastTransitionTime: origCond.LastTransitionTime,
 		}
 	}
 
@@ -18,6 +18,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/reference"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	kubevirtv1 "kubevirt.io/client-go/api/v1"
 
@@ -191,7 +192,7 @@ Version: 1.2.3`)
 		It("should create if not present", func() {
 			mandatoryKvFeatureGates = getMandatoryKvFeatureGates(false)
 			hco.Spec.FeatureGates = hcov1beta1.HyperConvergedFeatureGates{
-				WithHostPassthroughCPU: true,
+				WithHostPassthroughCPU: boolPtr(true),
 			}
 
 			expectedResource, err := NewKubeVirt(hco, commonTestUtils.Namespace)
@@ -254,6 +255,7 @@ Version: 1.2.3`)
 			expectedResource, err := NewKubeVirt(hco, commonTestUtils.Namespace)
 			Expect(err).ToNot(HaveOccurred())
 			expectedResource.ObjectMeta.SelfLink = fmt.Sprintf("/apis/v1/namespaces/%s/dummies/%s", expectedResource.Namespace, expectedResource.Name)
+			Expect(controllerutil.SetControllerReference(hco, expectedResource, commonTestUtils.GetScheme())).To(Succeed())
 			cl := commonTestUtils.InitClient([]runtime.Object{hco, expectedResource})
 			handler := (*genericOperand)(newKubevirtHandler(cl, commonTestUtils.GetScheme()))
 			res := handler.ensure(req)
@@ -290,7 +292,7 @@ Version: 1.2.3`)
 		It("should force mandatory configurations", func() {
 			mandatoryKvFeatureGates = getMandatoryKvFeatureGates(false)
 			hco.Spec.FeatureGates = hcov1beta1.HyperConvergedFeatureGates{
-				WithHostPassthroughCPU: true,
+				WithHostPassthroughCPU: boolPtr(true),
 			}
 
 			os.Setenv(smbiosEnvName,
@@ -388,7 +390,7 @@ Version: 1.2.3`)
 
 		It("should fail if the SMBIOS is wrongly formatted mandatory configurations", func() {
 			hco.Spec.FeatureGates = hcov1beta1.HyperConvergedFeatureGates{
-				WithHostPassthroughCPU: true,
+				WithHostPassthroughCPU: boolPtr(true),
 			}
 
 			_ = os.Setenv(smbiosEnvName, "WRONG YAML")
@@ -996,7 +998,7 @@ Version: 1.2.3`)
 				It("should add the WithHostPassthroughCPU feature gate if it's set in HyperConverged CR", func() {
 					// one enabled, one disabled and one missing
 					hco.Spec.FeatureGates = hcov1beta1.HyperConvergedFeatureGates{
-						WithHostPassthroughCPU: true,
+						WithHostPassthroughCPU: boolPtr(true),
 					}
 
 					existingResource, err := NewKubeVirt(hco)
@@ -1010,7 +1012,7 @@ Version: 1.2.3`)
 				It("should not add the WithHostPassthroughCPU feature gate if it's disabled in HyperConverged CR", func() {
 					// one enabled, one disabled and one missing
 					hco.Spec.FeatureGates = hcov1beta1.HyperConvergedFeatureGates{
-						WithHostPassthroughCPU: false,
+						WithHostPassthroughCPU: boolPtr(false),
 					}
 
 					existingResource, err := NewKubeVirt(hco)
@@ -1024,7 +1026,7 @@ Version: 1.2.3`)
 				It("should add the SRIOVLiveMigration feature gate if it's set in HyperConverged CR", func() {
 					// one enabled, one disabled and one missing
 					hco.Spec.FeatureGates = hcov1beta1.HyperConvergedFeatureGates{
-						SRIOVLiveMigration: true,
+						SRIOVLiveMigration: boolPtr(true),
 					}
 
 					existingResource, err := NewKubeVirt(hco)
@@ -1038,7 +1040,7 @@ Version: 1.2.3`)
 				It("should not add the SRIOVLiveMigration feature gate if it's disabled in HyperConverged CR", func() {
 					// one enabled, one disabled and one missing
 					hco.Spec.FeatureGates = hcov1beta1.HyperConvergedFeatureGates{
-						SRIOVLiveMigration: false,
+						SRIOVLiveMigration: boolPtr(false),
 					}
 
 					existingResource, err := NewKubeVirt(hco)
@@ -1070,8 +1072,8 @@ Version: 1.2.3`)
 					Expect(err).ToNot(HaveOccurred())
 
 					hco.Spec.FeatureGates = hcov1beta1.HyperConvergedFeatureGates{
-						WithHostPassthroughCPU: true,
-						SRIOVLiveMigration:     true,
+						WithHostPassthroughCPU: boolPtr(true),
+						SRIOVLiveMigration:     boolPtr(true),
 					}
 
 					cl := commonTestUtils.InitClient([]runtime.Object{hco, existingResource})
@@ -1101,8 +1103,8 @@ Version: 1.2.3`)
 					Expect(err).ToNot(HaveOccurred())
 
 					hco.Spec.FeatureGates = hcov1beta1.HyperConvergedFeatureGates{
-						WithHostPassthroughCPU: false,
-						SRIOVLiveMigration:     false,
+						WithHostPassthroughCPU: boolPtr(false),
+						SRIOVLiveMigration:     boolPtr(false),
 					}
 
 					cl := commonTestUtils.InitClient([]runtime.Object{hco, existingResource})
@@ -1167,10 +1169,11 @@ Version: 1.2.3`)
 					existingResource, err := NewKubeVirt(hco)
 					Expect(err).ToNot(HaveOccurred())
 					existingResource.Spec.Configuration.DeveloperConfiguration.FeatureGates = fgs
+					Expect(controllerutil.SetControllerReference(hco, existingResource, commonTestUtils.GetScheme())).To(Succeed())
 
 					hco.Spec.FeatureGates = hcov1beta1.HyperConvergedFeatureGates{
-						WithHostPassthroughCPU: true,
-						SRIOVLiveMigration:     true,
+						WithHostPassthroughCPU: boolPtr(true),
+						SRIOVLiveMigration:     boolPtr(true),
 					}
 
 					By("Make sure the existing KV is with the the expected FGs", func() {
@@ -1214,8 +1217,8 @@ Version: 1.2.3`)
 					})
 
 					hco.Spec.FeatureGates = hcov1beta1.HyperConvergedFeatureGates{
-						WithHostPassthroughCPU: false,
-						SRIOVLiveMigration:     false,
+						WithHostPassthroughCPU: boolPtr(false),
+						SRIOVLiveMigration:     boolPtr(false),
 					}
 
 					cl := commonTestUtils.InitClient([]runtime.Object{hco, existingResource})
@@ -1337,25 +1340,25 @@ Version: 1.2.3`)
 					),
 					Entry("When not using kvm-emulation and all FGs are disabled",
 						false,
-						&hcov1beta1.HyperConvergedFeatureGates{SRIOVLiveMigration: false, WithHostPassthroughCPU: false},
+						&hcov1beta1.HyperConvergedFeatureGates{SRIOVLiveMigration: boolPtr(false), WithHostPassthroughCPU: boolPtr(false)},
 						basicNumFgOnOpenshift,
 						[][]string{hardCodeKvFgs, sspConditionKvFgs},
 					),
 					Entry("When using kvm-emulation all FGs are disabled",
 						true,
-						&hcov1beta1.HyperConvergedFeatureGates{SRIOVLiveMigration: false, WithHostPassthroughCPU: false},
+						&hcov1beta1.HyperConvergedFeatureGates{SRIOVLiveMigration: boolPtr(false), WithHostPassthroughCPU: boolPtr(false)},
 						len(hardCodeKvFgs),
 						[][]string{hardCodeKvFgs},
 					),
 					Entry("When not using kvm-emulation and all FGs are enabled",
 						false,
-						&hcov1beta1.HyperConvergedFeatureGates{SRIOVLiveMigration: true, WithHostPassthroughCPU: true},
+						&hcov1beta1.HyperConvergedFeatureGates{SRIOVLiveMigration: boolPtr(true), WithHostPassthroughCPU: boolPtr(true)},
 						basicNumFgOnOpenshift+2,
 						[][]string{hardCodeKvFgs, sspConditionKvFgs, {kvWithHostPassthroughCPU}},
 					),
 					Entry("When using kvm-emulation all FGs are enabled",
 						true,
-						&hcov1beta1.HyperConvergedFeatureGates{SRIOVLiveMigration: true, WithHostPassthroughCPU: true},
+						&hcov1beta1.HyperConvergedFeatureGates{SRIOVLiveMigration: boolPtr(true), WithHostPassthroughCPU: boolPtr(true)},
 						len(hardCodeKvFgs)+2,
 						[][]string{hardCodeKvFgs, {kvWithHostPassthroughCPU}},
 					))
@@ -1900,6 +1903,7 @@ Version: 1.2.3`)
 			expectedResource, err := NewKubeVirt(hco, commonTestUtils.Namespace)
 			Expect(err).ToNot(HaveOccurred())
 			expectedResource.ObjectMeta.SelfLink = fmt.Sprintf("/apis/v1/namespaces/%s/dummies/%s", expectedResource.Namespace, expectedResource.Name)
+			Expect(controllerutil.SetControllerReference(hco, expectedResource, commonTestUtils.GetScheme())).To(Succeed())
 			expectedResource.Status.Conditions = []kubevirtv1.KubeVirtCondition{
 				{
 					Type:    kubevirtv1.KubeVirtConditionAvailable,
@@ -2388,3 +2392,7 @@ Version: 1.2.3`)
 		})
 	})
 })
+
+func boolPtr(b bool) *bool {
+	return &b
+}
@@ -28,12 +28,14 @@ const (
 	alertRuleGroup          = "kubevirt.hyperconverged.rules"
 	outOfBandUpdateAlert    = "KubevirtHyperconvergedClusterOperatorCRModification"
 	unsafeModificationAlert = "KubevirtHyperconvergedClusterOperatorUSModification"
+	operandNotEnforcedAlert = "KubevirtHyperconvergedClusterOperatorOperandNotEnforced"
 	runbookUrlTemplate      = "https://kubevirt.io/monitoring/runbooks/%s"
 )
 
 var (
 	outOfBandUpdateRunbookUrl    = fmt.Sprintf(runbookUrlTemplate, outOfBandUpdateAlert)
 	unsafeModificationRunbookUrl = fmt.Sprintf(runbookUrlTemplate, unsafeModificationAlert)
+	operandNotEnforcedRunbookUrl = fmt.Sprintf(runbookUrlTemplate, operandNotEnforcedAlert)
 )
 
 type metricsServiceHandler genericOperand
@@ -125,6 +127,7 @@ func newMetricsServiceMonitorHandler(Client client.Client, Scheme *runtime.Schem
 		removeExistingOwner:    false,
 		setControllerReference: true,
 		hooks:                  &metricsServiceMonitorHooks{},
+		dependsOn:              []string{"MetricsService"},
 	}
 }
 
@@ -279,6 +282,18 @@ func NewPrometheusRuleSpec() *monitoringv1.PrometheusRuleSpec {
 						"severity": "info",
 					},
 				},
+				{
+					Alert: operandNotEnforcedAlert,
+					Expr:  intstr.FromString("sum by(component_name, reconcile_policy) ((kubevirt_hco_operand_not_enforced)>0)"),
+					Annotations: map[string]string{
+						"description": "HCO does not enforce the required state of {{ $labels.component_name }}, due to its {{ $labels.reconcile_policy }} reconcile policy.",
+						"summary":     "The reconcile policy of an operand is not Enforce.",
+						"runbook_url": operandNotEnforcedRunbookUrl,
+					},
+					Labels: map[string]string{
+						"severity": "warning",
+					},
+				},
 				// Recording rules for openshift/cluster-monitoring-operator
 				{
 					Record: "cluster:vmi_request_cpu_cores:sum",
@@ -2,7 +2,7 @@ package operands
 
 import (
 	"errors"
-	"reflect"
+	"os"
 
 	networkaddonsshared "github.com/kubevirt/cluster-network-addons-operator/pkg/apis/networkaddonsoperator/shared"
 	networkaddonsv1 "github.com/kubevirt/cluster-network-addons-operator/pkg/apis/networkaddonsoperator/v1"
@@ -15,7 +15,6 @@ import (
 
 	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1beta1"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/controller/common"
-	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 )
 
@@ -53,9 +52,11 @@ func (h cnaHooks) getEmptyCr() client.Object { return &networkaddonsv1.NetworkAd
 func (h cnaHooks) getConditions(cr runtime.Object) []metav1.Condition {
 	return osConditionsToK8s(cr.(*networkaddonsv1.NetworkAddonsConfig).Status.Conditions)
 }
-func (h cnaHooks) checkComponentVersion(cr runtime.Object) bool {
-	found := cr.(*networkaddonsv1.NetworkAddonsConfig)
-	return checkComponentVersion(hcoutil.CnaoVersionEnvV, found.Status.ObservedVersion)
+func (h cnaHooks) getObservedVersion(cr runtime.Object) string {
+	return cr.(*networkaddonsv1.NetworkAddonsConfig).Status.ObservedVersion
+}
+func (h cnaHooks) getExpectedVersion() string {
+	return os.Getenv(hcoutil.CnaoVersionEnvV)
 }
 func (h cnaHooks) getObjectMeta(cr runtime.Object) *metav1.ObjectMeta {
 	return &cr.(*networkaddonsv1.NetworkAddonsConfig).ObjectMeta
@@ -74,43 +75,13 @@ func (h *cnaHooks) updateCr(req *common.HcoRequest, Client client.Client, exists
 
 	h.setDeployOvsAnnotation(req, found)
 
-	changed := h.updateSpec(req, found, networkAddons)
-	changed = h.updateLabels(found, networkAddons) || changed
-
-	if changed {
-		return h.updateCnaCr(req, Client, found)
-	}
-
-	return false, false, nil
-}
-
-func (h *cnaHooks) updateCnaCr(req *common.HcoRequest, Client client.Client, found *networkaddonsv1.NetworkAddonsConfig) (bool, bool, error) {
-	err := Client.Update(req.Ctx, found)
-	if err != nil {
-		return false, false, err
-	}
-	return true, !req.HCOTriggered, nil
-}
-
-func (h *cnaHooks) updateLabels(found *networkaddonsv1.NetworkAddonsConfig, networkAddons *networkaddonsv1.NetworkAddonsConfig) bool {
-	if !reflect.DeepEqual(found.Labels, networkAddons.Labels) {
-		util.DeepCopyLabels(&networkAddons.ObjectMeta, &found.ObjectMeta)
-		return true
+	if req.UpgradeMode {
+		// don't touch the CR during the upgrade. Applying the current spec would make HCO the owner of all its fields,
+		// and the next apply, without the fields that HCO doesn't set, would remove them.
+		return false, false, nil
 	}
-	return false
-}
 
-func (h *cnaHooks) updateSpec(req *common.HcoRequest, found *networkaddonsv1.NetworkAddonsConfig, networkAddons *networkaddonsv1.NetworkAddonsConfig) bool {
-	if !reflect.DeepEqual(found.Spec, networkAddons.Spec) && !req.UpgradeMode {
-		if req.HCOTriggered {
-			req.Logger.Info("Updating existing Network Addons's Spec to new opinionated values")
-		} else {
-			req.Logger.Info("Reconciling an externally updated Network Addons's Spec to its opinionated values")
-		}
-		networkAddons.Spec.DeepCopyInto(&found.Spec)
-		return true
-	}
-	return false
+	return applyCr(req, Client, "Network Addons", found, networkAddons)
 }
 
 // If deployOVS annotation doesn't exists prior the upgrade - set this annotation to true;
@@ -145,8 +116,8 @@ func NewNetworkAddons(hc *hcov1beta1.HyperConverged, opts ...string) (*networkad
 	}
 
 	cnaoSpec.Ovs = hcoAnnotation2CnaoSpec(hc.ObjectMeta.Annotations)
-	cnaoInfra := hcoConfig2CnaoPlacement(hc.Spec.Infra.NodePlacement)
-	cnaoWorkloads := hcoConfig2CnaoPlacement(hc.Spec.Workloads.NodePlacement)
+	cnaoInfra := hcoConfig2CnaoPlacement(getInfraConfig(hc, hcoutil.AppComponentNetwork).NodePlacement)
+	cnaoWorkloads := hcoConfig2CnaoPlacement(getWorkloadsConfig(hc, hcoutil.AppComponentNetwork).NodePlacement)
 	if cnaoInfra != nil || cnaoWorkloads != nil {
 		cnaoSpec.PlacementConfiguration = &networkaddonsshared.PlacementConfiguration{
 			Infra:     cnaoInfra,
@@ -128,6 +128,39 @@ var _ = Describe("CNA Operand", func() {
 
 		})
 
+		It("should not take the ownership of the CR fields during the upgrade", func() {
+			existingResource, err := NewNetworkAddons(hco)
+			Expect(err).ToNot(HaveOccurred())
+			existingResource.Spec.ImagePullPolicy = corev1.PullAlways
+			existingResource.Spec.Ovs = &networkaddonsshared.Ovs{}
+
+			By("Mock a CR that was created by a previous HCO version, without server-side apply")
+			cl := commonTestUtils.InitClient([]runtime.Object{hco})
+			Expect(cl.Create(context.TODO(), existingResource)).To(Succeed())
+			handler := (*genericOperand)(newCnaHandler(cl, commonTestUtils.GetScheme()))
+
+			req.SetUpgradeMode(true)
+			res := handler.ensure(req)
+			Expect(res.Err).To(BeNil())
+			Expect(res.Updated).To(BeFalse())
+			Expect(hco.Annotations).To(HaveKeyWithValue("deployOVS", "true"))
+
+			By("Reconcile after the upgrade")
+			req.SetUpgradeMode(false)
+			handler = (*genericOperand)(newCnaHandler(cl, commonTestUtils.GetScheme()))
+			res = handler.ensure(req)
+			Expect(res.Err).To(BeNil())
+
+			foundResource := &networkaddonsv1.NetworkAddonsConfig{}
+			Expect(
+				cl.Get(context.TODO(),
+					types.NamespacedName{Name: existingResource.Name, Namespace: existingResource.Namespace},
+					foundResource),
+			).To(BeNil())
+			Expect(foundResource.Spec.ImagePullPolicy).To(Equal(corev1.PullAlways))
+			Expect(foundResource.Spec.Ovs).ToNot(BeNil())
+		})
+
 		It("should add node placement if missing in CNAO", func() {
 			existingResource, err := NewNetworkAddons(hco)
 			Expect(err).ToNot(HaveOccurred())
@@ -0,0 +1,222 @@
+package operands
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/controller/common"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+const (
+	// NmoDeploymentName is the name of the node maintenance operator deployment, that OLM deploys from the HCO CSV
+	NmoDeploymentName = "node-maintenance-operator"
+
+	// the reason of the Progressing condition of a deployment, after its rollout is completed
+	newReplicaSetAvailableReason = "NewReplicaSetAvailable"
+)
+
+// nmoHandler reports the health and the version of the node maintenance operator. The operator has no configuration
+// CR, so they are read from its deployment. HCO does not create the deployment, as OLM deploys it; it only applies the
+// node placement of spec.nodeMaintenance on it.
+type nmoHandler struct {
+	genericOperand
+}
+
+func newNmoHandler(Client client.Client, Scheme *runtime.Scheme) *nmoHandler {
+	return &nmoHandler{
+		genericOperand: genericOperand{
+			Client:                 Client,
+			Scheme:                 Scheme,
+			crType:                 "NodeMaintenanceOperator",
+			removeExistingOwner:    false,
+			setControllerReference: false,
+			hooks:                  &nmoHooks{},
+		},
+	}
+}
+
+func (h *nmoHandler) ensure(req *common.HcoRequest) *EnsureResult {
+	cr, err := h.hooks.getFullCr(req.Instance)
+	if err != nil {
+		return &EnsureResult{
+			Err: err,
+		}
+	}
+
+	res := NewEnsureResult(cr)
+
+	key := client.ObjectKeyFromObject(cr)
+	found := h.hooks.getEmptyCr()
+	if err = h.Client.Get(req.Ctx, key, found); err != nil {
+		if apierrors.IsNotFound(err) {
+			// e.g. HCO was not deployed by OLM; there is nothing to report
+			req.Logger.Info("the node maintenance operator is not deployed; skipping")
+			return res.SetUpgradeDone(true)
+		}
+		return res.Error(err)
+	}
+	res.SetName(key.Name).SetNamespace(key.Namespace)
+
+	hash, err := getDesiredStateHash(cr)
+	if err != nil {
+		return res.Error(err)
+	}
+
+	return h.handleExistingCr(req, key, found, cr, hash, res)
+}
+
+type nmoHooks struct{}
+
+func (h nmoHooks) getFullCr(hc *hcov1beta1.HyperConverged) (client.Object, error) {
+	return newNmoDeploymentPlacement(hc)
+}
+
+func (h nmoHooks) getEmptyCr() client.Object { return &appsv1.Deployment{} }
+
+func (h nmoHooks) getConditions(cr runtime.Object) []metav1.Condition {
+	return getDeploymentConditions(cr.(*appsv1.Deployment))
+}
+func (h nmoHooks) getObservedVersion(cr runtime.Object) string {
+	return cr.(*appsv1.Deployment).Labels[hcoutil.OperatorVersionLabel]
+}
+func (h nmoHooks) getExpectedVersion() string {
+	return os.Getenv(hcoutil.NmoVersionEnvV)
+}
+func (h nmoHooks) getObjectMeta(cr runtime.Object) *metav1.ObjectMeta {
+	return &cr.(*appsv1.Deployment).ObjectMeta
+}
+
+func (h nmoHooks) updateCr(req *common.HcoRequest, Client client.Client, exists runtime.Object, required runtime.Object) (bool, bool, error) {
+	found, ok1 := exists.(*appsv1.Deployment)
+	cr, ok2 := required.(*unstructured.Unstructured)
+	if !ok1 || !ok2 {
+		return false, false, errors.New("can't convert to Deployment")
+	}
+
+	if !isNmoPlacementConfigured(req.Instance) && !isAppliedByHCO(found) {
+		// HCO never applied a node placement on the deployment; keep the node placement of the CSV
+		return false, false, nil
+	}
+
+	// applying an empty spec removes the node placement that HCO applied before
+	return applyCr(req, Client, "Deployment", found, cr)
+}
+
+func isNmoPlacementConfigured(hc *hcov1beta1.HyperConverged) bool {
+	return hc.Spec.NodeMaintenance != nil && hc.Spec.NodeMaintenance.NodePlacement != nil
+}
+
+// isAppliedByHCO checks if HCO owns any field of the object, by server-side apply
+func isAppliedByHCO(obj client.Object) bool {
+	for _, entry := range obj.GetManagedFields() {
+		if entry.Manager == hcoutil.HCOFieldManager && entry.Operation == metav1.ManagedFieldsOperationApply {
+			return true
+		}
+	}
+	return false
+}
+
+// newNmoDeploymentPlacement builds the configuration that HCO applies on the node maintenance operator deployment;
+// that is only the node placement of spec.nodeMaintenance, if it is set
+func newNmoDeploymentPlacement(hc *hcov1beta1.HyperConverged) (*unstructured.Unstructured, error) {
+	deployment := newNmoDeploymentWithNameOnly(hc)
+
+	if !isNmoPlacementConfigured(hc) {
+		return deployment, nil
+	}
+
+	podSpec, err := runtime.DefaultUnstructuredConverter.ToUnstructured(hc.Spec.NodeMaintenance.NodePlacement)
+	if err != nil {
+		return nil, fmt.Errorf("can't convert the node maintenance node placement; %w", err)
+	}
+
+	// the fields of the node placement have the same names as the scheduling fields of the pod spec
+	if err = unstructured.SetNestedMap(deployment.Object, podSpec, "spec", "template", "spec"); err != nil {
+		return nil, err
+	}
+
+	return deployment, nil
+}
+
+func newNmoDeploymentWithNameOnly(hc *hcov1beta1.HyperConverged) *unstructured.Unstructured {
+	deployment := &unstructured.Unstructured{}
+	deployment.SetGroupVersionKind(appsv1.SchemeGroupVersion.WithKind("Deployment"))
+	deployment.SetName(NmoDeploymentName)
+	deployment.SetNamespace(hc.Namespace)
+	return deployment
+}
+
+// getDeploymentConditions maps the conditions of a deployment to the Available, Progressing and Degraded conditions,
+// that HCO reads from the operand CRs. A deployment with no conditions yet, has no conditions.
+func getDeploymentConditions(deployment *appsv1.Deployment) []metav1.Condition {
+	if len(deployment.Status.Conditions) == 0 {
+		return nil
+	}
+
+	available := metav1.Condition{
+		Type:    hcov1beta1.ConditionAvailable,
+		Status:  metav1.ConditionFalse,
+		Reason:  "MinimumReplicasUnavailable",
+		Message: "Deployment does not have minimum availability.",
+	}
+	progressing := metav1.Condition{
+		Type:   hcov1beta1.ConditionProgressing,
+		Status: metav1.ConditionFalse,
+		Reason: newReplicaSetAvailableReason,
+	}
+	degraded := metav1.Condition{
+		Type:   hcov1beta1.ConditionDegraded,
+		Status: metav1.ConditionFalse,
+		Reason: "AsExpected",
+	}
+
+	for _, condition := range deployment.Status.Conditions {
+		switch condition.Type {
+		case appsv1.DeploymentAvailable:
+			setFromDeploymentCondition(&available, condition, metav1.ConditionStatus(condition.Status))
+
+		case appsv1.DeploymentProgressing:
+			switch {
+			case condition.Status == corev1.ConditionFalse:
+				// the progress deadline was exceeded
+				setFromDeploymentCondition(&degraded, condition, metav1.ConditionTrue)
+			case condition.Reason != newReplicaSetAvailableReason:
+				setFromDeploymentCondition(&progressing, condition, metav1.ConditionTrue)
+			default:
+				setFromDeploymentCondition(&progressing, condition, metav1.ConditionFalse)
+			}
+
+		case appsv1.DeploymentReplicaFailure:
+			if condition.Status == corev1.ConditionTrue && degraded.Status != metav1.ConditionTrue {
+				setFromDeploymentCondition(&degraded, condition, metav1.ConditionTrue)
+			}
+		}
+	}
+
+	if deployment.Status.ObservedGeneration < deployment.Generation {
+		// the conditions do not reflect the last modification of the deployment yet
+		progressing.Status = metav1.ConditionTrue
+		progressing.Reason = "DeploymentModified"
+		progressing.Message = "Deployment was modified, and was not observed yet."
+	}
+
+	return []metav1.Condition{available, progressing, degraded}
+}
+
+func setFromDeploymentCondition(target *metav1.Condition, condition appsv1.DeploymentCondition, status metav1.ConditionStatus) {
+	target.Status = status
+	target.Reason = condition.Reason
+	target.Message = condition.Message
+	target.LastTransitionTime = condition.LastTransitionTime
+}
@@ -0,0 +1,252 @@
+package operands
+
+import (
+	"context"
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/controller/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/controller/commonTestUtils"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+var _ = Describe("Node Maintenance Operator", func() {
+
+	Context("Node Maintenance Operator Deployment", func() {
+		var hco *hcov1beta1.HyperConverged
+		var req *common.HcoRequest
+
+		BeforeEach(func() {
+			hco = commonTestUtils.NewHco()
+			req = commonTestUtils.NewReq(hco)
+		})
+
+		newNmoDeployment := func(conditions ...appsv1.DeploymentCondition) *appsv1.Deployment {
+			return &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      NmoDeploymentName,
+					Namespace: commonTestUtils.Namespace,
+					Labels: map[string]string{
+						"name":                       NmoDeploymentName,
+						hcoutil.OperatorVersionLabel: "v1.2.3",
+					},
+				},
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Tolerations: []corev1.Toleration{{Key: "node-role.kubernetes.io/master", Effect: corev1.TaintEffectNoSchedule}},
+						},
+					},
+				},
+				Status: appsv1.DeploymentStatus{
+					Conditions: conditions,
+				},
+			}
+		}
+
+		getNmoDeployment := func(cl *commonTestUtils.HcoTestClient) *appsv1.Deployment {
+			found := &appsv1.Deployment{}
+			Expect(cl.Get(context.TODO(), types.NamespacedName{Name: NmoDeploymentName, Namespace: commonTestUtils.Namespace}, found)).To(Succeed())
+			return found
+		}
+
+		It("should skip if the deployment is not found", func() {
+			cl := commonTestUtils.InitClient([]runtime.Object{hco})
+			handler := newNmoHandler(cl, commonTestUtils.GetScheme())
+			res := handler.ensure(req)
+			Expect(res.Err).To(BeNil())
+			Expect(res.Created).To(BeFalse())
+			Expect(res.Updated).To(BeFalse())
+			Expect(res.UpgradeDone).To(BeTrue())
+			Expect(hco.Status.RelatedObjects).To(BeEmpty())
+		})
+
+		It("should not modify the deployment if the node placement is not set", func() {
+			existing := newNmoDeployment()
+			cl := commonTestUtils.InitClient([]runtime.Object{hco, existing})
+			handler := newNmoHandler(cl, commonTestUtils.GetScheme())
+			res := handler.ensure(req)
+			Expect(res.Err).To(BeNil())
+			Expect(res.Updated).To(BeFalse())
+
+			found := getNmoDeployment(cl)
+			Expect(found.Spec.Template.Spec.Tolerations).To(Equal(existing.Spec.Template.Spec.Tolerations))
+			Expect(found.Spec.Template.Spec.NodeSelector).To(BeEmpty())
+
+			Expect(hco.Status.RelatedObjects).To(HaveLen(1))
+			Expect(hco.Status.RelatedObjects[0].Kind).To(Equal("Deployment"))
+			Expect(hco.Status.RelatedObjects[0].Name).To(Equal(NmoDeploymentName))
+		})
+
+		It("should apply the node placement on the deployment", func() {
+			hco.Spec.NodeMaintenance = &hcov1beta1.HyperConvergedNodeMaintenanceConfig{
+				NodePlacement: commonTestUtils.NewNodePlacement(),
+			}
+			cl := commonTestUtils.InitClient([]runtime.Object{hco, newNmoDeployment()})
+			handler := newNmoHandler(cl, commonTestUtils.GetScheme())
+			res := handler.ensure(req)
+			Expect(res.Err).To(BeNil())
+			Expect(res.Updated).To(BeTrue())
+
+			found := getNmoDeployment(cl)
+			Expect(found.Spec.Template.Spec.NodeSelector).To(Equal(hco.Spec.NodeMaintenance.NodePlacement.NodeSelector))
+			Expect(found.Spec.Template.Spec.Tolerations).To(Equal(hco.Spec.NodeMaintenance.NodePlacement.Tolerations))
+			Expect(found.Spec.Template.Spec.Affinity).To(Equal(hco.Spec.NodeMaintenance.NodePlacement.Affinity))
+		})
+
+		It("should remove the node placement that HCO applied, when it is removed", func() {
+			hco.Spec.NodeMaintenance = &hcov1beta1.HyperConvergedNodeMaintenanceConfig{
+				NodePlacement: commonTestUtils.NewNodePlacement(),
+			}
+			cl := commonTestUtils.InitClient([]runtime.Object{hco, newNmoDeployment()})
+			handler := newNmoHandler(cl, commonTestUtils.GetScheme())
+			res := handler.ensure(req)
+			Expect(res.Err).To(BeNil())
+
+			// the fake client does not manage the fields; mock the fields that the server-side apply owns
+			found := getNmoDeployment(cl)
+			found.ManagedFields = []metav1.ManagedFieldsEntry{
+				{Manager: hcoutil.HCOFieldManager, Operation: metav1.ManagedFieldsOperationApply},
+			}
+			Expect(cl.Update(context.TODO(), found)).To(Succeed())
+
+			hco.Spec.NodeMaintenance = nil
+			req = commonTestUtils.NewReq(hco)
+			res = handler.ensure(req)
+			Expect(res.Err).To(BeNil())
+			Expect(res.Updated).To(BeTrue())
+
+			found = getNmoDeployment(cl)
+			Expect(found.Spec.Template.Spec.NodeSelector).To(BeEmpty())
+			Expect(found.Spec.Template.Spec.Affinity).To(BeNil())
+		})
+
+		It("should report the version of the operator from the deployment label", func() {
+			origVersion := os.Getenv(hcoutil.NmoVersionEnvV)
+			defer os.Setenv(hcoutil.NmoVersionEnvV, origVersion)
+
+			available := appsv1.DeploymentCondition{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionTrue, Reason: "MinimumReplicasAvailable"}
+			progressed := appsv1.DeploymentCondition{Type: appsv1.DeploymentProgressing, Status: corev1.ConditionTrue, Reason: newReplicaSetAvailableReason}
+
+			By("the deployment was not upgraded yet")
+			Expect(os.Setenv(hcoutil.NmoVersionEnvV, "v1.2.4")).To(Succeed())
+			req.UpgradeMode = true
+			cl := commonTestUtils.InitClient([]runtime.Object{hco, newNmoDeployment(available, progressed)})
+			handler := newNmoHandler(cl, commonTestUtils.GetScheme())
+			res := handler.ensure(req)
+			Expect(res.Err).To(BeNil())
+			Expect(res.UpgradeDone).To(BeFalse())
+
+			By("the deployment was upgraded")
+			Expect(os.Setenv(hcoutil.NmoVersionEnvV, "v1.2.3")).To(Succeed())
+			req = commonTestUtils.NewReq(hco)
+			req.UpgradeMode = true
+			res = handler.ensure(req)
+			Expect(res.Err).To(BeNil())
+			Expect(res.UpgradeDone).To(BeTrue())
+		})
+
+		It("should report the conditions of the deployment", func() {
+			cl := commonTestUtils.InitClient([]runtime.Object{hco, newNmoDeployment(
+				appsv1.DeploymentCondition{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionFalse, Reason: "MinimumReplicasUnavailable", Message: "Bar"},
+				appsv1.DeploymentCondition{Type: appsv1.DeploymentProgressing, Status: corev1.ConditionFalse, Reason: "ProgressDeadlineExceeded", Message: "Bar"},
+			)})
+			handler := newNmoHandler(cl, commonTestUtils.GetScheme())
+			res := handler.ensure(req)
+			Expect(res.Err).To(BeNil())
+			Expect(res.UpgradeDone).To(BeFalse())
+
+			Expect(req.Conditions[hcov1beta1.ConditionAvailable]).To(commonTestUtils.RepresentCondition(metav1.Condition{
+				Type:    hcov1beta1.ConditionAvailable,
+				Status:  metav1.ConditionFalse,
+				Reason:  "NodeMaintenanceOperatorNotAvailable",
+				Message: "NodeMaintenanceOperator is not available: Bar",
+			}))
+			Expect(req.Conditions[hcov1beta1.ConditionDegraded]).To(commonTestUtils.RepresentCondition(metav1.Condition{
+				Type:    hcov1beta1.ConditionDegraded,
+				Status:  metav1.ConditionTrue,
+				Reason:  "NodeMaintenanceOperatorDegraded",
+				Message: "NodeMaintenanceOperator is degraded: Bar",
+			}))
+		})
+	})
+
+	Context("getDeploymentConditions", func() {
+		getCondition := func(conditions []metav1.Condition, conditionType string) metav1.Condition {
+			for _, condition := range conditions {
+				if condition.Type == conditionType {
+					return condition
+				}
+			}
+			Fail("missing condition " + conditionType)
+			return metav1.Condition{}
+		}
+
+		It("should return no conditions if the deployment has no conditions yet", func() {
+			Expect(getDeploymentConditions(&appsv1.Deployment{})).To(BeEmpty())
+		})
+
+		DescribeTable("should map the deployment conditions",
+			func(deploymentConditions []appsv1.DeploymentCondition, observedGeneration int64, available, progressing, degraded metav1.ConditionStatus) {
+				deployment := &appsv1.Deployment{
+					ObjectMeta: metav1.ObjectMeta{Generation: 2},
+					Status: appsv1.DeploymentStatus{
+						ObservedGeneration: observedGeneration,
+						Conditions:         deploymentConditions,
+					},
+				}
+
+				conditions := getDeploymentConditions(deployment)
+				Expect(conditions).To(HaveLen(3))
+				Expect(getCondition(conditions, hcov1beta1.ConditionAvailable).Status).To(Equal(available))
+				Expect(getCondition(conditions, hcov1beta1.ConditionProgressing).Status).To(Equal(progressing))
+				Expect(getCondition(conditions, hcov1beta1.ConditionDegraded).Status).To(Equal(degraded))
+			},
+			Entry("available",
+				[]appsv1.DeploymentCondition{
+					{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionTrue},
+					{Type: appsv1.DeploymentProgressing, Status: corev1.ConditionTrue, Reason: newReplicaSetAvailableReason},
+				},
+				int64(2), metav1.ConditionTrue, metav1.ConditionFalse, metav1.ConditionFalse,
+			),
+			Entry("rolling out",
+				[]appsv1.DeploymentCondition{
+					{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionTrue},
+					{Type: appsv1.DeploymentProgressing, Status: corev1.ConditionTrue, Reason: "ReplicaSetUpdated"},
+				},
+				int64(2), metav1.ConditionTrue, metav1.ConditionTrue, metav1.ConditionFalse,
+			),
+			Entry("not observed yet",
+				[]appsv1.DeploymentCondition{
+					{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionTrue},
+					{Type: appsv1.DeploymentProgressing, Status: corev1.ConditionTrue, Reason: newReplicaSetAvailableReason},
+				},
+				int64(1), metav1.ConditionTrue, metav1.ConditionTrue, metav1.ConditionFalse,
+			),
+			Entry("progress deadline exceeded",
+				[]appsv1.DeploymentCondition{
+					{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionFalse},
+					{Type: appsv1.DeploymentProgressing, Status: corev1.ConditionFalse, Reason: "ProgressDeadlineExceeded"},
+				},
+				int64(2), metav1.ConditionFalse, metav1.ConditionFalse, metav1.ConditionTrue,
+			),
+			Entry("replica failure",
+				[]appsv1.DeploymentCondition{
+					{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionFalse},
+					{Type: appsv1.DeploymentProgressing, Status: corev1.ConditionTrue, Reason: "ReplicaSetUpdated"},
+					{Type: appsv1.DeploymentReplicaFailure, Status: corev1.ConditionTrue, Reason: "FailedCreate"},
+				},
+				int64(2), metav1.ConditionFalse, metav1.ConditionTrue, metav1.ConditionTrue,
+			),
+		)
+	})
+})
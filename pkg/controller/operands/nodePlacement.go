@@ -0,0 +1,42 @@
+package operands
+
+import (
+	sdkapi "kubevirt.io/controller-lifecycle-operator-sdk/pkg/sdk/api"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1beta1"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+// getInfraConfig returns the infra configuration of a specific component; that is the component override, if set, or
+// the global infra configuration
+func getInfraConfig(hc *hcov1beta1.HyperConverged, component hcoutil.AppComponent) hcov1beta1.HyperConvergedConfig {
+	if override := getPlacementOverride(hc, component); override != nil && override.Infra.NodePlacement != nil {
+		return override.Infra
+	}
+	return hc.Spec.Infra
+}
+
+// getWorkloadsConfig returns the workloads configuration of a specific component; that is the component override, if
+// set, or the global workloads configuration
+func getWorkloadsConfig(hc *hcov1beta1.HyperConverged, component hcoutil.AppComponent) hcov1beta1.HyperConvergedConfig {
+	if override := getPlacementOverride(hc, component); override != nil && override.Workloads.NodePlacement != nil {
+		return override.Workloads
+	}
+	return hc.Spec.Workloads
+}
+
+// GetWorkloadsNodePlacement returns the effective workloads node placement of a specific component, as it is propagated
+// to the component CR
+func GetWorkloadsNodePlacement(hc *hcov1beta1.HyperConverged, component hcoutil.AppComponent) *sdkapi.NodePlacement {
+	return getWorkloadsConfig(hc, component).NodePlacement
+}
+
+func getPlacementOverride(hc *hcov1beta1.HyperConverged, component hcoutil.AppComponent) *hcov1beta1.HyperConvergedNodePlacementOverride {
+	for i, override := range hc.Spec.NodePlacementOverrides {
+		if override.Component == string(component) {
+			return &hc.Spec.NodePlacementOverrides[i]
+		}
+	}
+
+	return nil
+}
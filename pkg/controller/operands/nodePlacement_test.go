@@ -0,0 +1,87 @@
+package operands
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/controller/commonTestUtils"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+var _ = Describe("Node placement overrides", func() {
+	var hco *hcov1beta1.HyperConverged
+
+	BeforeEach(func() {
+		hco = commonTestUtils.NewHco()
+		hco.Spec.Infra.NodePlacement = commonTestUtils.NewNodePlacement()
+		hco.Spec.Workloads.NodePlacement = commonTestUtils.NewNodePlacement()
+	})
+
+	It("should use the global node placement if there is no override", func() {
+		for _, component := range []hcoutil.AppComponent{
+			hcoutil.AppComponentCompute,
+			hcoutil.AppComponentStorage,
+			hcoutil.AppComponentNetwork,
+			hcoutil.AppComponentSchedule,
+		} {
+			Expect(getInfraConfig(hco, component)).To(Equal(hco.Spec.Infra))
+			Expect(getWorkloadsConfig(hco, component)).To(Equal(hco.Spec.Workloads))
+		}
+	})
+
+	It("should use the component override, and fall back to the global node placement", func() {
+		otherPlacement := commonTestUtils.NewOtherNodePlacement()
+		hco.Spec.NodePlacementOverrides = []hcov1beta1.HyperConvergedNodePlacementOverride{
+			{
+				Component: string(hcoutil.AppComponentStorage),
+				Workloads: hcov1beta1.HyperConvergedConfig{NodePlacement: otherPlacement},
+			},
+		}
+
+		Expect(getWorkloadsConfig(hco, hcoutil.AppComponentStorage).NodePlacement).To(Equal(otherPlacement))
+		Expect(getInfraConfig(hco, hcoutil.AppComponentStorage)).To(Equal(hco.Spec.Infra))
+		Expect(getWorkloadsConfig(hco, hcoutil.AppComponentCompute)).To(Equal(hco.Spec.Workloads))
+	})
+
+	It("should propagate the overrides to the operand CRs", func() {
+		otherPlacement := commonTestUtils.NewOtherNodePlacement()
+		hco.Spec.NodePlacementOverrides = []hcov1beta1.HyperConvergedNodePlacementOverride{
+			{
+				Component: string(hcoutil.AppComponentCompute),
+				Workloads: hcov1beta1.HyperConvergedConfig{NodePlacement: otherPlacement},
+			},
+			{
+				Component: string(hcoutil.AppComponentStorage),
+				Infra:     hcov1beta1.HyperConvergedConfig{NodePlacement: otherPlacement},
+			},
+			{
+				Component: string(hcoutil.AppComponentNetwork),
+				Workloads: hcov1beta1.HyperConvergedConfig{NodePlacement: otherPlacement},
+			},
+			{
+				Component: string(hcoutil.AppComponentSchedule),
+				Infra:     hcov1beta1.HyperConvergedConfig{NodePlacement: otherPlacement},
+			},
+		}
+
+		kv, err := NewKubeVirt(hco)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(kv.Spec.Workloads.NodePlacement.NodeSelector).To(Equal(otherPlacement.NodeSelector))
+		Expect(kv.Spec.Infra.NodePlacement.NodeSelector).To(Equal(hco.Spec.Infra.NodePlacement.NodeSelector))
+
+		cdi, err := NewCDI(hco)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cdi.Spec.Infra).To(Equal(*otherPlacement))
+		Expect(cdi.Spec.Workloads).To(Equal(*hco.Spec.Workloads.NodePlacement))
+
+		cna, err := NewNetworkAddons(hco)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cna.Spec.PlacementConfiguration.Workloads.NodeSelector).To(Equal(otherPlacement.NodeSelector))
+		Expect(cna.Spec.PlacementConfiguration.Infra.NodeSelector).To(Equal(hco.Spec.Infra.NodePlacement.NodeSelector))
+
+		ssp := NewSSP(hco)
+		Expect(ssp.Spec.TemplateValidator.Placement).To(Equal(otherPlacement))
+		Expect(ssp.Spec.NodeLabeller.Placement).To(Equal(hco.Spec.Workloads.NodePlacement))
+	})
+})
@@ -5,7 +5,6 @@ import (
 	"errors"
 	"fmt"
 	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
-	"os"
 	"strings"
 
 	jsonpatch "github.com/evanphx/json-patch"
@@ -20,6 +19,8 @@ import (
 
 	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1beta1"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/controller/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/drift"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/metrics"
 	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 )
 
@@ -43,6 +44,10 @@ type genericOperand struct {
 	setControllerReference bool
 	// Set of resource handler hooks, to be implement in each handler
 	hooks hcoResourceHooks
+	// The types of the operands that must be ensured successfully before this one
+	dependsOn []string
+	// The state of the resource after HCO last applied, or compared, its desired state; see getObservedState
+	appliedState string
 }
 
 // Set of resource handler hooks, to be implement in each handler
@@ -63,8 +68,10 @@ type hcoOperandHooks interface {
 	hcoResourceHooks
 	// get the CR conditions, if exists
 	getConditions(runtime.Object) []metav1.Condition
-	// on upgrade mode, check if the CR is already with the expected version
-	checkComponentVersion(runtime.Object) bool
+	// get the version reported in the CR status
+	getObservedVersion(runtime.Object) string
+	// get the version of the operand that HCO ships
+	getExpectedVersion() string
 }
 
 type reseter interface {
@@ -80,55 +87,151 @@ func (h *genericOperand) ensure(req *common.HcoRequest) *EnsureResult {
 		}
 	}
 
+	cr, overrides := ApplyOverrides(req.Instance, cr)
+	setOverridesStatus(req, overrides)
+
 	res := NewEnsureResult(cr)
 
 	if err := h.doSetControllerReference(req, cr); err != nil {
 		return res.Error(err)
 	}
 
+	hash, err := getDesiredStateHash(cr)
+	if err != nil {
+		return res.Error(err)
+	}
+
 	key := client.ObjectKeyFromObject(cr)
-	res.SetName(key.Name)
+	res.SetName(key.Name).SetNamespace(key.Namespace)
 	found := h.hooks.getEmptyCr()
 	err = h.Client.Get(req.Ctx, key, found)
 	if err != nil {
-		return h.createNewCr(req, err, cr, res)
+		if apierrors.IsNotFound(err) && h.getReconcilePolicy(req, res) == hcov1beta1.ReconcilePolicyPaused {
+			req.Logger.Info(h.crType + " is paused; not creating it")
+			return res.SetUpgradeDone(req.ComponentUpgradeInProgress)
+		}
+		return h.createNewCr(req, err, cr, hash, res)
 	}
 
-	return h.handleExistingCr(req, key, found, cr, res)
+	return h.handleExistingCr(req, key, found, cr, hash, res)
 }
 
-func (h *genericOperand) handleExistingCr(req *common.HcoRequest, key client.ObjectKey, found client.Object, cr client.Object, res *EnsureResult) *EnsureResult {
+func (h *genericOperand) handleExistingCr(req *common.HcoRequest, key client.ObjectKey, found client.Object, cr client.Object, hash string, res *EnsureResult) *EnsureResult {
 	req.Logger.Info(h.crType+" already exists", h.crType+".Namespace", key.Namespace, h.crType+".Name", key.Name)
 
-	h.doRemoveExistingOwners(req, found)
+	// check the hash of the found resource before it is stamped
+	desiredStateApplied := h.isDesiredStateApplied(req, found, hash)
+	cr = h.stampDesiredStateHash(found, cr, hash)
 
-	updated, overwritten, err := h.hooks.updateCr(req, h.Client, found, cr)
-	if err != nil {
-		return res.Error(err)
+	var updated, overwritten bool
+	switch h.getReconcilePolicy(req, res) {
+	case hcov1beta1.ReconcilePolicyPaused:
+		req.Logger.Info(h.crType + " is paused; not reconciling it")
+
+	case hcov1beta1.ReconcilePolicyWarnOnly:
+		if err := h.detectDrift(req, found, cr, res); err != nil {
+			return res.Error(err)
+		}
+
+	default:
+		if desiredStateApplied {
+			// neither the HyperConverged CR nor the resource were modified since the last reconciliation
+			req.Logger.Info(h.crType + " is already in its desired state; not comparing it")
+			metrics.HcoMetrics.IncOperandWrites(h.crType, metrics.WriteSkipped)
+			break
+		}
+
+		h.doRemoveExistingOwners(req, found)
+
+		observed := found.DeepCopyObject().(client.Object)
+		var err error
+		updated, overwritten, err = h.hooks.updateCr(req, h.Client, found, cr)
+		if err != nil {
+			return res.Error(err)
+		}
+
+		if overwritten {
+			h.reportDrift(req, observed, found, res)
+		}
+
+		h.setDesiredStateApplied(found)
+		countWrite(h.crType, updated)
 	}
 
-	if err = h.addCrToTheRelatedObjectList(req, found); err != nil {
+	if err := h.addCrToTheRelatedObjectList(req, found); err != nil {
 		return res.Error(err)
 	}
 
+	opr, isOperand := h.hooks.(hcoOperandHooks)
+	if isOperand {
+		res.SetComponent(getComponentStatus(h.crType, opr, found)).SetVersion(getComponentVersion(h.crType, opr, found))
+	}
+
 	if updated {
 		// update resourceVersions of objects in relatedObjects
 		req.StatusDirty = true
 		return res.SetUpdated().SetOverwritten(overwritten)
 	}
 
-	if opr, ok := h.hooks.(hcoOperandHooks); ok { // for operands, perform some more checks
+	if isOperand { // for operands, perform some more checks
 		return h.completeEnsureOperands(req, opr, found, res)
 	}
 	// For resources that are not CRs, such as priority classes or a config map, there is no new version to upgrade
 	return res.SetUpgradeDone(req.ComponentUpgradeInProgress)
 }
 
+// reportDrift adds the description of an out-of-band modification of the resource to the result. The updated found
+// object holds the resource as restored by HCO.
+func (h *genericOperand) reportDrift(req *common.HcoRequest, observed client.Object, found client.Object, res *EnsureResult) {
+	report, err := drift.NewReport(res.Type, observed, found)
+	if err != nil {
+		req.Logger.Error(err, "can't build the drift report of "+h.crType)
+		return
+	}
+	res.SetDrift(report)
+}
+
+// getReconcilePolicy returns the reconcile policy of the resource. Only operand CRs may have a reconcile policy other
+// than Enforce. During an upgrade, an operand whose upgrade step is still pending is paused.
+func (h *genericOperand) getReconcilePolicy(req *common.HcoRequest, res *EnsureResult) hcov1beta1.ReconcilePolicy {
+	if _, isOperand := h.hooks.(hcoOperandHooks); !isOperand {
+		return hcov1beta1.ReconcilePolicyEnforce
+	}
+
+	if isUpgradeStepPending(req, res.Type) {
+		// the operand is not modified until the previous steps of the upgrade are completed
+		req.Logger.Info(h.crType + " is waiting for its upgrade step")
+		return hcov1beta1.ReconcilePolicyPaused
+	}
+
+	return GetReconcilePolicy(req.Instance, res.Type)
+}
+
+// detectDrift checks, using a dry-run update, if the resource is different than the required one, and if so, adds
+// the description of the difference to the result, without modifying the resource.
+func (h *genericOperand) detectDrift(req *common.HcoRequest, found client.Object, cr client.Object, res *EnsureResult) error {
+	desired := found.DeepCopyObject().(client.Object)
+	if _, _, err := h.hooks.updateCr(req, client.NewDryRunClient(h.Client), desired, cr); err != nil {
+		return err
+	}
+
+	report, err := drift.NewReport(res.Type, found, desired)
+	if err != nil {
+		return err
+	}
+
+	if len(report.Changes) > 0 {
+		req.Logger.Info(fmt.Sprintf("%s was modified out-of-band; not reverting the modification, due to its WarnOnly reconcile policy", h.crType))
+		res.SetDrift(report).SetWarned()
+	}
+	return nil
+}
+
 func (h *genericOperand) completeEnsureOperands(req *common.HcoRequest, opr hcoOperandHooks, found client.Object, res *EnsureResult) *EnsureResult {
 	// Handle KubeVirt resource conditions
 	isReady := handleComponentConditions(req, h.crType, opr.getConditions(found))
 
-	versionUpdated := opr.checkComponentVersion(found)
+	versionUpdated := checkComponentVersion(opr, found)
 	if isReady && !versionUpdated {
 		req.Logger.Info(fmt.Sprintf("could not complete the upgrade process. %s is not with the expected version. Check %s observed version in the status field of its CR", h.crType, h.crType))
 	}
@@ -183,14 +286,27 @@ func (h *genericOperand) doRemoveExistingOwners(req *common.HcoRequest, found cl
 	}
 }
 
-func (h *genericOperand) createNewCr(req *common.HcoRequest, err error, cr client.Object, res *EnsureResult) *EnsureResult {
+func (h *genericOperand) createNewCr(req *common.HcoRequest, err error, cr client.Object, hash string, res *EnsureResult) *EnsureResult {
 	if apierrors.IsNotFound(err) {
 		req.Logger.Info("Creating " + h.crType)
-		err = h.Client.Create(req.Ctx, cr)
+		cr = h.stampDesiredStateHash(nil, cr, hash)
+		opr, isOperand := h.hooks.(hcoOperandHooks)
+		if isOperand {
+			err = createCrWithApply(req, h.Client, cr)
+		} else {
+			err = h.Client.Create(req.Ctx, cr)
+		}
 		if err != nil {
 			req.Logger.Error(err, "Failed to create object for "+h.crType)
 			return res.Error(err)
 		}
+		h.setDesiredStateApplied(cr)
+		metrics.HcoMetrics.IncOperandWrites(h.crType, metrics.WritePerformed)
+		if isOperand {
+			// nothing is known yet about the new operand, but its name
+			res.SetComponent(&hcov1beta1.ComponentStatus{Name: h.crType})
+			res.SetVersion(&hcov1beta1.Version{Name: h.crType, ExpectedVersion: opr.getExpectedVersion()})
+		}
 		return res.SetCreated()
 	}
 	return res.Error(err)
@@ -202,6 +318,65 @@ func (h *genericOperand) reset() {
 	}
 }
 
+// GetReconcilePolicy returns the reconcile policy of an operand CR, by its kind
+func GetReconcilePolicy(hc *hcov1beta1.HyperConverged, kind string) hcov1beta1.ReconcilePolicy {
+	for _, policy := range hc.Spec.ReconcilePolicies {
+		if policy.Kind == kind {
+			return policy.ReconcilePolicy
+		}
+	}
+	return hcov1beta1.ReconcilePolicyEnforce
+}
+
+// getComponentVersion builds the status.versions entry of an operand, from the operand CR
+func getComponentVersion(name string, opr hcoOperandHooks, found client.Object) *hcov1beta1.Version {
+	return &hcov1beta1.Version{
+		Name:            name,
+		Version:         opr.getObservedVersion(found),
+		ExpectedVersion: opr.getExpectedVersion(),
+	}
+}
+
+// getComponentStatus builds the status.components entry of an operand, from the operand CR
+func getComponentStatus(name string, opr hcoOperandHooks, found client.Object) *hcov1beta1.ComponentStatus {
+	status := &hcov1beta1.ComponentStatus{
+		Name:            name,
+		ObservedVersion: opr.getObservedVersion(found),
+		Generation:      found.GetGeneration(),
+	}
+
+	for _, condition := range opr.getConditions(found) {
+		switch condition.Type {
+		case hcov1beta1.ConditionAvailable, hcov1beta1.ConditionProgressing, hcov1beta1.ConditionDegraded:
+			status.Conditions = append(status.Conditions, hcov1beta1.ComponentCondition{
+				Type:               condition.Type,
+				Status:             condition.Status,
+				Reason:             condition.Reason,
+				Message:            condition.Message,
+				LastTransitionTime: condition.LastTransitionTime,
+			})
+		}
+	}
+
+	return status
+}
+
+// getComponentConditions returns the conditions of an operand, as last seen in its status.components entry
+func getComponentConditions(component hcov1beta1.ComponentStatus) []metav1.Condition {
+	conditions := make([]metav1.Condition, 0, len(component.Conditions))
+	for _, condition := range component.Conditions {
+		conditions = append(conditions, metav1.Condition{
+			Type:               condition.Type,
+			Status:             condition.Status,
+			Reason:             condition.Reason,
+			Message:            condition.Message,
+			LastTransitionTime: condition.LastTransitionTime,
+		})
+	}
+
+	return conditions
+}
+
 // handleComponentConditions - read and process a sub-component conditions.
 // returns true if the the conditions indicates "ready" state and false if not.
 func handleComponentConditions(req *common.HcoRequest, component string, componentConds []metav1.Condition) bool {
@@ -327,9 +502,10 @@ func componentNotAvailable(req *common.HcoRequest, component string, msg string)
 	})
 }
 
-func checkComponentVersion(versionEnvName, actualVersion string) bool {
-	expectedVersion := os.Getenv(versionEnvName)
-	return expectedVersion != "" && expectedVersion == actualVersion
+// on upgrade mode, check if the CR is already with the expected version
+func checkComponentVersion(opr hcoOperandHooks, cr runtime.Object) bool {
+	expectedVersion := opr.getExpectedVersion()
+	return expectedVersion != "" && expectedVersion == opr.getObservedVersion(cr)
 }
 
 func getNamespace(defaultNamespace string, opts []string) string {
@@ -395,10 +571,11 @@ func applyPatchToSpec(hc *hcov1beta1.HyperConverged, annotationName string, obj
 
 func osConditionToK8s(condition conditionsv1.Condition) metav1.Condition {
 	return metav1.Condition{
-		Type:    string(condition.Type),
-		Reason:  condition.Reason,
-		Status:  metav1.ConditionStatus(condition.Status),
-		Message: condition.Message,
+		Type:               string(condition.Type),
+		Reason:             condition.Reason,
+		Status:             metav1.ConditionStatus(condition.Status),
+		Message:            condition.Message,
+		LastTransitionTime: condition.LastTransitionTime,
 	}
 }
 
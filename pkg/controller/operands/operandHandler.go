@@ -2,7 +2,9 @@ package operands
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"reflect"
 	"sync"
 	"time"
 
@@ -10,6 +12,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	kubevirtv1 "kubevirt.io/client-go/api/v1"
 	cdiv1beta1 "kubevirt.io/containerized-data-importer/pkg/apis/core/v1beta1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -17,6 +20,7 @@ import (
 
 	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1beta1"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/controller/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/drift"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/metrics"
 	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 )
@@ -30,6 +34,8 @@ const (
 	ErrHCOUninstall       = "ErrHCOUninstall"
 	uninstallHCOErrorMsg  = "The uninstall request failed on dependent components, please check their logs."
 	deleteTimeOut         = 30 * time.Second
+	// the number of drift reports kept in the HyperConverged status
+	maxStatusDriftReports = 10
 )
 
 var (
@@ -42,17 +48,25 @@ type OperandHandler struct {
 	// save for deletions
 	objects      []client.Object
 	eventEmitter hcoutil.EventEmitter
+	// deployed maps the resources that were ensured in the last full ensure, to the operands that deployed them
+	deployed map[objectKey]Operand
+	// the maximum number of operands that are ensured concurrently
+	parallelism int
 }
 
 func NewOperandHandler(client client.Client, scheme *runtime.Scheme, isOpenshiftCluster bool, eventEmitter hcoutil.EventEmitter) *OperandHandler {
 	operands := []Operand{
 		(*genericOperand)(newKvPriorityClassHandler(client, scheme)),
-		(*genericOperand)(newKubevirtHandler(client, scheme)),
+		// the operands are ensured in the order of the upgrade plan
 		(*genericOperand)(newCdiHandler(client, scheme)),
+		(*genericOperand)(newKubevirtHandler(client, scheme)),
 		(*genericOperand)(newStorageConfigHandler(client, scheme)),
+		newVddkConfigHandler(client, scheme),
 		(*genericOperand)(newConfigReaderRoleHandler(client, scheme)),
 		(*genericOperand)(newConfigReaderRoleBindingHandler(client, scheme)),
 		(*genericOperand)(newCnaHandler(client, scheme)),
+		newHppHandler(client, scheme),
+		newNmoHandler(client, scheme),
 		newKubeVirtCmHandler(client, eventEmitter),
 	}
 
@@ -63,8 +77,8 @@ func NewOperandHandler(client client.Client, scheme *runtime.Scheme, isOpenshift
 			(*genericOperand)(newMetricsServiceMonitorHandler(client, scheme)),
 			(*genericOperand)(newMonitoringPrometheusRuleHandler(client, scheme)),
 			(*genericOperand)(newCliDownloadHandler(client, scheme)),
-			(*genericOperand)(newCliDownloadsRouteHandler(client, scheme)),
 			(*genericOperand)(newCliDownloadsServiceHandler(client, scheme)),
+			(*genericOperand)(newCliDownloadsRouteHandler(client, scheme)),
 		}...)
 	}
 
@@ -72,6 +86,7 @@ func NewOperandHandler(client client.Client, scheme *runtime.Scheme, isOpenshift
 		client:       client,
 		operands:     operands,
 		eventEmitter: eventEmitter,
+		parallelism:  defaultEnsureParallelism,
 	}
 }
 
@@ -85,10 +100,6 @@ func (h *OperandHandler) FirstUseInitiation(scheme *runtime.Scheme, isOpenshiftC
 	}
 }
 
-func (h *OperandHandler) GetQuickStartNames() []string {
-	return quickstartNames
-}
-
 type GetHandler func(logger log.Logger, Client client.Client, Scheme *runtime.Scheme, hc *hcov1beta1.HyperConverged) ([]Operand, error)
 
 func (h *OperandHandler) addOperands(scheme *runtime.Scheme, hc *hcov1beta1.HyperConverged, getHandler GetHandler) {
@@ -108,40 +119,260 @@ func (h *OperandHandler) addOperands(scheme *runtime.Scheme, hc *hcov1beta1.Hype
 	}
 }
 
-func (h OperandHandler) Ensure(req *common.HcoRequest) error {
-	for _, handler := range h.operands {
-		res := handler.ensure(req)
+func (h *OperandHandler) Ensure(req *common.HcoRequest) error {
+	// each operand reports the overrides it applies; the entries that no operand matched, remain ignored
+	origOverrides := req.Instance.Status.Overrides
+	req.Instance.Status.Overrides = newOverridesStatus(req.Instance)
+
+	var components []hcov1beta1.ComponentStatus
+	var versions []hcov1beta1.Version
+	var errs []error
+	ensured := make(map[string]bool)
+	required := make(map[objectKey]bool)
+	deployed := make(map[objectKey]Operand)
+	h.ensureOperands(req, func(handler Operand, res *EnsureResult) {
 		if res.Err != nil {
-			req.Logger.Error(res.Err, "failed to ensure an operand")
-
-			req.ComponentUpgradeInProgress = false
-			req.Conditions.SetStatusCondition(metav1.Condition{
-				Type:               hcov1beta1.ConditionReconcileComplete,
-				Status:             metav1.ConditionFalse,
-				Reason:             reconcileFailed,
-				Message:            fmt.Sprintf("Error while reconciling: %v", res.Err),
-				ObservedGeneration: req.Instance.Generation,
-			})
-			return res.Err
+			// keep going with the operands that do not depend on the failed one
+			req.Logger.Error(res.Err, "failed to ensure an operand", "type", res.Type)
+			errs = append(errs, res.Err)
+			return
 		}
 
-		if res.Created {
-			h.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeNormal, "Created", fmt.Sprintf("Created %s %s", res.Type, res.Name))
-		} else if res.Updated {
-			if !res.Overwritten {
-				h.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeNormal, "Updated", fmt.Sprintf("Updated %s %s", res.Type, res.Name))
-			} else {
-				h.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeWarning, "Overwritten", fmt.Sprintf("Overwritten %s %s", res.Type, res.Name))
-				metrics.HcoMetrics.IncOverwrittenModifications(res.Type, res.Name)
-			}
+		h.reportResult(req, res)
+
+		if res.Component != nil {
+			components = append(components, *res.Component)
+		}
+		if res.Version != nil {
+			versions = append(versions, *res.Version)
 		}
 
 		req.ComponentUpgradeInProgress = req.ComponentUpgradeInProgress && res.UpgradeDone
+
+		ensured[res.Type] = true
+		if res.Name != "" {
+			key := objectKey{kind: res.Type, namespace: res.Namespace, name: res.Name}
+			required[key] = true
+			deployed[key] = handler
+		}
+		h.updateUpgradeStep(req, res)
+	})
+
+	if len(errs) > 0 {
+		err := utilerrors.Reduce(utilerrors.NewAggregate(errs))
+		h.ensureFailed(req, origOverrides, err)
+		return err
 	}
+
+	h.deployed = deployed
+
+	h.sweep(req, required)
+
+	h.skipMissingUpgradeSteps(req, ensured)
+	req.ComponentUpgradeInProgress = req.ComponentUpgradeInProgress && isUpgradeCompleted(req)
+
+	if !reflect.DeepEqual(components, req.Instance.Status.Components) {
+		req.Instance.Status.Components = components
+		req.StatusDirty = true
+	}
+
+	updateVersions(req, versions, true)
+
+	if !reflect.DeepEqual(origOverrides, req.Instance.Status.Overrides) {
+		req.StatusDirty = true
+	}
+
 	return nil
 
 }
 
+// EnsureOperand ensures only the operand that deployed the secondary resource that triggered the request, and then
+// reads the conditions of the other operands from their last seen state in the status, to keep the aggregation of the
+// conditions complete. Returns false if the resource is not known to be deployed by any operand; in this case, nothing
+// is ensured, and all the operands should be ensured instead.
+func (h *OperandHandler) EnsureOperand(req *common.HcoRequest, trigger common.TriggeringObject) (bool, error) {
+	handler, found := h.deployed[objectKey{kind: trigger.GroupKind.Kind, namespace: trigger.Namespace, name: trigger.Name}]
+	if !found {
+		return false, nil
+	}
+
+	// the overrides of the other operands are not applied now, so their last state is kept
+	origOverrides := req.Instance.Status.Overrides
+	req.Instance.Status.Overrides = append([]hcov1beta1.HyperConvergedOverrideStatus(nil), origOverrides...)
+
+	res := ensureWithMetrics(handler, req)
+	if res.Err != nil {
+		req.Logger.Error(res.Err, "failed to ensure an operand", "type", res.Type)
+		h.ensureFailed(req, origOverrides, res.Err)
+		return true, res.Err
+	}
+
+	h.reportResult(req, res)
+
+	components := make([]hcov1beta1.ComponentStatus, 0, len(req.Instance.Status.Components)+1)
+	replaced := false
+	for _, component := range req.Instance.Status.Components {
+		if res.Component != nil && component.Name == res.Component.Name {
+			components = append(components, *res.Component)
+			replaced = true
+			continue
+		}
+
+		handleComponentConditions(req, component.Name, getComponentConditions(component))
+		components = append(components, component)
+	}
+
+	if res.Component != nil && !replaced {
+		components = append(components, *res.Component)
+	}
+
+	if !reflect.DeepEqual(components, req.Instance.Status.Components) {
+		req.Instance.Status.Components = components
+		req.StatusDirty = true
+	}
+
+	if res.Version != nil {
+		updateVersions(req, []hcov1beta1.Version{*res.Version}, false)
+	}
+
+	if !reflect.DeepEqual(origOverrides, req.Instance.Status.Overrides) {
+		req.StatusDirty = true
+	}
+
+	return true, nil
+}
+
+// updateVersions reports the observed and the expected versions of the operands in the HyperConverged status. After
+// all the operands were ensured, the versions of the operands that were not reported are removed.
+func updateVersions(req *common.HcoRequest, versions []hcov1beta1.Version, removeMissing bool) {
+	origVersions := append(hcov1beta1.Versions(nil), req.Instance.Status.Versions...)
+
+	if removeMissing {
+		reported := make(map[string]bool)
+		for _, version := range versions {
+			reported[version.Name] = true
+		}
+		for _, version := range origVersions {
+			// only the versions of the operands have an expected version
+			if version.ExpectedVersion != "" && !reported[version.Name] {
+				req.Instance.Status.RemoveVersion(version.Name)
+			}
+		}
+	}
+
+	for _, version := range versions {
+		req.Instance.Status.UpdateComponentVersion(version.Name, version.Version, version.ExpectedVersion)
+	}
+
+	if !reflect.DeepEqual(origVersions, req.Instance.Status.Versions) {
+		req.StatusDirty = true
+	}
+}
+
+func (h OperandHandler) ensureFailed(req *common.HcoRequest, origOverrides []hcov1beta1.HyperConvergedOverrideStatus, err error) {
+	req.Instance.Status.Overrides = origOverrides
+
+	req.ComponentUpgradeInProgress = false
+	req.Conditions.SetStatusCondition(metav1.Condition{
+		Type:               hcov1beta1.ConditionReconcileComplete,
+		Status:             metav1.ConditionFalse,
+		Reason:             reconcileFailed,
+		Message:            fmt.Sprintf("Error while reconciling: %v", err),
+		ObservedGeneration: req.Instance.Generation,
+	})
+}
+
+// ensureWithMetrics ensures an operand, and records the duration and the result of the ensure in the metrics
+func ensureWithMetrics(operand Operand, req *common.HcoRequest) *EnsureResult {
+	start := time.Now()
+	res := operand.ensure(req)
+
+	operandType := getOperandType(operand)
+	metrics.HcoMetrics.ObserveEnsureDuration(operandType, time.Since(start))
+	if result, ok := getEnsureResultMetric(res); ok {
+		metrics.HcoMetrics.IncEnsureResult(operandType, result)
+	}
+
+	return res
+}
+
+func getEnsureResultMetric(res *EnsureResult) (string, bool) {
+	switch {
+	case res.Err != nil:
+		return metrics.EnsureError, true
+	case res.Created:
+		return metrics.EnsureCreated, true
+	case res.Updated && res.Overwritten:
+		return metrics.EnsureOverwritten, true
+	case res.Updated:
+		return metrics.EnsureUpdated, true
+	}
+	return "", false
+}
+
+// reportResult emits the events of an ensured resource, and records its drift
+func (h OperandHandler) reportResult(req *common.HcoRequest, res *EnsureResult) {
+	if res.Created {
+		h.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeNormal, "Created", fmt.Sprintf("Created %s %s", res.Type, res.Name))
+	} else if res.Updated {
+		if !res.Overwritten {
+			h.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeNormal, "Updated", fmt.Sprintf("Updated %s %s", res.Type, res.Name))
+		} else {
+			h.emitDriftEvent(req, res, "Overwritten", fmt.Sprintf("Overwritten %s %s", res.Type, res.Name))
+			metrics.HcoMetrics.IncOverwrittenModifications(res.Type, res.Name)
+			if res.Drift != nil {
+				recordDrift(req, *res.Drift)
+			}
+		}
+	} else if res.Warned && isNewDrift(req, *res.Drift) {
+		// the modification is not reverted, so it is detected again on each reconciliation; report it only once
+		h.emitDriftEvent(req, res, "DriftDetected", fmt.Sprintf("Detected an out-of-band modification of %s %s", res.Type, res.Name))
+		recordDrift(req, *res.Drift)
+	}
+}
+
+func (h OperandHandler) emitDriftEvent(req *common.HcoRequest, res *EnsureResult, reason, msg string) {
+	if res.Drift == nil {
+		h.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeWarning, reason, msg)
+		return
+	}
+
+	report, err := json.Marshal(res.Drift)
+	if err != nil {
+		req.Logger.Error(err, "can't marshal the drift report")
+		h.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeWarning, reason, msg)
+		return
+	}
+
+	annotations := map[string]string{hcoutil.DriftReportAnnotation: string(report)}
+	h.eventEmitter.EmitAnnotatedEvent(req.Instance, annotations, corev1.EventTypeWarning, reason, msg)
+}
+
+// isNewDrift checks if the drift report is different than the last report of the same resource in the HyperConverged
+// status
+func isNewDrift(req *common.HcoRequest, report hcov1beta1.DriftReport) bool {
+	reports := req.Instance.Status.DriftReports
+	for i := len(reports) - 1; i >= 0; i-- {
+		if reports[i].Kind == report.Kind && reports[i].Name == report.Name && reports[i].Namespace == report.Namespace {
+			return !reflect.DeepEqual(reports[i].Changes, report.Changes)
+		}
+	}
+	return true
+}
+
+// recordDrift keeps the drift report in memory, for the debug endpoint, and in the HyperConverged status. The status
+// holds only the last maxStatusDriftReports reports.
+func recordDrift(req *common.HcoRequest, report hcov1beta1.DriftReport) {
+	drift.Reports.Add(report)
+
+	reports := append(req.Instance.Status.DriftReports, report)
+	if len(reports) > maxStatusDriftReports {
+		reports = reports[len(reports)-maxStatusDriftReports:]
+	}
+	req.Instance.Status.DriftReports = reports
+	req.StatusDirty = true
+}
+
 func (h OperandHandler) EnsureDeleted(req *common.HcoRequest) error {
 
 	tCtx, cancel := context.WithTimeout(req.Ctx, deleteTimeOut)
@@ -155,6 +386,7 @@ func (h OperandHandler) EnsureDeleted(req *common.HcoRequest) error {
 		NewKubeVirtWithNameOnly(req.Instance),
 		NewCDIWithNameOnly(req.Instance),
 		NewNetworkAddonsWithNameOnly(req.Instance),
+		NewHostPathProvisionerWithNameOnly(req.Instance),
 		NewSSP(req.Instance),
 		NewConsoleCLIDownload(req.Instance),
 	}
@@ -5,13 +5,17 @@ import (
 	"fmt"
 	networkaddonsv1 "github.com/kubevirt/cluster-network-addons-operator/pkg/apis/networkaddonsoperator/v1"
 	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/controller/common"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/controller/commonTestUtils"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/metrics"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	consolev1 "github.com/openshift/api/console/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	kubevirtv1 "kubevirt.io/client-go/api/v1"
 	cdiv1beta1 "kubevirt.io/containerized-data-importer/pkg/apis/core/v1beta1"
 	"os"
@@ -48,12 +52,12 @@ var _ = Describe("Test operandHandler", func() {
 				{
 					EventType: corev1.EventTypeNormal,
 					Reason:    "Created",
-					Msg:       "Created KubeVirt kubevirt-kubevirt-hyperconverged",
+					Msg:       "Created CDI cdi-kubevirt-hyperconverged",
 				},
 				{
 					EventType: corev1.EventTypeNormal,
 					Reason:    "Created",
-					Msg:       "Created CDI cdi-kubevirt-hyperconverged",
+					Msg:       "Created KubeVirt kubevirt-kubevirt-hyperconverged",
 				},
 				{
 					EventType: corev1.EventTypeNormal,
@@ -146,6 +150,108 @@ var _ = Describe("Test operandHandler", func() {
 				Expect(cmList.Items).To(HaveLen(1))
 				Expect(cmList.Items[0].Name).Should(Equal("grafana-dashboard-kubevirt-top-consumers"))
 			})
+
+			By("make sure the operands are reported in status.components", func() {
+				var names []string
+				for _, component := range req.Instance.Status.Components {
+					names = append(names, component.Name)
+					Expect(component.Conditions).To(BeEmpty())
+				}
+				Expect(names).To(Equal([]string{"CDI", "KubeVirt", "NetworkAddonsConfig", "SSP"}))
+				Expect(req.StatusDirty).To(BeTrue())
+			})
+		})
+
+		It("should report the state of each operand in status.components", func() {
+			hco := commonTestUtils.NewHco()
+			kv, err := NewKubeVirt(hco)
+			Expect(err).ToNot(HaveOccurred())
+			kv.Generation = 3
+			kv.Status.ObservedKubeVirtVersion = "v1.2.3"
+			transitionTime := metav1.NewTime(time.Date(2021, 10, 1, 12, 0, 0, 0, time.UTC))
+			kv.Status.Conditions = []kubevirtv1.KubeVirtCondition{
+				{Type: kubevirtv1.KubeVirtConditionAvailable, Status: corev1.ConditionFalse, Reason: "Foo", Message: "Bar", LastTransitionTime: transitionTime},
+				{Type: kubevirtv1.KubeVirtConditionProgressing, Status: corev1.ConditionTrue, Reason: "Foo", Message: "Bar", LastTransitionTime: transitionTime},
+				{Type: kubevirtv1.KubeVirtConditionDegraded, Status: corev1.ConditionFalse, LastTransitionTime: transitionTime},
+				{Type: kubevirtv1.KubeVirtConditionCreated, Status: corev1.ConditionTrue, LastTransitionTime: transitionTime},
+			}
+			cdi, err := NewCDI(hco)
+			Expect(err).ToNot(HaveOccurred())
+			cdi.Status.ObservedVersion = "v4.5.6"
+
+			cli := commonTestUtils.InitClient([]runtime.Object{hco, kv, cdi})
+			handler := NewOperandHandler(cli, commonTestUtils.GetScheme(), false, commonTestUtils.NewEventEmitterMock())
+
+			req := commonTestUtils.NewReq(hco)
+			Expect(handler.Ensure(req)).To(Succeed())
+
+			components := req.Instance.Status.Components
+			Expect(components).To(HaveLen(3))
+			Expect(components[0].Name).To(Equal("CDI"))
+			Expect(components[0].ObservedVersion).To(Equal("v4.5.6"))
+			Expect(components[1].Name).To(Equal("KubeVirt"))
+			Expect(components[1].ObservedVersion).To(Equal("v1.2.3"))
+			Expect(components[1].Generation).To(BeEquivalentTo(3))
+			Expect(components[1].Conditions).To(HaveLen(3))
+			for i, condType := range []string{hcov1beta1.ConditionAvailable, hcov1beta1.ConditionProgressing, hcov1beta1.ConditionDegraded} {
+				Expect(components[1].Conditions[i].Type).To(Equal(condType))
+				Expect(components[1].Conditions[i].Status).To(BeEquivalentTo(kv.Status.Conditions[i].Status))
+				Expect(components[1].Conditions[i].Reason).To(Equal(kv.Status.Conditions[i].Reason))
+				Expect(components[1].Conditions[i].Message).To(Equal(kv.Status.Conditions[i].Message))
+				Expect(components[1].Conditions[i].LastTransitionTime.Equal(&transitionTime)).To(BeTrue())
+			}
+			Expect(components[2].Name).To(Equal("NetworkAddonsConfig"))
+		})
+
+		It("should keep the last drift reports in the status", func() {
+			hco := commonTestUtils.NewHco()
+			req := commonTestUtils.NewReq(hco)
+
+			for i := 0; i < maxStatusDriftReports+2; i++ {
+				recordDrift(req, hcov1beta1.DriftReport{Kind: "KubeVirt", Name: fmt.Sprintf("kv-%d", i)})
+			}
+
+			Expect(req.StatusDirty).To(BeTrue())
+			reports := req.Instance.Status.DriftReports
+			Expect(reports).To(HaveLen(maxStatusDriftReports))
+			Expect(reports[0].Name).To(Equal("kv-2"))
+			Expect(reports[maxStatusDriftReports-1].Name).To(Equal(fmt.Sprintf("kv-%d", maxStatusDriftReports+1)))
+		})
+
+		It("should report the modification of a WarnOnly operand only once", func() {
+			hco := commonTestUtils.NewHco()
+			hco.Spec.ReconcilePolicies = []hcov1beta1.OperandReconcilePolicy{
+				{Kind: "KubeVirt", ReconcilePolicy: hcov1beta1.ReconcilePolicyWarnOnly},
+			}
+			kv, err := NewKubeVirt(hco)
+			Expect(err).ToNot(HaveOccurred())
+			kv.Spec.UninstallStrategy = kubevirtv1.KubeVirtUninstallStrategyRemoveWorkloads
+
+			cli := commonTestUtils.InitClient([]runtime.Object{hco, kv})
+			eventEmitter := commonTestUtils.NewEventEmitterMock()
+			handler := NewOperandHandler(cli, commonTestUtils.GetScheme(), false, eventEmitter)
+
+			expectedEvents := []commonTestUtils.MockEvent{
+				{
+					EventType: corev1.EventTypeWarning,
+					Reason:    "DriftDetected",
+					Msg:       "Detected an out-of-band modification of KubeVirt " + kv.Name,
+				},
+			}
+
+			req := commonTestUtils.NewReq(hco)
+			req.HCOTriggered = false
+			Expect(handler.Ensure(req)).To(Succeed())
+			Expect(eventEmitter.CheckEvents(expectedEvents)).To(BeTrue())
+			Expect(req.Instance.Status.DriftReports).To(HaveLen(1))
+			Expect(req.Instance.Status.DriftReports[0].Kind).To(Equal("KubeVirt"))
+
+			eventEmitter.Reset()
+			req = commonTestUtils.NewReq(req.Instance)
+			req.HCOTriggered = false
+			Expect(handler.Ensure(req)).To(Succeed())
+			Expect(eventEmitter.CheckEvents(expectedEvents)).To(BeFalse())
+			Expect(req.Instance.Status.DriftReports).To(HaveLen(1))
 		})
 
 		It("should handle errors on ensure loop", func() {
@@ -190,6 +296,95 @@ var _ = Describe("Test operandHandler", func() {
 			})
 		})
 
+		It("should report the versions of the operands in the HyperConverged status", func() {
+			origVersion := os.Getenv(hcoutil.KubevirtVersionEnvV)
+			Expect(os.Setenv(hcoutil.KubevirtVersionEnvV, "v1.2.3")).To(Succeed())
+			defer os.Setenv(hcoutil.KubevirtVersionEnvV, origVersion)
+
+			hco := commonTestUtils.NewHco()
+			hco.Status.UpdateVersion("operator", "v0.0.1")
+			hco.Status.UpdateComponentVersion("NotDeployed", "v1.0.0", "v1.0.0")
+			cli := commonTestUtils.InitClient([]runtime.Object{qsCrd, hco})
+
+			handler := NewOperandHandler(cli, commonTestUtils.GetScheme(), true, commonTestUtils.NewEventEmitterMock())
+			handler.FirstUseInitiation(commonTestUtils.GetScheme(), true, hco)
+
+			req := commonTestUtils.NewReq(hco)
+			Expect(handler.Ensure(req)).To(Succeed())
+			Expect(req.StatusDirty).To(BeTrue())
+
+			versions := req.Instance.Status.Versions
+			Expect(versions).To(ContainElement(hcov1beta1.Version{Name: "operator", Version: "v0.0.1"}))
+			Expect(versions).To(ContainElement(hcov1beta1.Version{Name: "KubeVirt", ExpectedVersion: "v1.2.3"}))
+			_, found := req.Instance.Status.GetVersion("NotDeployed")
+			Expect(found).To(BeFalse())
+
+			By("updating the observed version of the triggering operand")
+			kv := &kubevirtv1.KubeVirt{}
+			Expect(cli.Get(req.Ctx, client.ObjectKeyFromObject(NewKubeVirtWithNameOnly(hco)), kv)).To(Succeed())
+			kv.Status.ObservedKubeVirtVersion = "v1.2.2"
+			Expect(cli.Update(req.Ctx, kv)).To(Succeed())
+
+			req = commonTestUtils.NewReq(req.Instance)
+			trigger := common.TriggeringObject{GroupKind: schema.GroupKind{Group: "kubevirt.io", Kind: "KubeVirt"}, Namespace: kv.Namespace, Name: kv.Name}
+			Expect(handler.EnsureOperand(req, trigger)).To(BeTrue())
+			Expect(req.Instance.Status.Versions).To(ContainElement(hcov1beta1.Version{Name: "KubeVirt", Version: "v1.2.2", ExpectedVersion: "v1.2.3"}))
+			Expect(req.Instance.Status.Versions).To(ContainElement(hcov1beta1.Version{Name: "operator", Version: "v0.0.1"}))
+		})
+
+		It("should record the duration and the result of the ensure of each operand in the metrics", func() {
+			hco := commonTestUtils.NewHco()
+			cli := commonTestUtils.InitClient([]runtime.Object{qsCrd, hco})
+
+			handler := NewOperandHandler(cli, commonTestUtils.GetScheme(), true, commonTestUtils.NewEventEmitterMock())
+			handler.FirstUseInitiation(commonTestUtils.GetScheme(), true, hco)
+
+			getResults := func(kind, result string) float64 {
+				count, err := metrics.HcoMetrics.GetEnsureResultCount(kind, result)
+				Expect(err).ToNot(HaveOccurred())
+				return count
+			}
+			getDurations := func(kind string) uint64 {
+				count, err := metrics.HcoMetrics.GetEnsureDurationCount(kind)
+				Expect(err).ToNot(HaveOccurred())
+				return count
+			}
+
+			kvCreated := getResults("KubeVirt", metrics.EnsureCreated)
+			cdiErrors := getResults("CDI", metrics.EnsureError)
+			kvDurations := getDurations("KubeVirt")
+			cdiDurations := getDurations("CDI")
+
+			cli.InitiateCreateErrors(func(obj client.Object) error {
+				if _, ok := obj.(*cdiv1beta1.CDI); ok {
+					return fmt.Errorf("fake create CDI error")
+				}
+				return nil
+			})
+
+			req := commonTestUtils.NewReq(hco)
+			Expect(handler.Ensure(req)).ToNot(Succeed())
+
+			Expect(getResults("KubeVirt", metrics.EnsureCreated)).To(Equal(kvCreated + 1))
+			Expect(getResults("CDI", metrics.EnsureError)).To(Equal(cdiErrors + 1))
+			Expect(getDurations("KubeVirt")).To(Equal(kvDurations + 1))
+			Expect(getDurations("CDI")).To(Equal(cdiDurations + 1))
+
+			By("counting the overwritten operands")
+			kv := &kubevirtv1.KubeVirt{}
+			Expect(cli.Get(req.Ctx, client.ObjectKeyFromObject(NewKubeVirtWithNameOnly(hco)), kv)).To(Succeed())
+			kv.Spec.UninstallStrategy = kubevirtv1.KubeVirtUninstallStrategyRemoveWorkloads
+			Expect(cli.Update(req.Ctx, kv)).To(Succeed())
+
+			kvOverwritten := getResults("KubeVirt", metrics.EnsureOverwritten)
+			cli.InitiateCreateErrors(nil)
+			req = commonTestUtils.NewReq(hco)
+			req.HCOTriggered = false
+			Expect(handler.Ensure(req)).To(Succeed())
+
+			Expect(getResults("KubeVirt", metrics.EnsureOverwritten)).To(Equal(kvOverwritten + 1))
+		})
+
 		It("make sure the all objects are deleted", func() {
 			hco := commonTestUtils.NewHco()
 			cli := commonTestUtils.InitClient([]runtime.Object{qsCrd, hco})
@@ -433,6 +628,105 @@ var _ = Describe("Test operandHandler", func() {
 			})
 		})
 
+		Context("Test EnsureOperand", func() {
+			var (
+				hco          *hcov1beta1.HyperConverged
+				cli          *commonTestUtils.HcoTestClient
+				eventEmitter *commonTestUtils.EventEmitterMock
+				handler      *OperandHandler
+			)
+
+			BeforeEach(func() {
+				hco = commonTestUtils.NewHco()
+				cli = commonTestUtils.InitClient([]runtime.Object{hco})
+				eventEmitter = commonTestUtils.NewEventEmitterMock()
+				handler = NewOperandHandler(cli, commonTestUtils.GetScheme(), false, eventEmitter)
+
+				Expect(handler.Ensure(commonTestUtils.NewReq(hco))).To(Succeed())
+				eventEmitter.Reset()
+			})
+
+			It("should ensure only the operand that deployed the triggering resource", func() {
+				cm := &corev1.ConfigMap{}
+				Expect(cli.Get(context.TODO(), client.ObjectKey{Namespace: hco.Namespace, Name: "kubevirt-storage-class-defaults"}, cm)).To(Succeed())
+				Expect(cli.Delete(context.TODO(), cm)).To(Succeed())
+				cdi := NewCDIWithNameOnly(hco)
+				Expect(cli.Delete(context.TODO(), cdi)).To(Succeed())
+
+				req := commonTestUtils.NewReq(hco)
+				ensured, err := handler.EnsureOperand(req, common.TriggeringObject{
+					GroupKind: schema.GroupKind{Kind: "ConfigMap"},
+					Namespace: hco.Namespace,
+					Name:      "kubevirt-storage-class-defaults",
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ensured).To(BeTrue())
+
+				Expect(cli.Get(context.TODO(), client.ObjectKeyFromObject(cm), &corev1.ConfigMap{})).To(Succeed())
+				Expect(cli.Get(context.TODO(), client.ObjectKeyFromObject(cdi), &cdiv1beta1.CDI{})).ToNot(Succeed())
+				Expect(eventEmitter.CheckEvents([]commonTestUtils.MockEvent{
+					{
+						EventType: corev1.EventTypeNormal,
+						Reason:    "Created",
+						Msg:       "Created ConfigMap kubevirt-storage-class-defaults",
+					},
+				})).To(BeTrue())
+				Expect(eventEmitter.CheckEvents([]commonTestUtils.MockEvent{
+					{
+						EventType: corev1.EventTypeNormal,
+						Reason:    "Created",
+						Msg:       "Created CDI cdi-kubevirt-hyperconverged",
+					},
+				})).To(BeFalse())
+			})
+
+			It("should aggregate the conditions of the other operands from their status", func() {
+				kv := &kubevirtv1.KubeVirt{}
+				Expect(cli.Get(context.TODO(), client.ObjectKeyFromObject(NewKubeVirtWithNameOnly(hco)), kv)).To(Succeed())
+				kv.Status.Conditions = []kubevirtv1.KubeVirtCondition{
+					{Type: kubevirtv1.KubeVirtConditionAvailable, Status: corev1.ConditionTrue},
+					{Type: kubevirtv1.KubeVirtConditionProgressing, Status: corev1.ConditionFalse},
+					{Type: kubevirtv1.KubeVirtConditionDegraded, Status: corev1.ConditionTrue, Message: "fake degraded"},
+				}
+				Expect(cli.Update(context.TODO(), kv)).To(Succeed())
+
+				req := commonTestUtils.NewReq(hco)
+				ensured, err := handler.EnsureOperand(req, common.TriggeringObject{
+					GroupKind: kubevirtv1.KubeVirtGroupVersionKind.GroupKind(),
+					Namespace: kv.Namespace,
+					Name:      kv.Name,
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ensured).To(BeTrue())
+
+				Expect(req.Instance.Status.Components).To(HaveLen(3))
+				Expect(req.Instance.Status.Components[1].Name).To(Equal("KubeVirt"))
+				Expect(req.Instance.Status.Components[1].Conditions).To(HaveLen(3))
+				Expect(req.StatusDirty).To(BeTrue())
+
+				degraded, found := req.Conditions[hcov1beta1.ConditionDegraded]
+				Expect(found).To(BeTrue())
+				Expect(degraded.Reason).To(Equal("KubeVirtDegraded"))
+
+				// CDI did not report any condition yet
+				available, found := req.Conditions[hcov1beta1.ConditionAvailable]
+				Expect(found).To(BeTrue())
+				Expect(available.Status).To(Equal(metav1.ConditionFalse))
+			})
+
+			It("should not ensure anything if the triggering resource is not deployed by any operand", func() {
+				req := commonTestUtils.NewReq(hco)
+				ensured, err := handler.EnsureOperand(req, common.TriggeringObject{
+					GroupKind: schema.GroupKind{Kind: "ConfigMap"},
+					Namespace: hco.Namespace,
+					Name:      "unknown",
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ensured).To(BeFalse())
+				Expect(req.Conditions).To(BeEmpty())
+			})
+		})
+
 		It("delete timeout error handling", func() {
 			hco := commonTestUtils.NewHco()
 			cli := commonTestUtils.InitClient([]runtime.Object{qsCrd, hco})
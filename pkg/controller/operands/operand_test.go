@@ -1,10 +1,19 @@
 package operands
 
 import (
+	"context"
 	"fmt"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	kubevirtv1 "kubevirt.io/client-go/api/v1"
 	cdiv1beta1 "kubevirt.io/containerized-data-importer/pkg/apis/core/v1beta1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/controller/commonTestUtils"
 )
 
 var _ = Describe("Test operator.go", func() {
@@ -80,3 +89,115 @@ var _ = Describe("Test operator.go", func() {
 		})
 	})
 })
+
+var _ = Describe("Test the reconcile policy", func() {
+	var hco *hcov1beta1.HyperConverged
+
+	BeforeEach(func() {
+		hco = commonTestUtils.NewHco()
+	})
+
+	getKubeVirt := func(cl *commonTestUtils.HcoTestClient) (*kubevirtv1.KubeVirt, error) {
+		kv := &kubevirtv1.KubeVirt{}
+		err := cl.Get(context.TODO(), types.NamespacedName{Name: "kubevirt-" + hco.Name, Namespace: commonTestUtils.Namespace}, kv)
+		return kv, err
+	}
+
+	setPolicy := func(policy hcov1beta1.ReconcilePolicy) {
+		hco.Spec.ReconcilePolicies = []hcov1beta1.OperandReconcilePolicy{
+			{Kind: "KubeVirt", ReconcilePolicy: policy},
+		}
+	}
+
+	It("should return Enforce if the operand has no reconcile policy", func() {
+		hco.Spec.ReconcilePolicies = []hcov1beta1.OperandReconcilePolicy{
+			{Kind: "CDI", ReconcilePolicy: hcov1beta1.ReconcilePolicyPaused},
+		}
+		Expect(GetReconcilePolicy(hco, "KubeVirt")).To(Equal(hcov1beta1.ReconcilePolicyEnforce))
+		Expect(GetReconcilePolicy(hco, "CDI")).To(Equal(hcov1beta1.ReconcilePolicyPaused))
+	})
+
+	It("should not create a paused operand", func() {
+		setPolicy(hcov1beta1.ReconcilePolicyPaused)
+		cl := commonTestUtils.InitClient([]runtime.Object{hco})
+		handler := (*genericOperand)(newKubevirtHandler(cl, commonTestUtils.GetScheme()))
+
+		res := handler.ensure(commonTestUtils.NewReq(hco))
+		Expect(res.Err).ToNot(HaveOccurred())
+		Expect(res.Created).To(BeFalse())
+
+		_, err := getKubeVirt(cl)
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should not modify a paused operand, but still report its state", func() {
+		cl := commonTestUtils.InitClient([]runtime.Object{hco})
+		handler := (*genericOperand)(newKubevirtHandler(cl, commonTestUtils.GetScheme()))
+		Expect(handler.ensure(commonTestUtils.NewReq(hco)).Created).To(BeTrue())
+
+		kv, err := getKubeVirt(cl)
+		Expect(err).ToNot(HaveOccurred())
+		kv.Spec.UninstallStrategy = kubevirtv1.KubeVirtUninstallStrategyRemoveWorkloads
+		Expect(cl.Update(context.TODO(), kv)).To(Succeed())
+
+		setPolicy(hcov1beta1.ReconcilePolicyPaused)
+		req := commonTestUtils.NewReq(hco)
+		req.HCOTriggered = false
+		res := handler.ensure(req)
+		Expect(res.Err).ToNot(HaveOccurred())
+		Expect(res.Updated).To(BeFalse())
+		Expect(res.Warned).To(BeFalse())
+		Expect(res.Component).ToNot(BeNil())
+		Expect(res.Component.Name).To(Equal("KubeVirt"))
+
+		kv, err = getKubeVirt(cl)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(kv.Spec.UninstallStrategy).To(Equal(kubevirtv1.KubeVirtUninstallStrategyRemoveWorkloads))
+	})
+
+	It("should report, but not revert, a modification of a WarnOnly operand", func() {
+		cl := commonTestUtils.InitClient([]runtime.Object{hco})
+		handler := (*genericOperand)(newKubevirtHandler(cl, commonTestUtils.GetScheme()))
+		Expect(handler.ensure(commonTestUtils.NewReq(hco)).Created).To(BeTrue())
+
+		kv, err := getKubeVirt(cl)
+		Expect(err).ToNot(HaveOccurred())
+		kv.Spec.UninstallStrategy = kubevirtv1.KubeVirtUninstallStrategyRemoveWorkloads
+		Expect(cl.Update(context.TODO(), kv)).To(Succeed())
+
+		setPolicy(hcov1beta1.ReconcilePolicyWarnOnly)
+		req := commonTestUtils.NewReq(hco)
+		req.HCOTriggered = false
+		res := handler.ensure(req)
+		Expect(res.Err).ToNot(HaveOccurred())
+		Expect(res.Updated).To(BeFalse())
+		Expect(res.Overwritten).To(BeFalse())
+		Expect(res.Warned).To(BeTrue())
+		Expect(res.Drift).ToNot(BeNil())
+		Expect(res.Drift.Changes).To(Equal([]hcov1beta1.DriftChange{
+			{
+				Path:     "/spec/uninstallStrategy",
+				Observed: `"RemoveWorkloads"`,
+				Desired:  `"BlockUninstallIfWorkloadsExist"`,
+			},
+		}))
+
+		found, err := getKubeVirt(cl)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found.Spec.UninstallStrategy).To(Equal(kubevirtv1.KubeVirtUninstallStrategyRemoveWorkloads))
+		Expect(found.ResourceVersion).To(Equal(kv.ResourceVersion))
+	})
+
+	It("should not warn if a WarnOnly operand was not modified", func() {
+		setPolicy(hcov1beta1.ReconcilePolicyWarnOnly)
+		cl := commonTestUtils.InitClient([]runtime.Object{hco})
+		handler := (*genericOperand)(newKubevirtHandler(cl, commonTestUtils.GetScheme()))
+		Expect(handler.ensure(commonTestUtils.NewReq(hco)).Created).To(BeTrue())
+
+		res := handler.ensure(commonTestUtils.NewReq(hco))
+		Expect(res.Err).ToNot(HaveOccurred())
+		Expect(res.Updated).To(BeFalse())
+		Expect(res.Warned).To(BeFalse())
+		Expect(res.Drift).To(BeNil())
+	})
+})
@@ -0,0 +1,211 @@
+package operands
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	networkaddonsv1 "github.com/kubevirt/cluster-network-addons-operator/pkg/apis/networkaddonsoperator/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	kubevirtv1 "kubevirt.io/client-go/api/v1"
+	cdiv1beta1 "kubevirt.io/containerized-data-importer/pkg/apis/core/v1beta1"
+	sspv1beta1 "kubevirt.io/ssp-operator/api/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/controller/common"
+)
+
+const overrideIgnoredMessage = "no resource deployed by HCO matches the override target"
+
+// overridableFields maps the supported override target kinds to the top level field that the overrides may modify
+var overridableFields = map[string]string{
+	"KubeVirt":            "spec",
+	"CDI":                 "spec",
+	"NetworkAddonsConfig": "spec",
+	"SSP":                 "spec",
+	"HostPathProvisioner": "spec",
+	"ConfigMap":           "data",
+}
+
+// OverrideResult is the result of applying a single spec.overrides entry. Err is nil if the override was applied.
+type OverrideResult struct {
+	// Index is the index of the entry in the spec.overrides list
+	Index int
+	Err   error
+}
+
+// ValidateOverride checks that a spec.overrides entry is well-formed, without applying it
+func ValidateOverride(override hcov1beta1.HyperConvergedOverride) error {
+	field, ok := overridableFields[override.Target.Kind]
+	if !ok {
+		return fmt.Errorf("unsupported target kind %q", override.Target.Kind)
+	}
+
+	if override.Target.Kind == "ConfigMap" && override.Target.Name == "" {
+		return errors.New("the target name is required for ConfigMaps")
+	}
+
+	switch override.PatchType {
+	case hcov1beta1.OverridePatchTypeJSON:
+		patch, err := jsonpatch.DecodePatch([]byte(override.Patch))
+		if err != nil {
+			return fmt.Errorf("invalid JSON patch; %w", err)
+		}
+
+		for _, op := range patch {
+			path, err := op.Path()
+			if err != nil {
+				return fmt.Errorf("invalid JSON patch; %w", err)
+			}
+
+			if !strings.HasPrefix(path, "/"+field+"/") {
+				return fmt.Errorf("can only modify %s fields", field)
+			}
+		}
+
+	case hcov1beta1.OverridePatchTypeStrategicMerge:
+		patch := make(map[string]interface{})
+		if err := json.Unmarshal([]byte(override.Patch), &patch); err != nil {
+			return fmt.Errorf("invalid strategic merge patch; %w", err)
+		}
+
+		for key := range patch {
+			if key != field {
+				return fmt.Errorf("can only modify %s fields", field)
+			}
+		}
+
+	default:
+		return fmt.Errorf("unsupported patch type %q", override.PatchType)
+	}
+
+	return nil
+}
+
+// ApplyOverrides applies the spec.overrides entries that target obj, in their order in the list. It returns a patched
+// copy of obj, and the result of each one of the matching entries. A failed entry is skipped; obj itself is never
+// modified.
+func ApplyOverrides(hc *hcov1beta1.HyperConverged, obj client.Object) (client.Object, []OverrideResult) {
+	if len(hc.Spec.Overrides) == 0 {
+		return obj, nil
+	}
+
+	kind := getOverrideKind(obj)
+	if kind == "" {
+		return obj, nil
+	}
+
+	var results []OverrideResult
+	for i, override := range hc.Spec.Overrides {
+		if override.Target.Kind != kind || (override.Target.Name != "" && override.Target.Name != obj.GetName()) {
+			continue
+		}
+
+		patched, err := applyOverride(override, obj)
+		if err == nil {
+			obj = patched
+		}
+		results = append(results, OverrideResult{Index: i, Err: err})
+	}
+
+	return obj, results
+}
+
+func applyOverride(override hcov1beta1.HyperConvergedOverride, obj client.Object) (client.Object, error) {
+	if err := ValidateOverride(override); err != nil {
+		return nil, err
+	}
+
+	orig, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	var patched []byte
+	_, isUnstructured := obj.(*unstructured.Unstructured)
+	switch {
+	case override.PatchType == hcov1beta1.OverridePatchTypeJSON:
+		patch, _ := jsonpatch.DecodePatch([]byte(override.Patch)) // already validated
+		patched, err = patch.Apply(orig)
+	case isUnstructured:
+		// the schema of the unstructured operands is not known, so their patch strategies are not known as well
+		patched, err = jsonpatch.MergePatch(orig, []byte(override.Patch))
+	default:
+		patched, err = strategicpatch.StrategicMergePatch(orig, []byte(override.Patch), obj)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("can't apply the patch; %w", err)
+	}
+
+	result := reflect.New(reflect.TypeOf(obj).Elem()).Interface().(client.Object)
+	dec := json.NewDecoder(bytes.NewReader(patched))
+	if !isUnstructured {
+		dec.DisallowUnknownFields()
+	}
+	if err = dec.Decode(result); err != nil {
+		return nil, fmt.Errorf("the patched %s is not valid; %w", override.Target.Kind, err)
+	}
+
+	return result, nil
+}
+
+func getOverrideKind(obj client.Object) string {
+	switch o := obj.(type) {
+	case *kubevirtv1.KubeVirt:
+		return "KubeVirt"
+	case *cdiv1beta1.CDI:
+		return "CDI"
+	case *networkaddonsv1.NetworkAddonsConfig:
+		return "NetworkAddonsConfig"
+	case *sspv1beta1.SSP:
+		return "SSP"
+	case *corev1.ConfigMap:
+		return "ConfigMap"
+	case *unstructured.Unstructured:
+		return o.GetKind()
+	}
+	return ""
+}
+
+// newOverridesStatus initializes the overrides status. All the entries are ignored until an operand applies them.
+func newOverridesStatus(hc *hcov1beta1.HyperConverged) []hcov1beta1.HyperConvergedOverrideStatus {
+	if len(hc.Spec.Overrides) == 0 {
+		return nil
+	}
+
+	statuses := make([]hcov1beta1.HyperConvergedOverrideStatus, len(hc.Spec.Overrides))
+	for i, override := range hc.Spec.Overrides {
+		statuses[i] = hcov1beta1.HyperConvergedOverrideStatus{
+			Target:  override.Target,
+			State:   hcov1beta1.OverrideStateIgnored,
+			Message: overrideIgnoredMessage,
+		}
+	}
+
+	return statuses
+}
+
+func setOverridesStatus(req *common.HcoRequest, results []OverrideResult) {
+	statuses := req.Instance.Status.Overrides
+	for _, res := range results {
+		if res.Index >= len(statuses) {
+			continue
+		}
+
+		if res.Err != nil {
+			req.Logger.Error(res.Err, "failed to apply an override", "index", res.Index)
+			statuses[res.Index].State = hcov1beta1.OverrideStateFailed
+			statuses[res.Index].Message = res.Err.Error()
+		} else {
+			statuses[res.Index].State = hcov1beta1.OverrideStateApplied
+			statuses[res.Index].Message = ""
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package operands
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	kubevirtv1 "kubevirt.io/client-go/api/v1"
+	sspv1beta1 "kubevirt.io/ssp-operator/api/v1beta1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/controller/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/controller/commonTestUtils"
+)
+
+var _ = Describe("Overrides", func() {
+	var hco *hcov1beta1.HyperConverged
+	var req *common.HcoRequest
+
+	BeforeEach(func() {
+		hco = commonTestUtils.NewHco()
+		req = commonTestUtils.NewReq(hco)
+	})
+
+	newOverride := func(kind, name string, patchType hcov1beta1.OverridePatchType, patch string) hcov1beta1.HyperConvergedOverride {
+		return hcov1beta1.HyperConvergedOverride{
+			Target:    hcov1beta1.HyperConvergedOverrideTarget{Kind: kind, Name: name},
+			PatchType: patchType,
+			Patch:     patch,
+			Reason:    "testing",
+			Owner:     "tester",
+		}
+	}
+
+	Context("ApplyOverrides", func() {
+		It("should apply a JSON patch without modifying the original object", func() {
+			hco.Spec.Overrides = []hcov1beta1.HyperConvergedOverride{
+				newOverride("KubeVirt", "", hcov1beta1.OverridePatchTypeJSON, `[{"op": "add", "path": "/spec/configuration/cpuRequest", "value": "12m"}]`),
+			}
+			kv, err := NewKubeVirt(hco)
+			Expect(err).ToNot(HaveOccurred())
+
+			patched, results := ApplyOverrides(hco, kv)
+			Expect(results).To(Equal([]OverrideResult{{Index: 0}}))
+			Expect(patched.(*kubevirtv1.KubeVirt).Spec.Configuration.CPURequest.String()).To(Equal("12m"))
+			Expect(kv.Spec.Configuration.CPURequest).To(BeNil())
+		})
+
+		It("should apply a strategic merge patch", func() {
+			hco.Spec.Overrides = []hcov1beta1.HyperConvergedOverride{
+				newOverride("SSP", "", hcov1beta1.OverridePatchTypeStrategicMerge, `{"spec": {"templateValidator": {"replicas": 5}}}`),
+			}
+			ssp := NewSSP(hco)
+
+			patched, results := ApplyOverrides(hco, ssp)
+			Expect(results).To(Equal([]OverrideResult{{Index: 0}}))
+			Expect(*patched.(*sspv1beta1.SSP).Spec.TemplateValidator.Replicas).To(BeEquivalentTo(5))
+			Expect(patched.(*sspv1beta1.SSP).Spec.CommonTemplates.Namespace).To(Equal(ssp.Spec.CommonTemplates.Namespace))
+		})
+
+		It("should apply a merge patch on an unstructured operand", func() {
+			hco.Spec.Storage = &hcov1beta1.HyperConvergedStorageConfig{
+				HostPathProvisioner: &hcov1beta1.HostPathProvisionerConfig{
+					PathConfig: hcov1beta1.HostPathProvisionerPathConfig{Path: "/var/hpvolumes"},
+				},
+			}
+			hco.Spec.Overrides = []hcov1beta1.HyperConvergedOverride{
+				newOverride("HostPathProvisioner", "", hcov1beta1.OverridePatchTypeStrategicMerge, `{"spec": {"imagePullPolicy": "Always"}}`),
+			}
+			hpp, err := NewHostPathProvisioner(hco)
+			Expect(err).ToNot(HaveOccurred())
+
+			patched, results := ApplyOverrides(hco, hpp)
+			Expect(results).To(Equal([]OverrideResult{{Index: 0}}))
+			imagePullPolicy, _, err := unstructured.NestedString(patched.(*unstructured.Unstructured).Object, "spec", "imagePullPolicy")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(imagePullPolicy).To(Equal("Always"))
+		})
+
+		It("should apply only the overrides that match the target", func() {
+			hco.Spec.Overrides = []hcov1beta1.HyperConvergedOverride{
+				newOverride("ConfigMap", "other-cm", hcov1beta1.OverridePatchTypeStrategicMerge, `{"data": {"accessMode": "ReadOnlyMany"}}`),
+				newOverride("CDI", "", hcov1beta1.OverridePatchTypeJSON, `[{"op": "add", "path": "/spec/config", "value": {}}]`),
+				newOverride("ConfigMap", "kubevirt-storage-class-defaults", hcov1beta1.OverridePatchTypeStrategicMerge, `{"data": {"accessMode": "ReadWriteMany"}}`),
+			}
+			cm := NewKubeVirtStorageConfigForCR(hco, commonTestUtils.Namespace)
+
+			patched, results := ApplyOverrides(hco, cm)
+			Expect(results).To(Equal([]OverrideResult{{Index: 2}}))
+			Expect(patched.(*corev1.ConfigMap).Data).To(HaveKeyWithValue("accessMode", "ReadWriteMany"))
+		})
+
+		It("should skip an override that can't be applied", func() {
+			hco.Spec.Overrides = []hcov1beta1.HyperConvergedOverride{
+				newOverride("KubeVirt", "", hcov1beta1.OverridePatchTypeJSON, `[{"op": "add", "path": "/spec/noSuchField", "value": true}]`),
+				newOverride("KubeVirt", "", hcov1beta1.OverridePatchTypeJSON, `[{"op": "add", "path": "/metadata/labels/foo", "value": "bar"}]`),
+				newOverride("KubeVirt", "", hcov1beta1.OverridePatchTypeJSON, `[{"op": "add", "path": "/spec/configuration/cpuRequest", "value": "12m"}]`),
+			}
+			kv, err := NewKubeVirt(hco)
+			Expect(err).ToNot(HaveOccurred())
+
+			patched, results := ApplyOverrides(hco, kv)
+			Expect(results).To(HaveLen(3))
+			Expect(results[0].Err).To(HaveOccurred())
+			Expect(results[1].Err).To(MatchError("can only modify spec fields"))
+			Expect(results[2].Err).ToNot(HaveOccurred())
+			Expect(patched.(*kubevirtv1.KubeVirt).Spec.Configuration.CPURequest.String()).To(Equal("12m"))
+		})
+	})
+
+	Context("overrides status", func() {
+		It("should report the state of each override", func() {
+			hco.Spec.Overrides = []hcov1beta1.HyperConvergedOverride{
+				newOverride("ConfigMap", "kubevirt-storage-class-defaults", hcov1beta1.OverridePatchTypeStrategicMerge, `{"data": {"accessMode": "ReadWriteMany"}}`),
+				newOverride("ConfigMap", "kubevirt-storage-class-defaults", hcov1beta1.OverridePatchTypeJSON, `[{"op": "add", "path": "/data/foo", "value": 5}]`),
+				newOverride("HostPathProvisioner", "", hcov1beta1.OverridePatchTypeStrategicMerge, `{"spec": {"imagePullPolicy": "Always"}}`),
+			}
+			req.Instance.Status.Overrides = newOverridesStatus(hco)
+
+			cl := commonTestUtils.InitClient([]runtime.Object{hco})
+			handler := (*genericOperand)(newStorageConfigHandler(cl, commonTestUtils.GetScheme()))
+			res := handler.ensure(req)
+			Expect(res.Created).To(BeTrue())
+			Expect(res.Err).ToNot(HaveOccurred())
+
+			foundResource := &corev1.ConfigMap{}
+			Expect(
+				cl.Get(context.TODO(),
+					types.NamespacedName{Name: "kubevirt-storage-class-defaults", Namespace: commonTestUtils.Namespace},
+					foundResource),
+			).To(Succeed())
+			Expect(foundResource.Data).To(HaveKeyWithValue("accessMode", "ReadWriteMany"))
+			Expect(foundResource.Data).ToNot(HaveKey("foo"))
+
+			statuses := req.Instance.Status.Overrides
+			Expect(statuses).To(HaveLen(3))
+			Expect(statuses[0].State).To(Equal(hcov1beta1.OverrideStateApplied))
+			Expect(statuses[0].Message).To(BeEmpty())
+			Expect(statuses[1].State).To(Equal(hcov1beta1.OverrideStateFailed))
+			Expect(statuses[1].Message).ToNot(BeEmpty())
+			Expect(statuses[2].State).To(Equal(hcov1beta1.OverrideStateIgnored))
+			Expect(statuses[2].Message).To(Equal(overrideIgnoredMessage))
+		})
+	})
+})
@@ -9,7 +9,6 @@ import (
 	"os"
 	"path"
 	"path/filepath"
-	"reflect"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1beta1"
@@ -73,9 +72,11 @@ func (h sspHooks) getEmptyCr() client.Object { return &sspv1beta1.SSP{} }
 func (h sspHooks) getConditions(cr runtime.Object) []metav1.Condition {
 	return osConditionsToK8s(cr.(*sspv1beta1.SSP).Status.Conditions)
 }
-func (h sspHooks) checkComponentVersion(cr runtime.Object) bool {
-	found := cr.(*sspv1beta1.SSP)
-	return checkComponentVersion(hcoutil.SspVersionEnvV, found.Status.ObservedVersion)
+func (h sspHooks) getObservedVersion(cr runtime.Object) string {
+	return cr.(*sspv1beta1.SSP).Status.ObservedVersion
+}
+func (h sspHooks) getExpectedVersion() string {
+	return os.Getenv(hcoutil.SspVersionEnvV)
 }
 func (h sspHooks) getObjectMeta(cr runtime.Object) *metav1.ObjectMeta {
 	return &cr.(*sspv1beta1.SSP).ObjectMeta
@@ -90,22 +91,7 @@ func (h *sspHooks) updateCr(req *common.HcoRequest, client client.Client, exists
 	if !ok1 || !ok2 {
 		return false, false, errors.New("can't convert to SSP")
 	}
-	if !reflect.DeepEqual(found.Spec, ssp.Spec) ||
-		!reflect.DeepEqual(found.Labels, ssp.Labels) {
-		if req.HCOTriggered {
-			req.Logger.Info("Updating existing SSP's Spec to new opinionated values")
-		} else {
-			req.Logger.Info("Reconciling an externally updated SSP's Spec to its opinionated values")
-		}
-		util.DeepCopyLabels(&ssp.ObjectMeta, &found.ObjectMeta)
-		ssp.Spec.DeepCopyInto(&found.Spec)
-		err := client.Update(req.Ctx, found)
-		if err != nil {
-			return false, false, err
-		}
-		return true, !req.HCOTriggered, nil
-	}
-	return false, false, nil
+	return applyCr(req, client, "SSP", found, ssp)
 }
 
 func NewSSP(hc *hcov1beta1.HyperConverged, opts ...string) *sspv1beta1.SSP {
@@ -134,12 +120,12 @@ func NewSSP(hc *hcov1beta1.HyperConverged, opts ...string) *sspv1beta1.SSP {
 		NodeLabeller: sspv1beta1.NodeLabeller{},
 	}
 
-	if hc.Spec.Infra.NodePlacement != nil {
-		spec.TemplateValidator.Placement = hc.Spec.Infra.NodePlacement.DeepCopy()
+	if infra := getInfraConfig(hc, hcoutil.AppComponentSchedule); infra.NodePlacement != nil {
+		spec.TemplateValidator.Placement = infra.NodePlacement.DeepCopy()
 	}
 
-	if hc.Spec.Workloads.NodePlacement != nil {
-		spec.NodeLabeller.Placement = hc.Spec.Workloads.NodePlacement.DeepCopy()
+	if workloads := getWorkloadsConfig(hc, hcoutil.AppComponentSchedule); workloads.NodePlacement != nil {
+		spec.NodeLabeller.Placement = workloads.NodePlacement.DeepCopy()
 	}
 
 	return &sspv1beta1.SSP{
@@ -193,7 +179,7 @@ func readDataImportCronTemplatesFromFile() error {
 func getDataImportCronTemplates(hc *hcov1beta1.HyperConverged) []sspv1beta1.DataImportCronTemplate {
 	var dataImportCronTemplateList []sspv1beta1.DataImportCronTemplate = nil
 
-	if hc.Spec.FeatureGates.EnableCommonBootImageImport {
+	if hc.Spec.FeatureGates.IsEnableCommonBootImageImportEnabled() {
 		dataImportCronTemplateList = append(dataImportCronTemplateList, dataImportCronTemplateHardCodedList...)
 	}
 	dataImportCronTemplateList = append(dataImportCronTemplateList, hc.Spec.DataImportCronTemplates...)
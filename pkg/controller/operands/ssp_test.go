@@ -450,10 +450,10 @@ var _ = Describe("SSP Operands", func() {
 
 				It("should return an empty list if both the hard-coded list and the list from HC are empty", func() {
 					hcoWithEmptyList := commonTestUtils.NewHco()
-					hcoWithEmptyList.Spec.FeatureGates.EnableCommonBootImageImport = true
+					hcoWithEmptyList.Spec.FeatureGates.EnableCommonBootImageImport = boolPtr(true)
 					hcoWithEmptyList.Spec.DataImportCronTemplates = []sspv1beta1.DataImportCronTemplate{}
 					hcoWithNilList := commonTestUtils.NewHco()
-					hcoWithNilList.Spec.FeatureGates.EnableCommonBootImageImport = true
+					hcoWithNilList.Spec.FeatureGates.EnableCommonBootImageImport = boolPtr(true)
 					hcoWithNilList.Spec.DataImportCronTemplates = nil
 
 					dataImportCronTemplateHardCodedList = nil
@@ -467,7 +467,7 @@ var _ = Describe("SSP Operands", func() {
 				It("Should add the CR list to the hard-coded list", func() {
 					dataImportCronTemplateHardCodedList = []sspv1beta1.DataImportCronTemplate{image1, image2}
 					hco := commonTestUtils.NewHco()
-					hco.Spec.FeatureGates.EnableCommonBootImageImport = true
+					hco.Spec.FeatureGates.EnableCommonBootImageImport = boolPtr(true)
 					hco.Spec.DataImportCronTemplates = []sspv1beta1.DataImportCronTemplate{image3, image4}
 					goldenImageList := getDataImportCronTemplates(hco)
 					Expect(goldenImageList).To(HaveLen(4))
@@ -479,7 +479,7 @@ var _ = Describe("SSP Operands", func() {
 					By("CR list is nil")
 					dataImportCronTemplateHardCodedList = []sspv1beta1.DataImportCronTemplate{image1, image2}
 					hco := commonTestUtils.NewHco()
-					hco.Spec.FeatureGates.EnableCommonBootImageImport = true
+					hco.Spec.FeatureGates.EnableCommonBootImageImport = boolPtr(true)
 					hco.Spec.DataImportCronTemplates = nil
 					goldenImageList := getDataImportCronTemplates(hco)
 					Expect(goldenImageList).To(HaveLen(2))
@@ -495,7 +495,7 @@ var _ = Describe("SSP Operands", func() {
 
 				It("Should return only the CR list, if the hard-coded list is empty", func() {
 					hco := commonTestUtils.NewHco()
-					hco.Spec.FeatureGates.EnableCommonBootImageImport = true
+					hco.Spec.FeatureGates.EnableCommonBootImageImport = boolPtr(true)
 					hco.Spec.DataImportCronTemplates = []sspv1beta1.DataImportCronTemplate{image3, image4}
 
 					By("when dataImportCronTemplateHardCodedList is nil")
@@ -518,7 +518,7 @@ var _ = Describe("SSP Operands", func() {
 
 				It("should return an empty list if there is no file and no list in the HyperConverged CR", func() {
 					hco := commonTestUtils.NewHco()
-					hco.Spec.FeatureGates.EnableCommonBootImageImport = true
+					hco.Spec.FeatureGates.EnableCommonBootImageImport = boolPtr(true)
 					ssp := NewSSP(hco)
 
 					Expect(ssp.Spec.CommonTemplates.DataImportCronTemplates).Should(BeNil())
@@ -536,7 +536,7 @@ var _ = Describe("SSP Operands", func() {
 					Expect(readDataImportCronTemplatesFromFile()).ToNot(HaveOccurred())
 
 					hco := commonTestUtils.NewHco()
-					hco.Spec.FeatureGates.EnableCommonBootImageImport = true
+					hco.Spec.FeatureGates.EnableCommonBootImageImport = boolPtr(true)
 					ssp := NewSSP(hco)
 
 					Expect(ssp.Spec.CommonTemplates.DataImportCronTemplates).ShouldNot(BeNil())
@@ -555,7 +555,7 @@ var _ = Describe("SSP Operands", func() {
 					Expect(readDataImportCronTemplatesFromFile()).ToNot(HaveOccurred())
 
 					hco := commonTestUtils.NewHco()
-					hco.Spec.FeatureGates.EnableCommonBootImageImport = true
+					hco.Spec.FeatureGates.EnableCommonBootImageImport = boolPtr(true)
 					hco.Spec.DataImportCronTemplates = []sspv1beta1.DataImportCronTemplate{image3, image4}
 					ssp := NewSSP(hco)
 
@@ -569,7 +569,7 @@ var _ = Describe("SSP Operands", func() {
 					Expect(dataImportCronTemplateHardCodedList).Should(BeEmpty())
 
 					hco := commonTestUtils.NewHco()
-					hco.Spec.FeatureGates.EnableCommonBootImageImport = true
+					hco.Spec.FeatureGates.EnableCommonBootImageImport = boolPtr(true)
 					hco.Spec.DataImportCronTemplates = []sspv1beta1.DataImportCronTemplate{image3, image4}
 					ssp := NewSSP(hco)
 
@@ -590,7 +590,7 @@ var _ = Describe("SSP Operands", func() {
 					Expect(readDataImportCronTemplatesFromFile()).ToNot(HaveOccurred())
 
 					hco := commonTestUtils.NewHco()
-					hco.Spec.FeatureGates.EnableCommonBootImageImport = false
+					hco.Spec.FeatureGates.EnableCommonBootImageImport = boolPtr(false)
 					hco.Spec.DataImportCronTemplates = []sspv1beta1.DataImportCronTemplate{image3, image4}
 					ssp := NewSSP(hco)
 
@@ -0,0 +1,130 @@
+package operands
+
+import (
+	"fmt"
+
+	consolev1 "github.com/openshift/api/console/v1"
+	routev1 "github.com/openshift/api/route/v1"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/controller/common"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+// objectKey identifies a resource that HCO deploys
+type objectKey struct {
+	kind      string
+	namespace string
+	name      string
+}
+
+// getSweptKinds returns the lists of the kinds that HCO deploys with its standard labels, other than the operand CRs.
+// The resources of these kinds that carry the labels of HCO, but are not required by any of the handlers, are removed.
+func getSweptKinds() []client.ObjectList {
+	return []client.ObjectList{
+		&corev1.ConfigMapList{},
+		&corev1.ServiceList{},
+		&rbacv1.RoleList{},
+		&rbacv1.RoleBindingList{},
+		&routev1.RouteList{},
+		&monitoringv1.ServiceMonitorList{},
+		&monitoringv1.PrometheusRuleList{},
+		&consolev1.ConsoleQuickStartList{},
+		&consolev1.ConsoleCLIDownloadList{},
+	}
+}
+
+// kvCmBackupName is the backup of the kubevirt-config ConfigMap, that is created during the upgrade
+const kvCmBackupName = kvCmName + "-backup"
+
+// isSweepException checks if a resource is kept, although it is not required by any of the handlers. The
+// kubevirt-config ConfigMap is removed only after its configurations are adopted, and its backup is never removed.
+func isSweepException(key objectKey) bool {
+	return key.kind == "ConfigMap" && (key.name == kvCmName || key.name == kvCmBackupName)
+}
+
+// sweep removes the resources that carry the labels of HCO, but are not required by any of the handlers anymore; e.g.
+// the quick start guides or the dashboards of an older version, and then removes them from the related objects. The
+// sweep is best-effort; a resource that failed to be removed is removed in the next reconciliation.
+func (h OperandHandler) sweep(req *common.HcoRequest, required map[objectKey]bool) {
+	selector := client.MatchingLabels{
+		hcoutil.AppLabel:          req.Instance.Name,
+		hcoutil.AppLabelManagedBy: hcoutil.OperatorName,
+	}
+
+	sweptKinds := make(map[string]bool)
+	for _, list := range getSweptKinds() {
+		gvk, err := apiutil.GVKForObject(list, h.client.Scheme())
+		if err != nil {
+			req.Logger.Error(err, "can't get the kind of the resources to sweep")
+			continue
+		}
+
+		if err = h.client.List(req.Ctx, list, selector); err != nil {
+			if !meta.IsNoMatchError(err) {
+				req.Logger.Error(err, "failed to list the resources to sweep", "Kind", gvk.Kind)
+			}
+			continue
+		}
+
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			req.Logger.Error(err, "can't read the list of the resources to sweep", "Kind", gvk.Kind)
+			continue
+		}
+
+		kind := gvk.Kind[:len(gvk.Kind)-len("List")]
+		sweptKinds[kind] = true
+		for _, item := range items {
+			obj, ok := item.(client.Object)
+			if !ok {
+				continue
+			}
+
+			key := objectKey{kind: kind, namespace: obj.GetNamespace(), name: obj.GetName()}
+			if required[key] || isSweepException(key) {
+				continue
+			}
+
+			h.removeOrphan(req, obj, kind)
+		}
+	}
+
+	pruneRelatedObjects(req, sweptKinds, required)
+}
+
+func (h OperandHandler) removeOrphan(req *common.HcoRequest, obj client.Object, kind string) {
+	req.Logger.Info("removing a resource that is not required anymore", "Kind", kind, "namespace", obj.GetNamespace(), "name", obj.GetName())
+	if err := h.client.Delete(req.Ctx, obj); err != nil {
+		if !apierrors.IsNotFound(err) {
+			req.Logger.Error(err, "failed to remove a resource that is not required anymore", "Kind", kind, "name", obj.GetName())
+		}
+		return
+	}
+
+	h.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeNormal, "Killing", fmt.Sprintf("Removed %s %s", kind, obj.GetName()))
+}
+
+// pruneRelatedObjects removes the resources of the swept kinds, that are not required anymore, from the related
+// objects
+func pruneRelatedObjects(req *common.HcoRequest, sweptKinds map[string]bool, required map[objectKey]bool) {
+	refs := make([]corev1.ObjectReference, 0, len(req.Instance.Status.RelatedObjects))
+	for _, ref := range req.Instance.Status.RelatedObjects {
+		key := objectKey{kind: ref.Kind, namespace: ref.Namespace, name: ref.Name}
+		if sweptKinds[ref.Kind] && !required[key] && !isSweepException(key) {
+			continue
+		}
+		refs = append(refs, ref)
+	}
+
+	if len(refs) != len(req.Instance.Status.RelatedObjects) {
+		req.Instance.Status.RelatedObjects = refs
+		req.StatusDirty = true
+	}
+}
@@ -0,0 +1,166 @@
+package operands
+
+import (
+	"context"
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	consolev1 "github.com/openshift/api/console/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/controller/commonTestUtils"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+var _ = Describe("Test the sweep of the resources that are not required anymore", func() {
+	testFileLocation := getTestFilesLocation()
+
+	_ = os.Setenv(quickStartManifestLocationVarName, testFileLocation+"/quickstarts")
+	_ = os.Setenv(dashboardManifestLocationVarName, testFileLocation+"/dashboards")
+
+	var (
+		hco          *hcov1beta1.HyperConverged
+		eventEmitter *commonTestUtils.EventEmitterMock
+	)
+
+	hcoLabels := func() map[string]string {
+		return map[string]string{
+			hcoutil.AppLabel:          hco.Name,
+			hcoutil.AppLabelManagedBy: hcoutil.OperatorName,
+		}
+	}
+
+	newCm := func(name string, labels map[string]string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: hco.Namespace,
+				Labels:    labels,
+			},
+		}
+	}
+
+	exists := func(cl client.Client, obj client.Object) bool {
+		err := cl.Get(context.TODO(), client.ObjectKeyFromObject(obj), obj)
+		if apierrors.IsNotFound(err) {
+			return false
+		}
+		Expect(err).ToNot(HaveOccurred())
+		return true
+	}
+
+	ensure := func(cl client.Client) *OperandHandler {
+		handler := NewOperandHandler(cl, commonTestUtils.GetScheme(), true, eventEmitter)
+		handler.FirstUseInitiation(commonTestUtils.GetScheme(), true, hco)
+
+		req := commonTestUtils.NewReq(hco)
+		Expect(handler.Ensure(req)).To(Succeed())
+		return handler
+	}
+
+	BeforeEach(func() {
+		hco = commonTestUtils.NewHco()
+		eventEmitter = commonTestUtils.NewEventEmitterMock()
+	})
+
+	It("should remove the resources with the labels of HCO, that are not required by any handler", func() {
+		orphanCm := newCm("orphan-cm", hcoLabels())
+		orphanQs := &consolev1.ConsoleQuickStart{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "old-quickstart-guide",
+				Labels: hcoLabels(),
+			},
+		}
+		cl := commonTestUtils.InitClient([]runtime.Object{qsCrd, hco, orphanCm, orphanQs})
+
+		ensure(cl)
+
+		Expect(exists(cl, orphanCm)).To(BeFalse())
+		Expect(exists(cl, orphanQs)).To(BeFalse())
+		Expect(eventEmitter.CheckEvents([]commonTestUtils.MockEvent{
+			{
+				EventType: corev1.EventTypeNormal,
+				Reason:    "Killing",
+				Msg:       "Removed ConfigMap orphan-cm",
+			},
+			{
+				EventType: corev1.EventTypeNormal,
+				Reason:    "Killing",
+				Msg:       "Removed ConsoleQuickStart old-quickstart-guide",
+			},
+		})).To(BeTrue())
+
+		By("keeping the required resources")
+		Expect(exists(cl, newCm("kubevirt-storage-class-defaults", nil))).To(BeTrue())
+		Expect(exists(cl, &consolev1.ConsoleQuickStart{ObjectMeta: metav1.ObjectMeta{Name: "test-quick-start"}})).To(BeTrue())
+	})
+
+	It("should not remove resources without the labels of HCO, or the kubevirt-config backup", func() {
+		userCm := newCm("user-cm", map[string]string{hcoutil.AppLabel: hco.Name})
+		otherHcoCm := newCm("other-hco-cm", map[string]string{
+			hcoutil.AppLabel:          "other-hco",
+			hcoutil.AppLabelManagedBy: hcoutil.OperatorName,
+		})
+		backupCm := newCm(kvCmBackupName, hcoLabels())
+		cl := commonTestUtils.InitClient([]runtime.Object{qsCrd, hco, userCm, otherHcoCm, backupCm})
+
+		ensure(cl)
+
+		Expect(exists(cl, userCm)).To(BeTrue())
+		Expect(exists(cl, otherHcoCm)).To(BeTrue())
+		Expect(exists(cl, backupCm)).To(BeTrue())
+	})
+
+	It("should remove the resources that are not required anymore from the related objects", func() {
+		required := corev1.ObjectReference{
+			APIVersion: "v1",
+			Kind:       "ConfigMap",
+			Name:       "kubevirt-storage-class-defaults",
+			Namespace:  hco.Namespace,
+		}
+		orphan := corev1.ObjectReference{
+			APIVersion: "v1",
+			Kind:       "ConfigMap",
+			Name:       "orphan-cm",
+			Namespace:  hco.Namespace,
+		}
+		removedQs := corev1.ObjectReference{
+			APIVersion: "console.openshift.io/v1",
+			Kind:       "ConsoleQuickStart",
+			Name:       "old-quickstart-guide",
+		}
+		other := corev1.ObjectReference{
+			APIVersion: "v2v.kubevirt.io/v1alpha1",
+			Kind:       "VMImportConfig",
+			Name:       "vmimport-kubevirt-hyperconverged",
+		}
+		hco.Status.RelatedObjects = []corev1.ObjectReference{required, orphan, removedQs, other}
+		cl := commonTestUtils.InitClient([]runtime.Object{qsCrd, hco})
+
+		handler := NewOperandHandler(cl, commonTestUtils.GetScheme(), true, eventEmitter)
+		handler.FirstUseInitiation(commonTestUtils.GetScheme(), true, hco)
+		req := commonTestUtils.NewReq(hco)
+		Expect(handler.Ensure(req)).To(Succeed())
+
+		Expect(req.StatusDirty).To(BeTrue())
+		Expect(hco.Status.RelatedObjects).ToNot(ContainElement(orphan))
+		Expect(hco.Status.RelatedObjects).ToNot(ContainElement(removedQs))
+		Expect(hco.Status.RelatedObjects).To(ContainElement(other))
+		Expect(searchRelatedObject(hco.Status.RelatedObjects, "ConfigMap", "kubevirt-storage-class-defaults")).To(BeTrue())
+	})
+})
+
+func searchRelatedObject(refs []corev1.ObjectReference, kind, name string) bool {
+	for _, ref := range refs {
+		if ref.Kind == kind && ref.Name == name {
+			return true
+		}
+	}
+	return false
+}
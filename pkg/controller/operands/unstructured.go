@@ -0,0 +1,67 @@
+package operands
+
+import (
+	"fmt"
+
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/controller/common"
+)
+
+// Some of the operands APIs are not vendored. These operands are handled as unstructured objects, using the helper
+// functions below. The operand CRs are expected to use the openshift conditions and to report their version in the
+// status.observedVersion field.
+
+func getUnstructuredConditions(cr runtime.Object) []metav1.Condition {
+	found := cr.(*unstructured.Unstructured)
+	conditions, ok, err := unstructured.NestedSlice(found.Object, "status", "conditions")
+	if err != nil || !ok {
+		return nil
+	}
+
+	osConditions := make([]conditionsv1.Condition, 0, len(conditions))
+	for _, c := range conditions {
+		obj, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		cond := conditionsv1.Condition{}
+		if err = runtime.DefaultUnstructuredConverter.FromUnstructured(obj, &cond); err != nil {
+			continue
+		}
+		osConditions = append(osConditions, cond)
+	}
+
+	return osConditionsToK8s(osConditions)
+}
+
+func getUnstructuredObservedVersion(cr runtime.Object) string {
+	found := cr.(*unstructured.Unstructured)
+	observedVersion, _, _ := unstructured.NestedString(found.Object, "status", "observedVersion")
+	return observedVersion
+}
+
+func getUnstructuredObjectMeta(cr runtime.Object) *metav1.ObjectMeta {
+	found := cr.(*unstructured.Unstructured)
+	return &metav1.ObjectMeta{
+		Name:            found.GetName(),
+		Namespace:       found.GetNamespace(),
+		Labels:          found.GetLabels(),
+		OwnerReferences: found.GetOwnerReferences(),
+	}
+}
+
+// updateUnstructuredSpec reconciles the spec and the labels of an unstructured operand CR
+func updateUnstructuredSpec(req *common.HcoRequest, Client client.Client, crType string, exists runtime.Object, required runtime.Object) (bool, bool, error) {
+	cr, ok1 := required.(*unstructured.Unstructured)
+	found, ok2 := exists.(*unstructured.Unstructured)
+	if !ok1 || !ok2 {
+		return false, false, fmt.Errorf("can't convert to %s", crType)
+	}
+
+	return applyCr(req, Client, crType, found, cr)
+}
@@ -0,0 +1,263 @@
+package operands
+
+import (
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/controller/common"
+)
+
+// upgradePlan is the rollout order of the operands during an upgrade; an operand is upgraded only after the operands
+// it depends on. The operand handler ensures the operands in the same order, so once a step is completed, the next
+// operand is upgraded in the same reconciliation.
+var upgradePlan = []string{
+	"CDI",
+	"KubeVirt",
+	"NetworkAddonsConfig",
+	"HostPathProvisioner",
+	"SSP",
+}
+
+// StartUpgrade initializes the upgrade status of the HyperConverged CR, unless an upgrade to the same version was
+// already started; e.g. before the operator was restarted. Returns true if the status was modified.
+func StartUpgrade(hc *hcov1beta1.HyperConverged, sourceVersion, targetVersion string) bool {
+	if IsUpgradeStarted(hc, targetVersion) {
+		return false
+	}
+
+	now := metav1.Now()
+	upgrade := &hcov1beta1.UpgradeStatus{
+		SourceVersion: sourceVersion,
+		TargetVersion: targetVersion,
+		Phase:         hcov1beta1.UpgradePhaseInProgress,
+		StartedAt:     now,
+		Steps:         make([]hcov1beta1.UpgradeStepStatus, len(upgradePlan)),
+	}
+	for i, name := range upgradePlan {
+		upgrade.Steps[i] = hcov1beta1.UpgradeStepStatus{Name: name, Phase: hcov1beta1.UpgradeStepPending}
+	}
+	advanceUpgrade(upgrade, true, now)
+
+	hc.Status.Upgrade = upgrade
+	return true
+}
+
+// IsUpgradeStarted checks if the upgrade status of the HyperConverged CR already records an upgrade to the target
+// version
+func IsUpgradeStarted(hc *hcov1beta1.HyperConverged, targetVersion string) bool {
+	return hc.Status.Upgrade != nil && hc.Status.Upgrade.TargetVersion == targetVersion
+}
+
+// IsMigrationCompleted checks if a migration was already completed during the current upgrade
+func IsMigrationCompleted(hc *hcov1beta1.HyperConverged, migration string) bool {
+	if hc.Status.Upgrade == nil {
+		return false
+	}
+
+	for _, completed := range hc.Status.Upgrade.CompletedMigrations {
+		if completed == migration {
+			return true
+		}
+	}
+	return false
+}
+
+// SetMigrationCompleted records the completion of a migration in the upgrade status. Returns true if the status was
+// modified.
+func SetMigrationCompleted(hc *hcov1beta1.HyperConverged, migration string) bool {
+	if hc.Status.Upgrade == nil || IsMigrationCompleted(hc, migration) {
+		return false
+	}
+
+	hc.Status.Upgrade.CompletedMigrations = append(hc.Status.Upgrade.CompletedMigrations, migration)
+	return true
+}
+
+// GetUpgradeStepTimeLeft returns the time left until the timeout of the current upgrade step, if there is such
+func GetUpgradeStepTimeLeft(hc *hcov1beta1.HyperConverged) (time.Duration, bool) {
+	upgrade := hc.Status.Upgrade
+	if upgrade == nil || upgrade.Phase != hcov1beta1.UpgradePhaseInProgress || getStepTimeout(hc) == nil {
+		return 0, false
+	}
+
+	step := getUpgradeStep(upgrade, upgrade.CurrentStep)
+	if step == nil || step.Phase != hcov1beta1.UpgradeStepInProgress || step.StartedAt == nil {
+		return 0, false
+	}
+
+	timeLeft := time.Until(step.StartedAt.Add(getStepTimeout(hc).Duration))
+	return timeLeft, timeLeft > 0
+}
+
+// isUpgradeStepPending checks if the upgrade of an operand should wait for the previous steps of the upgrade plan
+func isUpgradeStepPending(req *common.HcoRequest, kind string) bool {
+	if !req.UpgradeMode || req.Instance.Status.Upgrade == nil {
+		return false
+	}
+
+	step := getUpgradeStep(req.Instance.Status.Upgrade, kind)
+	return step != nil && step.Phase == hcov1beta1.UpgradeStepPending
+}
+
+// isUpgradeCompleted checks if all the steps of the upgrade plan are completed
+func isUpgradeCompleted(req *common.HcoRequest) bool {
+	upgrade := req.Instance.Status.Upgrade
+	return upgrade == nil || upgrade.Phase == hcov1beta1.UpgradePhaseCompleted
+}
+
+// updateUpgradeStep updates the upgrade step of an operand by the result of its ensure, and then starts the next step,
+// if possible
+func (h OperandHandler) updateUpgradeStep(req *common.HcoRequest, res *EnsureResult) {
+	upgrade := req.Instance.Status.Upgrade
+	if !req.UpgradeMode || upgrade == nil || upgrade.Phase == hcov1beta1.UpgradePhaseCompleted {
+		return
+	}
+
+	step := getUpgradeStep(upgrade, res.Type)
+	if step == nil || (step.Phase != hcov1beta1.UpgradeStepInProgress && step.Phase != hcov1beta1.UpgradeStepFailed) {
+		return
+	}
+
+	now := metav1.Now()
+	if res.UpgradeDone {
+		req.Logger.Info(fmt.Sprintf("upgrade step %s is completed", step.Name))
+		step.Phase = hcov1beta1.UpgradeStepCompleted
+		step.FinishedAt = &now
+		step.FailureReason = ""
+	} else if step.Phase == hcov1beta1.UpgradeStepInProgress {
+		reason := getUpgradeStepFailure(req.Instance, step, res, now)
+		if reason == "" {
+			return
+		}
+
+		req.Logger.Info(fmt.Sprintf("upgrade step %s failed: %s", step.Name, reason))
+		h.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeWarning, "UpgradeStepFailed", fmt.Sprintf("Upgrade step %s failed: %s", step.Name, reason))
+		step.Phase = hcov1beta1.UpgradeStepFailed
+		step.FailureReason = reason
+	} else {
+		return
+	}
+
+	h.advanceUpgrade(req, now)
+}
+
+// skipMissingUpgradeSteps skips the current upgrade steps, if their operands are not deployed on this cluster
+func (h OperandHandler) skipMissingUpgradeSteps(req *common.HcoRequest, ensured map[string]bool) {
+	upgrade := req.Instance.Status.Upgrade
+	if !req.UpgradeMode || upgrade == nil {
+		return
+	}
+
+	for upgrade.Phase == hcov1beta1.UpgradePhaseInProgress {
+		step := getUpgradeStep(upgrade, upgrade.CurrentStep)
+		if step == nil || step.Phase != hcov1beta1.UpgradeStepInProgress || ensured[step.Name] {
+			return
+		}
+
+		now := metav1.Now()
+		step.Phase = hcov1beta1.UpgradeStepSkipped
+		step.FinishedAt = &now
+		h.advanceUpgrade(req, now)
+	}
+}
+
+func (h OperandHandler) advanceUpgrade(req *common.HcoRequest, now metav1.Time) {
+	upgrade := req.Instance.Status.Upgrade
+	prevPhase := upgrade.Phase
+	advanceUpgrade(upgrade, getHaltOnFailure(req.Instance), now)
+	req.StatusDirty = true
+
+	if upgrade.Phase == prevPhase {
+		return
+	}
+
+	switch upgrade.Phase {
+	case hcov1beta1.UpgradePhaseHalted:
+		h.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeWarning, "UpgradeHalted", fmt.Sprintf("The upgrade is halted at the failed %s step", upgrade.CurrentStep))
+	case hcov1beta1.UpgradePhaseCompleted:
+		req.Logger.Info("all the upgrade steps are completed")
+	}
+}
+
+// advanceUpgrade starts the next pending step, unless there is already a step in progress, or the rollout is halted at
+// a failed step, and then sets the current step and the phase of the upgrade
+func advanceUpgrade(upgrade *hcov1beta1.UpgradeStatus, haltOnFailure bool, now metav1.Time) {
+	upgrade.CurrentStep = ""
+	upgrade.Phase = hcov1beta1.UpgradePhaseInProgress
+
+	for i := range upgrade.Steps {
+		step := &upgrade.Steps[i]
+		switch step.Phase {
+		case hcov1beta1.UpgradeStepCompleted, hcov1beta1.UpgradeStepSkipped:
+			continue
+
+		case hcov1beta1.UpgradeStepFailed:
+			if upgrade.CurrentStep == "" {
+				upgrade.CurrentStep = step.Name
+			}
+			if haltOnFailure {
+				upgrade.Phase = hcov1beta1.UpgradePhaseHalted
+				return
+			}
+			continue
+
+		case hcov1beta1.UpgradeStepPending:
+			step.Phase = hcov1beta1.UpgradeStepInProgress
+			step.StartedAt = &now
+		}
+
+		if upgrade.CurrentStep == "" {
+			upgrade.CurrentStep = step.Name
+		}
+		return
+	}
+
+	if upgrade.CurrentStep == "" {
+		upgrade.Phase = hcov1beta1.UpgradePhaseCompleted
+		upgrade.FinishedAt = &now
+	}
+}
+
+// getUpgradeStepFailure returns the reason of the failure of an upgrade step in progress, if it failed
+func getUpgradeStepFailure(hc *hcov1beta1.HyperConverged, step *hcov1beta1.UpgradeStepStatus, res *EnsureResult, now metav1.Time) string {
+	if res.Component != nil {
+		for _, condition := range res.Component.Conditions {
+			if condition.Type == hcov1beta1.ConditionDegraded && condition.Status == metav1.ConditionTrue {
+				return fmt.Sprintf("%s is degraded: %s", step.Name, condition.Message)
+			}
+		}
+	}
+
+	if timeout := getStepTimeout(hc); timeout != nil && step.StartedAt != nil && now.Sub(step.StartedAt.Time) > timeout.Duration {
+		return fmt.Sprintf("%s was not upgraded within %s", step.Name, timeout.Duration)
+	}
+
+	return ""
+}
+
+func getUpgradeStep(upgrade *hcov1beta1.UpgradeStatus, name string) *hcov1beta1.UpgradeStepStatus {
+	for i := range upgrade.Steps {
+		if upgrade.Steps[i].Name == name {
+			return &upgrade.Steps[i]
+		}
+	}
+	return nil
+}
+
+func getStepTimeout(hc *hcov1beta1.HyperConverged) *metav1.Duration {
+	if hc.Spec.UpgradeStrategy == nil {
+		return nil
+	}
+	return hc.Spec.UpgradeStrategy.StepTimeout
+}
+
+func getHaltOnFailure(hc *hcov1beta1.HyperConverged) bool {
+	if hc.Spec.UpgradeStrategy == nil || hc.Spec.UpgradeStrategy.HaltOnFailure == nil {
+		return true
+	}
+	return *hc.Spec.UpgradeStrategy.HaltOnFailure
+}
@@ -0,0 +1,269 @@
+package operands
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	kubevirtv1 "kubevirt.io/client-go/api/v1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/controller/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/controller/commonTestUtils"
+)
+
+var _ = Describe("Test the upgrade plan", func() {
+	const (
+		sourceVersion = "1.5.0"
+		targetVersion = "1.6.0"
+	)
+
+	var (
+		hco          *hcov1beta1.HyperConverged
+		req          *common.HcoRequest
+		eventEmitter *commonTestUtils.EventEmitterMock
+		handler      *OperandHandler
+	)
+
+	BeforeEach(func() {
+		hco = commonTestUtils.NewHco()
+		req = commonTestUtils.NewReq(hco)
+		req.SetUpgradeMode(true)
+		eventEmitter = commonTestUtils.NewEventEmitterMock()
+		handler = &OperandHandler{eventEmitter: eventEmitter}
+	})
+
+	getStepPhases := func() []hcov1beta1.UpgradeStepPhase {
+		var phases []hcov1beta1.UpgradeStepPhase
+		for _, step := range hco.Status.Upgrade.Steps {
+			phases = append(phases, step.Phase)
+		}
+		return phases
+	}
+
+	degradedComponent := func(kind string) *hcov1beta1.ComponentStatus {
+		return &hcov1beta1.ComponentStatus{
+			Name: kind,
+			Conditions: []hcov1beta1.ComponentCondition{
+				{Type: hcov1beta1.ConditionDegraded, Status: metav1.ConditionTrue, Message: "fake failure"},
+			},
+		}
+	}
+
+	Context("StartUpgrade", func() {
+		It("should start the first step of the upgrade plan", func() {
+			Expect(StartUpgrade(hco, sourceVersion, targetVersion)).To(BeTrue())
+
+			upgrade := hco.Status.Upgrade
+			Expect(upgrade).ToNot(BeNil())
+			Expect(upgrade.SourceVersion).To(Equal(sourceVersion))
+			Expect(upgrade.TargetVersion).To(Equal(targetVersion))
+			Expect(upgrade.Phase).To(Equal(hcov1beta1.UpgradePhaseInProgress))
+			Expect(upgrade.CurrentStep).To(Equal("CDI"))
+			Expect(upgrade.Steps).To(HaveLen(len(upgradePlan)))
+			Expect(upgrade.Steps[0].StartedAt).ToNot(BeNil())
+			Expect(getStepPhases()).To(Equal([]hcov1beta1.UpgradeStepPhase{
+				hcov1beta1.UpgradeStepInProgress,
+				hcov1beta1.UpgradeStepPending,
+				hcov1beta1.UpgradeStepPending,
+				hcov1beta1.UpgradeStepPending,
+				hcov1beta1.UpgradeStepPending,
+			}))
+		})
+
+		It("should not restart an upgrade to the same version", func() {
+			Expect(StartUpgrade(hco, sourceVersion, targetVersion)).To(BeTrue())
+			hco.Status.Upgrade.Steps[0].Phase = hcov1beta1.UpgradeStepCompleted
+
+			Expect(StartUpgrade(hco, sourceVersion, targetVersion)).To(BeFalse())
+			Expect(hco.Status.Upgrade.Steps[0].Phase).To(Equal(hcov1beta1.UpgradeStepCompleted))
+
+			Expect(StartUpgrade(hco, targetVersion, "1.7.0")).To(BeTrue())
+			Expect(hco.Status.Upgrade.TargetVersion).To(Equal("1.7.0"))
+			Expect(hco.Status.Upgrade.Steps[0].Phase).To(Equal(hcov1beta1.UpgradeStepInProgress))
+		})
+
+		It("should record the completed migrations of the current upgrade", func() {
+			Expect(SetMigrationCompleted(hco, "migration")).To(BeFalse())
+
+			Expect(StartUpgrade(hco, sourceVersion, targetVersion)).To(BeTrue())
+			Expect(IsMigrationCompleted(hco, "migration")).To(BeFalse())
+			Expect(SetMigrationCompleted(hco, "migration")).To(BeTrue())
+			Expect(SetMigrationCompleted(hco, "migration")).To(BeFalse())
+			Expect(IsMigrationCompleted(hco, "migration")).To(BeTrue())
+			Expect(hco.Status.Upgrade.CompletedMigrations).To(Equal([]string{"migration"}))
+
+			Expect(StartUpgrade(hco, sourceVersion, targetVersion)).To(BeFalse())
+			Expect(IsMigrationCompleted(hco, "migration")).To(BeTrue())
+
+			Expect(StartUpgrade(hco, targetVersion, "1.7.0")).To(BeTrue())
+			Expect(IsMigrationCompleted(hco, "migration")).To(BeFalse())
+		})
+	})
+
+	Context("updateUpgradeStep", func() {
+		BeforeEach(func() {
+			StartUpgrade(hco, sourceVersion, targetVersion)
+		})
+
+		It("should start the next step when the operand is upgraded", func() {
+			handler.updateUpgradeStep(req, &EnsureResult{Type: "CDI", UpgradeDone: true})
+
+			Expect(req.StatusDirty).To(BeTrue())
+			Expect(hco.Status.Upgrade.CurrentStep).To(Equal("KubeVirt"))
+			Expect(hco.Status.Upgrade.Steps[0].FinishedAt).ToNot(BeNil())
+			Expect(getStepPhases()[:3]).To(Equal([]hcov1beta1.UpgradeStepPhase{
+				hcov1beta1.UpgradeStepCompleted,
+				hcov1beta1.UpgradeStepInProgress,
+				hcov1beta1.UpgradeStepPending,
+			}))
+		})
+
+		It("should wait while the operand is upgrading", func() {
+			handler.updateUpgradeStep(req, &EnsureResult{Type: "CDI"})
+
+			Expect(req.StatusDirty).To(BeFalse())
+			Expect(hco.Status.Upgrade.CurrentStep).To(Equal("CDI"))
+			Expect(hco.Status.Upgrade.Steps[0].Phase).To(Equal(hcov1beta1.UpgradeStepInProgress))
+		})
+
+		It("should ignore the operands of the pending steps", func() {
+			handler.updateUpgradeStep(req, &EnsureResult{Type: "KubeVirt", UpgradeDone: true})
+
+			Expect(hco.Status.Upgrade.CurrentStep).To(Equal("CDI"))
+			Expect(hco.Status.Upgrade.Steps[1].Phase).To(Equal(hcov1beta1.UpgradeStepPending))
+		})
+
+		It("should complete the upgrade after the last step", func() {
+			for _, kind := range upgradePlan {
+				handler.updateUpgradeStep(req, &EnsureResult{Type: kind, UpgradeDone: true})
+			}
+
+			Expect(hco.Status.Upgrade.Phase).To(Equal(hcov1beta1.UpgradePhaseCompleted))
+			Expect(hco.Status.Upgrade.CurrentStep).To(BeEmpty())
+			Expect(hco.Status.Upgrade.FinishedAt).ToNot(BeNil())
+			Expect(isUpgradeCompleted(req)).To(BeTrue())
+		})
+
+		It("should halt the upgrade if the operand is degraded", func() {
+			handler.updateUpgradeStep(req, &EnsureResult{Type: "CDI", Component: degradedComponent("CDI")})
+
+			upgrade := hco.Status.Upgrade
+			Expect(upgrade.Phase).To(Equal(hcov1beta1.UpgradePhaseHalted))
+			Expect(upgrade.CurrentStep).To(Equal("CDI"))
+			Expect(upgrade.Steps[0].Phase).To(Equal(hcov1beta1.UpgradeStepFailed))
+			Expect(upgrade.Steps[0].FailureReason).To(Equal("CDI is degraded: fake failure"))
+			Expect(upgrade.Steps[1].Phase).To(Equal(hcov1beta1.UpgradeStepPending))
+			Expect(isUpgradeStepPending(req, "KubeVirt")).To(BeTrue())
+
+			Expect(eventEmitter.CheckEvents([]commonTestUtils.MockEvent{
+				{
+					EventType: corev1.EventTypeWarning,
+					Reason:    "UpgradeStepFailed",
+					Msg:       "Upgrade step CDI failed: CDI is degraded: fake failure",
+				},
+				{
+					EventType: corev1.EventTypeWarning,
+					Reason:    "UpgradeHalted",
+					Msg:       "The upgrade is halted at the failed CDI step",
+				},
+			})).To(BeTrue())
+
+			By("resume the upgrade when the failed operand is upgraded")
+			handler.updateUpgradeStep(req, &EnsureResult{Type: "CDI", UpgradeDone: true})
+			Expect(upgrade.Phase).To(Equal(hcov1beta1.UpgradePhaseInProgress))
+			Expect(upgrade.CurrentStep).To(Equal("KubeVirt"))
+			Expect(upgrade.Steps[0].Phase).To(Equal(hcov1beta1.UpgradeStepCompleted))
+			Expect(upgrade.Steps[0].FailureReason).To(BeEmpty())
+		})
+
+		It("should continue to the next step on failure, if HaltOnFailure is false", func() {
+			haltOnFailure := false
+			hco.Spec.UpgradeStrategy = &hcov1beta1.HyperConvergedUpgradeStrategy{HaltOnFailure: &haltOnFailure}
+
+			handler.updateUpgradeStep(req, &EnsureResult{Type: "CDI", Component: degradedComponent("CDI")})
+
+			upgrade := hco.Status.Upgrade
+			Expect(upgrade.Phase).To(Equal(hcov1beta1.UpgradePhaseInProgress))
+			Expect(upgrade.Steps[0].Phase).To(Equal(hcov1beta1.UpgradeStepFailed))
+			Expect(upgrade.Steps[1].Phase).To(Equal(hcov1beta1.UpgradeStepInProgress))
+
+			By("the upgrade is not completed while a step is failed")
+			for _, kind := range upgradePlan[1:] {
+				handler.updateUpgradeStep(req, &EnsureResult{Type: kind, UpgradeDone: true})
+			}
+			Expect(upgrade.Phase).To(Equal(hcov1beta1.UpgradePhaseInProgress))
+			Expect(upgrade.CurrentStep).To(Equal("CDI"))
+			Expect(isUpgradeCompleted(req)).To(BeFalse())
+		})
+
+		It("should fail the step on timeout", func() {
+			hco.Spec.UpgradeStrategy = &hcov1beta1.HyperConvergedUpgradeStrategy{
+				StepTimeout: &metav1.Duration{Duration: time.Minute},
+			}
+
+			timeLeft, ok := GetUpgradeStepTimeLeft(hco)
+			Expect(ok).To(BeTrue())
+			Expect(timeLeft).To(BeNumerically("<=", time.Minute))
+
+			startedAt := metav1.NewTime(time.Now().Add(-2 * time.Minute))
+			hco.Status.Upgrade.Steps[0].StartedAt = &startedAt
+			_, ok = GetUpgradeStepTimeLeft(hco)
+			Expect(ok).To(BeFalse())
+
+			handler.updateUpgradeStep(req, &EnsureResult{Type: "CDI"})
+			Expect(hco.Status.Upgrade.Phase).To(Equal(hcov1beta1.UpgradePhaseHalted))
+			Expect(hco.Status.Upgrade.Steps[0].FailureReason).To(Equal("CDI was not upgraded within 1m0s"))
+		})
+
+		It("should skip the steps of the operands that are not deployed", func() {
+			hco.Status.Upgrade.Steps[0].Phase = hcov1beta1.UpgradeStepCompleted
+			hco.Status.Upgrade.Steps[1].Phase = hcov1beta1.UpgradeStepCompleted
+			hco.Status.Upgrade.Steps[2].Phase = hcov1beta1.UpgradeStepCompleted
+			hco.Status.Upgrade.Steps[3].Phase = hcov1beta1.UpgradeStepInProgress
+			hco.Status.Upgrade.CurrentStep = "HostPathProvisioner"
+
+			handler.skipMissingUpgradeSteps(req, map[string]bool{"SSP": true})
+
+			Expect(hco.Status.Upgrade.CurrentStep).To(Equal("SSP"))
+			Expect(getStepPhases()[3:]).To(Equal([]hcov1beta1.UpgradeStepPhase{
+				hcov1beta1.UpgradeStepSkipped,
+				hcov1beta1.UpgradeStepInProgress,
+			}))
+		})
+	})
+
+	It("should not modify an operand while its upgrade step is pending", func() {
+		cl := commonTestUtils.InitClient([]runtime.Object{hco})
+		kvHandler := (*genericOperand)(newKubevirtHandler(cl, commonTestUtils.GetScheme()))
+		Expect(kvHandler.ensure(commonTestUtils.NewReq(hco)).Created).To(BeTrue())
+
+		key := types.NamespacedName{Name: "kubevirt-" + hco.Name, Namespace: commonTestUtils.Namespace}
+		kv := &kubevirtv1.KubeVirt{}
+		Expect(cl.Get(context.TODO(), key, kv)).To(Succeed())
+		kv.Spec.UninstallStrategy = kubevirtv1.KubeVirtUninstallStrategyRemoveWorkloads
+		Expect(cl.Update(context.TODO(), kv)).To(Succeed())
+
+		StartUpgrade(hco, sourceVersion, targetVersion)
+		res := kvHandler.ensure(req)
+		Expect(res.Err).ToNot(HaveOccurred())
+		Expect(res.Updated).To(BeFalse())
+
+		Expect(cl.Get(context.TODO(), key, kv)).To(Succeed())
+		Expect(kv.Spec.UninstallStrategy).To(Equal(kubevirtv1.KubeVirtUninstallStrategyRemoveWorkloads))
+
+		By("update the operand when its step starts")
+		handler.updateUpgradeStep(req, &EnsureResult{Type: "CDI", UpgradeDone: true})
+		res = kvHandler.ensure(req)
+		Expect(res.Err).ToNot(HaveOccurred())
+		Expect(res.Updated).To(BeTrue())
+
+		Expect(cl.Get(context.TODO(), key, kv)).To(Succeed())
+		Expect(kv.Spec.UninstallStrategy).To(Equal(kubevirtv1.KubeVirtUninstallStrategyBlockUninstallIfWorkloadsExist))
+	})
+})
@@ -0,0 +1,262 @@
+package drift
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1beta1"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+// MaxReports is the number of drift reports that the operator keeps in memory
+const MaxReports = 100
+
+// Reports holds the last drift reports of the operator, to be exposed by the debug endpoint
+var Reports = NewRecorder(MaxReports)
+
+// Recorder keeps the last drift reports in a bounded ring buffer
+type Recorder struct {
+	lock    sync.Mutex
+	reports []hcov1beta1.DriftReport
+	next    int
+	full    bool
+}
+
+// NewRecorder creates a Recorder that keeps up to size reports
+func NewRecorder(size int) *Recorder {
+	return &Recorder{reports: make([]hcov1beta1.DriftReport, size)}
+}
+
+// Add records a new report. If the buffer is full, the oldest report is dropped.
+func (r *Recorder) Add(report hcov1beta1.DriftReport) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.reports[r.next] = *report.DeepCopy()
+	r.next = (r.next + 1) % len(r.reports)
+	if r.next == 0 {
+		r.full = true
+	}
+}
+
+// List returns the recorded reports; the oldest report first
+func (r *Recorder) List() []hcov1beta1.DriftReport {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	var reports []hcov1beta1.DriftReport
+	if r.full {
+		reports = append(reports, r.reports[r.next:]...)
+	}
+	reports = append(reports, r.reports[:r.next]...)
+
+	result := make([]hcov1beta1.DriftReport, len(reports))
+	for i := range reports {
+		reports[i].DeepCopyInto(&result[i])
+	}
+	return result
+}
+
+// ServeHTTP writes the recorded reports as a JSON list
+func (r *Recorder) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(r.List()); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// NewReport builds the drift report of a resource that HCO has overwritten, from the observed (modified) resource
+// and the resource as restored by HCO
+func NewReport(kind string, observed, desired client.Object) (*hcov1beta1.DriftReport, error) {
+	changes, err := Diff(observed, desired)
+	if err != nil {
+		return nil, err
+	}
+
+	return &hcov1beta1.DriftReport{
+		Kind:      kind,
+		Name:      desired.GetName(),
+		Namespace: desired.GetNamespace(),
+		Time:      metav1.Now(),
+		Changes:   changes,
+	}, nil
+}
+
+// Diff lists the fields that are different in the observed and in the desired resource. Only the labels, the
+// annotations and the content of the resource (e.g. the spec) are compared; the rest of the metadata and the status
+// are ignored. Maps are compared field by field; any other value, including lists, is compared as a whole.
+func Diff(observed, desired client.Object) ([]hcov1beta1.DriftChange, error) {
+	observedContent, err := getComparedContent(observed)
+	if err != nil {
+		return nil, err
+	}
+
+	desiredContent, err := getComparedContent(desired)
+	if err != nil {
+		return nil, err
+	}
+
+	d := &differ{managedFields: observed.GetManagedFields()}
+	if err = d.diff(nil, observedContent, desiredContent); err != nil {
+		return nil, err
+	}
+
+	return d.changes, nil
+}
+
+func getComparedContent(obj client.Object) (map[string]interface{}, error) {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	compared := make(map[string]interface{})
+	for key, value := range content {
+		switch key {
+		case "apiVersion", "kind", "status":
+		case "metadata":
+			metadata := make(map[string]interface{})
+			for _, field := range []string{"labels", "annotations"} {
+				if fieldValue, ok := value.(map[string]interface{})[field]; ok {
+					metadata[field] = fieldValue
+				}
+			}
+			compared[key] = metadata
+		default:
+			compared[key] = value
+		}
+	}
+
+	return compared, nil
+}
+
+type differ struct {
+	managedFields []metav1.ManagedFieldsEntry
+	changes       []hcov1beta1.DriftChange
+}
+
+func (d *differ) diff(path []string, observed, desired map[string]interface{}) error {
+	keys := make([]string, 0, len(observed)+len(desired))
+	for key := range observed {
+		keys = append(keys, key)
+	}
+	for key := range desired {
+		if _, ok := observed[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		observedValue, observedExists := observed[key]
+		desiredValue, desiredExists := desired[key]
+		fieldPath := append(append([]string{}, path...), key)
+
+		observedMap, ok1 := observedValue.(map[string]interface{})
+		desiredMap, ok2 := desiredValue.(map[string]interface{})
+		if ok1 && ok2 {
+			if err := d.diff(fieldPath, observedMap, desiredMap); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if observedExists && desiredExists && equalValues(observedValue, desiredValue) {
+			continue
+		}
+
+		change := hcov1beta1.DriftChange{
+			Path:    toJSONPointer(fieldPath),
+			Manager: d.getManager(fieldPath),
+		}
+		var err error
+		if observedExists {
+			if change.Observed, err = toJSON(observedValue); err != nil {
+				return err
+			}
+		}
+		if desiredExists {
+			if change.Desired, err = toJSON(desiredValue); err != nil {
+				return err
+			}
+		}
+		d.changes = append(d.changes, change)
+	}
+
+	return nil
+}
+
+// getManager returns the last manager, other than HCO, that owns the field, according to the managedFields of the
+// observed resource
+func (d *differ) getManager(path []string) string {
+	manager := ""
+	var lastTime *metav1.Time
+	for _, entry := range d.managedFields {
+		if entry.Manager == hcoutil.HCOFieldManager || entry.FieldsV1 == nil {
+			continue
+		}
+
+		fields := make(map[string]interface{})
+		if err := json.Unmarshal(entry.FieldsV1.Raw, &fields); err != nil {
+			continue
+		}
+
+		if ownsField(fields, path) && (lastTime == nil || (entry.Time != nil && lastTime.Before(entry.Time))) {
+			manager = entry.Manager
+			lastTime = entry.Time
+		}
+	}
+	return manager
+}
+
+// ownsField checks if a FieldsV1 set contains a field. A list is owned as a whole if any one of its items is owned.
+func ownsField(fields map[string]interface{}, path []string) bool {
+	for _, segment := range path {
+		next, ok := fields["f:"+segment].(map[string]interface{})
+		if !ok {
+			return isListItems(fields)
+		}
+		fields = next
+	}
+	return true
+}
+
+func isListItems(fields map[string]interface{}) bool {
+	for key := range fields {
+		if strings.HasPrefix(key, "k:") || strings.HasPrefix(key, "i:") || strings.HasPrefix(key, "v:") {
+			return true
+		}
+	}
+	return false
+}
+
+func equalValues(a, b interface{}) bool {
+	aJSON, err1 := json.Marshal(a)
+	bJSON, err2 := json.Marshal(b)
+	return err1 == nil && err2 == nil && string(aJSON) == string(bJSON)
+}
+
+func toJSON(value interface{}) (string, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func toJSONPointer(path []string) string {
+	escaper := strings.NewReplacer("~", "~0", "/", "~1")
+	sb := strings.Builder{}
+	for _, segment := range path {
+		sb.WriteString("/")
+		sb.WriteString(escaper.Replace(segment))
+	}
+	return sb.String()
+}
@@ -0,0 +1,152 @@
+package drift
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1beta1"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+var _ = Describe("Drift", func() {
+	newConfigMap := func() *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "cm",
+				Namespace:       "ns",
+				ResourceVersion: "1",
+				Labels:          map[string]string{"app": "hco"},
+			},
+			Data: map[string]string{"a": "1", "b/c": "2"},
+		}
+	}
+
+	Context("Diff", func() {
+		It("should report nothing if the resources are the same", func() {
+			observed := newConfigMap()
+			desired := newConfigMap()
+			desired.ResourceVersion = "2"
+
+			changes, err := Diff(observed, desired)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(changes).To(BeEmpty())
+		})
+
+		It("should report the modified, added and removed fields", func() {
+			observed := newConfigMap()
+			observed.Data["a"] = "5"
+			observed.Data["d"] = "3"
+			delete(observed.Data, "b/c")
+			observed.Labels["app"] = "other"
+
+			changes, err := Diff(observed, newConfigMap())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(changes).To(Equal([]hcov1beta1.DriftChange{
+				{Path: "/data/a", Observed: `"5"`, Desired: `"1"`},
+				{Path: "/data/b~1c", Desired: `"2"`},
+				{Path: "/data/d", Observed: `"3"`},
+				{Path: "/metadata/labels/app", Observed: `"other"`, Desired: `"hco"`},
+			}))
+		})
+
+		It("should read the modifying manager from the managed fields", func() {
+			earlier := metav1.Unix(1000, 0)
+			later := metav1.Unix(2000, 0)
+
+			observed := newConfigMap()
+			observed.Data["a"] = "5"
+			observed.ManagedFields = []metav1.ManagedFieldsEntry{
+				{
+					Manager:  hcoutil.HCOFieldManager,
+					Time:     &later,
+					FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:data": {"f:a": {}}}`)},
+				},
+				{
+					Manager:  "kubectl-edit",
+					Time:     &later,
+					FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:data": {"f:a": {}}}`)},
+				},
+				{
+					Manager:  "old-manager",
+					Time:     &earlier,
+					FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:data": {"f:a": {}}}`)},
+				},
+				{
+					Manager:  "other-manager",
+					Time:     &later,
+					FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:data": {"f:b/c": {}}}`)},
+				},
+			}
+
+			changes, err := Diff(observed, newConfigMap())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(changes).To(HaveLen(1))
+			Expect(changes[0].Manager).To(Equal("kubectl-edit"))
+		})
+	})
+
+	Context("NewReport", func() {
+		It("should identify the resource", func() {
+			observed := newConfigMap()
+			observed.Data["a"] = "5"
+
+			report, err := NewReport("ConfigMap", observed, newConfigMap())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(report.Kind).To(Equal("ConfigMap"))
+			Expect(report.Name).To(Equal("cm"))
+			Expect(report.Namespace).To(Equal("ns"))
+			Expect(report.Time.IsZero()).To(BeFalse())
+			Expect(report.Changes).To(HaveLen(1))
+		})
+	})
+
+	Context("Recorder", func() {
+		newReport := func(name string) hcov1beta1.DriftReport {
+			return hcov1beta1.DriftReport{Kind: "ConfigMap", Name: name}
+		}
+
+		getNames := func(reports []hcov1beta1.DriftReport) []string {
+			var names []string
+			for _, report := range reports {
+				names = append(names, report.Name)
+			}
+			return names
+		}
+
+		It("should list the reports, the oldest first", func() {
+			r := NewRecorder(3)
+			Expect(r.List()).To(BeEmpty())
+
+			r.Add(newReport("a"))
+			r.Add(newReport("b"))
+			Expect(getNames(r.List())).To(Equal([]string{"a", "b"}))
+		})
+
+		It("should drop the oldest reports when full", func() {
+			r := NewRecorder(3)
+			for _, name := range []string{"a", "b", "c", "d", "e"} {
+				r.Add(newReport(name))
+			}
+			Expect(getNames(r.List())).To(Equal([]string{"c", "d", "e"}))
+		})
+
+		It("should serve the reports as JSON", func() {
+			r := NewRecorder(3)
+			r.Add(newReport("a"))
+
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, httptest.NewRequest("GET", hcoutil.DriftReportEndpointName, nil))
+			Expect(rec.Code).To(Equal(200))
+			Expect(rec.Header().Get("Content-Type")).To(Equal("application/json"))
+
+			var reports []hcov1beta1.DriftReport
+			Expect(json.Unmarshal(rec.Body.Bytes(), &reports)).To(Succeed())
+			Expect(getNames(reports)).To(Equal([]string{"a"}))
+		})
+	})
+})
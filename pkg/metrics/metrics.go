@@ -1,17 +1,56 @@
 package metrics
 
 import (
+	"strings"
+	"time"
+
 	"github.com/prometheus/client_golang/prometheus"
 	dto "github.com/prometheus/client_model/go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
-	"strings"
 )
 
 const (
 	counterLabelCompName = "component_name"
 	counterLabelAnnName  = "annotation_name"
+	counterLabelPolicy   = "reconcile_policy"
+	counterLabelWrite    = "write_result"
+	counterLabelResult   = "result"
+	counterLabelCond     = "condition"
+	counterLabelStatus   = "status"
+	counterLabelTrigger  = "trigger_kind"
+	counterLabelExpected = "expected_version"
+	counterLabelObserved = "observed_version"
 )
 
+// The results of a write of a resource that HCO manages
+const (
+	// WriteSkipped means that the resource was not compared nor written, because neither the desired state nor the
+	// resource were modified since HCO last applied the desired state
+	WriteSkipped = "skipped"
+	// WriteUnchanged means that the resource was compared to its desired state, but it did not need to be written
+	WriteUnchanged = "unchanged"
+	// WritePerformed means that the resource was written
+	WritePerformed = "performed"
+)
+
+// The results of the ensure of an operand
+const (
+	EnsureCreated     = "created"
+	EnsureUpdated     = "updated"
+	EnsureOverwritten = "overwritten"
+	EnsureError       = "error"
+)
+
+// ComponentVersion is the observed and the expected versions of an operand
+type ComponentVersion struct {
+	Name     string
+	Expected string
+	Observed string
+}
+
+var conditionStatuses = []metav1.ConditionStatus{metav1.ConditionTrue, metav1.ConditionFalse, metav1.ConditionUnknown}
+
 // HcoMetrics wrapper for all hco metrics
 var HcoMetrics = hcoMetrics{
 	overwrittenModifications: prometheus.NewCounterVec(
@@ -28,6 +67,63 @@ var HcoMetrics = hcoMetrics{
 		},
 		[]string{counterLabelAnnName},
 	),
+	notEnforcedOperands: prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "kubevirt_hco_operand_not_enforced",
+			Help: "Indicates that HCO does not enforce the required state of an operand, due to its reconcile policy",
+		},
+		[]string{counterLabelCompName, counterLabelPolicy},
+	),
+	operandWrites: prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "kubevirt_hco_operand_writes_count",
+			Help: "Count of the reconciliations of the resources that HCO manages, by whether the write of the resource was skipped or performed",
+		},
+		[]string{counterLabelCompName, counterLabelWrite},
+	),
+	reconcileDuration: prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "kubevirt_hco_reconcile_duration_seconds",
+			Help:    "The duration of the reconciliations of the HyperConverged CR",
+			Buckets: prometheus.ExponentialBuckets(0.01, 2, 12),
+		},
+	),
+	ensureDuration: prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "kubevirt_hco_operand_ensure_duration_seconds",
+			Help:    "The duration of the reconciliation of each operand",
+			Buckets: prometheus.ExponentialBuckets(0.001, 2, 14),
+		},
+		[]string{counterLabelCompName},
+	),
+	ensureResults: prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "kubevirt_hco_operand_ensure_results_count",
+			Help: "Count of the operands that HCO created, updated, overwrote or failed to reconcile",
+		},
+		[]string{counterLabelCompName, counterLabelResult},
+	),
+	conditions: prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "kubevirt_hco_condition",
+			Help: "The conditions of the HyperConverged CR; 1 for the current status of each condition, and 0 for the other statuses",
+		},
+		[]string{counterLabelCond, counterLabelStatus},
+	),
+	reconcileTriggers: prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "kubevirt_hco_reconcile_trigger_count",
+			Help: "Count of the reconciliations, by the kind of the resource that triggered them",
+		},
+		[]string{counterLabelTrigger},
+	),
+	componentVersions: prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "kubevirt_hco_component_version_info",
+			Help: "The version of each operand, as observed in its CR, and the version that HCO ships",
+		},
+		[]string{counterLabelCompName, counterLabelExpected, counterLabelObserved},
+	),
 }
 
 // hcoMetrics holds all HCO metrics
@@ -37,6 +133,30 @@ type hcoMetrics struct {
 
 	// unsafeModifications counts the modifications done using the jsonpatch annotations
 	unsafeModifications *prometheus.GaugeVec
+
+	// notEnforcedOperands holds the operands with a reconcile policy other than Enforce
+	notEnforcedOperands *prometheus.GaugeVec
+
+	// operandWrites counts the reconciliations of the managed resources, by the result of the write of the resource
+	operandWrites *prometheus.CounterVec
+
+	// reconcileDuration holds the durations of the reconciliations
+	reconcileDuration prometheus.Histogram
+
+	// ensureDuration holds the durations of the reconciliations of the operands, by the operand
+	ensureDuration *prometheus.HistogramVec
+
+	// ensureResults counts the results of the reconciliations of the operands
+	ensureResults *prometheus.CounterVec
+
+	// conditions holds the statuses of the conditions of the HyperConverged CR
+	conditions *prometheus.GaugeVec
+
+	// reconcileTriggers counts the reconciliations by the kind of the resource that triggered them
+	reconcileTriggers *prometheus.CounterVec
+
+	// componentVersions holds the observed and the expected versions of the operands
+	componentVersions *prometheus.GaugeVec
 }
 
 func init() {
@@ -44,7 +164,18 @@ func init() {
 }
 
 func (hm *hcoMetrics) init() {
-	metrics.Registry.MustRegister(hm.overwrittenModifications, hm.unsafeModifications)
+	metrics.Registry.MustRegister(
+		hm.overwrittenModifications,
+		hm.unsafeModifications,
+		hm.notEnforcedOperands,
+		hm.operandWrites,
+		hm.reconcileDuration,
+		hm.ensureDuration,
+		hm.ensureResults,
+		hm.conditions,
+		hm.reconcileTriggers,
+		hm.componentVersions,
+	)
 }
 
 // IncOverwrittenModifications increments counter by 1
@@ -71,6 +202,121 @@ func (hm *hcoMetrics) GetUnsafeModificationsCount(unsafeAnnotation string) (floa
 	return m.Gauge.GetValue(), err
 }
 
+// SetNotEnforcedOperands sets the operands that are not enforced; policies maps the kind of each such operand to its
+// reconcile policy. The operands that are missing in policies are removed from the metric.
+func (hm *hcoMetrics) SetNotEnforcedOperands(policies map[string]string) {
+	hm.notEnforcedOperands.Reset()
+	for kind, policy := range policies {
+		hm.notEnforcedOperands.With(getLabelsForPolicy(kind, policy)).Set(1)
+	}
+}
+
+// GetNotEnforcedOperand returns the current value of the gauge. If error is not nil then value is undefined
+func (hm *hcoMetrics) GetNotEnforcedOperand(kind, policy string) (float64, error) {
+	var m = &dto.Metric{}
+	err := hm.notEnforcedOperands.With(getLabelsForPolicy(kind, policy)).Write(m)
+	return m.Gauge.GetValue(), err
+}
+
+// IncOperandWrites increments the counter of the write result of a managed resource by 1
+func (hm *hcoMetrics) IncOperandWrites(kind, result string) {
+	hm.operandWrites.With(getLabelsForWrite(kind, result)).Inc()
+}
+
+// GetOperandWritesCount returns current value of counter. If error is not nil then value is undefined
+func (hm *hcoMetrics) GetOperandWritesCount(kind, result string) (float64, error) {
+	var m = &dto.Metric{}
+	err := hm.operandWrites.With(getLabelsForWrite(kind, result)).Write(m)
+	return m.Counter.GetValue(), err
+}
+
+// ObserveReconcileDuration records the duration of a reconciliation
+func (hm *hcoMetrics) ObserveReconcileDuration(duration time.Duration) {
+	hm.reconcileDuration.Observe(duration.Seconds())
+}
+
+// GetReconcileDurationCount returns the number of the recorded reconciliations. If error is not nil then value is
+// undefined
+func (hm *hcoMetrics) GetReconcileDurationCount() (uint64, error) {
+	var m = &dto.Metric{}
+	err := hm.reconcileDuration.Write(m)
+	return m.Histogram.GetSampleCount(), err
+}
+
+// ObserveEnsureDuration records the duration of the reconciliation of an operand
+func (hm *hcoMetrics) ObserveEnsureDuration(kind string, duration time.Duration) {
+	hm.ensureDuration.With(getLabelsForComponent(kind)).Observe(duration.Seconds())
+}
+
+// GetEnsureDurationCount returns the number of the recorded reconciliations of an operand. If error is not nil then
+// value is undefined
+func (hm *hcoMetrics) GetEnsureDurationCount(kind string) (uint64, error) {
+	var m = &dto.Metric{}
+	err := hm.ensureDuration.With(getLabelsForComponent(kind)).(prometheus.Metric).Write(m)
+	return m.Histogram.GetSampleCount(), err
+}
+
+// IncEnsureResult increments the counter of a result of the reconciliation of an operand by 1
+func (hm *hcoMetrics) IncEnsureResult(kind, result string) {
+	hm.ensureResults.With(getLabelsForResult(kind, result)).Inc()
+}
+
+// GetEnsureResultCount returns current value of counter. If error is not nil then value is undefined
+func (hm *hcoMetrics) GetEnsureResultCount(kind, result string) (float64, error) {
+	var m = &dto.Metric{}
+	err := hm.ensureResults.With(getLabelsForResult(kind, result)).Write(m)
+	return m.Counter.GetValue(), err
+}
+
+// SetConditions sets the statuses of the conditions of the HyperConverged CR
+func (hm *hcoMetrics) SetConditions(conditions []metav1.Condition) {
+	hm.conditions.Reset()
+	for _, condition := range conditions {
+		for _, status := range conditionStatuses {
+			value := 0.0
+			if condition.Status == status {
+				value = 1
+			}
+			hm.conditions.With(getLabelsForCondition(condition.Type, status)).Set(value)
+		}
+	}
+}
+
+// GetCondition returns the current value of the gauge. If error is not nil then value is undefined
+func (hm *hcoMetrics) GetCondition(conditionType string, status metav1.ConditionStatus) (float64, error) {
+	var m = &dto.Metric{}
+	err := hm.conditions.With(getLabelsForCondition(conditionType, status)).Write(m)
+	return m.Gauge.GetValue(), err
+}
+
+// IncReconcileTrigger increments the counter of the reconciliations that were triggered by a resource of the kind by 1
+func (hm *hcoMetrics) IncReconcileTrigger(kind string) {
+	hm.reconcileTriggers.With(getLabelsForTrigger(kind)).Inc()
+}
+
+// GetReconcileTriggerCount returns current value of counter. If error is not nil then value is undefined
+func (hm *hcoMetrics) GetReconcileTriggerCount(kind string) (float64, error) {
+	var m = &dto.Metric{}
+	err := hm.reconcileTriggers.With(getLabelsForTrigger(kind)).Write(m)
+	return m.Counter.GetValue(), err
+}
+
+// SetComponentVersions sets the versions of the operands. The operands that are missing in versions are removed from
+// the metric.
+func (hm *hcoMetrics) SetComponentVersions(versions []ComponentVersion) {
+	hm.componentVersions.Reset()
+	for _, version := range versions {
+		hm.componentVersions.With(getLabelsForVersion(version)).Set(1)
+	}
+}
+
+// GetComponentVersion returns the current value of the gauge. If error is not nil then value is undefined
+func (hm *hcoMetrics) GetComponentVersion(version ComponentVersion) (float64, error) {
+	var m = &dto.Metric{}
+	err := hm.componentVersions.With(getLabelsForVersion(version)).Write(m)
+	return m.Gauge.GetValue(), err
+}
+
 func getLabelsForObj(kind string, name string) prometheus.Labels {
 	return prometheus.Labels{counterLabelCompName: strings.ToLower(kind + "/" + name)}
 }
@@ -78,3 +324,35 @@ func getLabelsForObj(kind string, name string) prometheus.Labels {
 func getLabelsForUnsafeAnnotation(unsafeAnnotation string) prometheus.Labels {
 	return prometheus.Labels{counterLabelAnnName: strings.ToLower(unsafeAnnotation)}
 }
+
+func getLabelsForPolicy(kind string, policy string) prometheus.Labels {
+	return prometheus.Labels{counterLabelCompName: strings.ToLower(kind), counterLabelPolicy: policy}
+}
+
+func getLabelsForWrite(kind string, result string) prometheus.Labels {
+	return prometheus.Labels{counterLabelCompName: strings.ToLower(kind), counterLabelWrite: result}
+}
+
+func getLabelsForComponent(kind string) prometheus.Labels {
+	return prometheus.Labels{counterLabelCompName: strings.ToLower(kind)}
+}
+
+func getLabelsForResult(kind string, result string) prometheus.Labels {
+	return prometheus.Labels{counterLabelCompName: strings.ToLower(kind), counterLabelResult: result}
+}
+
+func getLabelsForCondition(conditionType string, status metav1.ConditionStatus) prometheus.Labels {
+	return prometheus.Labels{counterLabelCond: conditionType, counterLabelStatus: string(status)}
+}
+
+func getLabelsForTrigger(kind string) prometheus.Labels {
+	return prometheus.Labels{counterLabelTrigger: strings.ToLower(kind)}
+}
+
+func getLabelsForVersion(version ComponentVersion) prometheus.Labels {
+	return prometheus.Labels{
+		counterLabelCompName: strings.ToLower(version.Name),
+		counterLabelExpected: version.Expected,
+		counterLabelObserved: version.Observed,
+	}
+}
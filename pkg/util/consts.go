@@ -14,8 +14,11 @@ const (
 	SspVersionEnvV         = "SSP_VERSION"
 	NmoVersionEnvV         = "NMO_VERSION"
 	HppoVersionEnvV        = "HPPO_VERSION"
+	ReconcileDeadlineEnv   = "RECONCILE_DEADLINE"
 	HcoValidatingWebhook   = "validate-hco.kubevirt.io"
+	HcoMutatingWebhook     = "mutate-hco.kubevirt.io"
 	HcoMutatingWebhookNS   = "mutate-ns-hco.kubevirt.io"
+	HcoConversionWebhook   = "convert-hco.kubevirt.io"
 	AppLabel               = "app"
 	UndefinedNamespace     = ""
 	OpenshiftNamespace     = "openshift"
@@ -23,7 +26,9 @@ const (
 	OperatorHubNamespace   = "operators"
 	APIVersionAlpha        = "v1alpha1"
 	APIVersionBeta         = "v1beta1"
+	APIVersionV1           = "v1"
 	CurrentAPIVersion      = APIVersionBeta
+	StorageAPIVersion      = APIVersionV1
 	APIVersionGroup        = "hco.kubevirt.io"
 	APIVersion             = APIVersionGroup + "/" + CurrentAPIVersion
 	HyperConvergedKind     = "HyperConverged"
@@ -38,6 +43,18 @@ const (
 	OperatorName = "hco-operator"
 	// Value for "part-of" label
 	HyperConvergedCluster = "hyperconverged-cluster"
+	// The field manager of the fields that HCO sets in the operand CRs, using server-side apply
+	HCOFieldManager = "hyperconverged-cluster-operator"
+	// The annotation of the Overwritten events, that holds the drift report of the overwritten resource
+	DriftReportAnnotation = "hco.kubevirt.io/driftReport"
+	// The annotation of the managed resources, that holds the hash of the desired state that HCO last applied
+	DesiredStateHashAnnotation = "hco.kubevirt.io/desiredStateHash"
+	// The label of the component operator deployments, that holds the version of the operator. HCO reads the version of
+	// the node maintenance operator, that has no operand CR, from it.
+	OperatorVersionLabel = "hco.kubevirt.io/operatorVersion"
+	// The annotation of the HyperConverged CR, that forces a workloads placement change while there are workloads on
+	// the nodes that are removed from the placement, when its value is "true". HCO removes it after the change.
+	ForceWorkloadsPlacementChangeAnnotation = "hco.kubevirt.io/forceWorkloadsPlacementChange"
 
 	// HyperConvergedName is the name of the HyperConverged resource that will be reconciled
 	HyperConvergedName          = "kubevirt-hyperconverged"
@@ -47,9 +64,11 @@ const (
 	HealthProbePort       int32 = 6060
 	ReadinessEndpointName       = "/readyz"
 	LivenessEndpointName        = "/livez"
+	DriftReportEndpointName     = "/debug/drift"
 	HCOWebhookPath              = "/validate-hco-kubevirt-io-v1beta1-hyperconverged"
 	HCONSWebhookPath            = "/mutate-ns-hco-kubevirt-io"
 	DefaulterWebhookPath        = "/mutate-hco-kubevirt-io-v1beta1-hyperconverged"
+	ConversionWebhookPath       = "/convert"
 	WebhookPort                 = 4343
 
 	WebhookCertName       = "apiserver.crt"
@@ -22,6 +22,7 @@ func GetEventEmitter() EventEmitter {
 type EventEmitter interface {
 	Init(ctx context.Context, cl client.Client, recorder record.EventRecorder, logger logr.Logger)
 	EmitEvent(object runtime.Object, eventType, reason, msg string)
+	EmitAnnotatedEvent(object runtime.Object, annotations map[string]string, eventType, reason, msg string)
 }
 
 type eventEmitter struct {
@@ -49,6 +50,20 @@ func (ee eventEmitter) EmitEvent(object runtime.Object, eventType, reason, msg s
 	}
 }
 
+func (ee eventEmitter) EmitAnnotatedEvent(object runtime.Object, annotations map[string]string, eventType, reason, msg string) {
+	if ee.pod != nil {
+		ee.recorder.AnnotatedEventf(ee.pod, annotations, eventType, reason, msg)
+	}
+
+	if object != nil {
+		ee.recorder.AnnotatedEventf(object, annotations, eventType, reason, msg)
+	}
+
+	if ee.csv != nil {
+		ee.recorder.AnnotatedEventf(ee.csv, annotations, eventType, reason, msg)
+	}
+}
+
 func (ee *eventEmitter) getResource(ctx context.Context, cl client.Reader, logger logr.Logger) {
 	if !GetClusterInfo().IsRunningLocally() {
 		var err error
@@ -0,0 +1,175 @@
+package util
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultReconcileDeadline is the maximum duration of a reconciliation, if not set by the RECONCILE_DEADLINE
+	// environment variable. A reconciliation that takes longer, is considered as stuck.
+	DefaultReconcileDeadline = 10 * time.Minute
+	// reconcileFailureGracePeriod is the duration that the reconciliation may fail, before the operator is not ready;
+	// a single failure is usually resolved in the next reconciliation.
+	reconcileFailureGracePeriod = time.Minute
+	// cacheSyncTimeout is the maximum time that the readiness check waits for the informer caches to be synced
+	cacheSyncTimeout = time.Second
+)
+
+var healthChecker = NewHealthChecker(DefaultReconcileDeadline)
+
+// GetHealthChecker returns the HealthChecker of the operator
+func GetHealthChecker() *HealthChecker {
+	return healthChecker
+}
+
+// GetReconcileDeadlineFromEnv returns the maximum duration of a reconciliation, as set by the RECONCILE_DEADLINE
+// environment variable (e.g. "15m"), or the default deadline if the variable is not set.
+func GetReconcileDeadlineFromEnv() (time.Duration, error) {
+	value, ok := os.LookupEnv(ReconcileDeadlineEnv)
+	if !ok || value == "" {
+		return DefaultReconcileDeadline, nil
+	}
+
+	deadline, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("can't parse %s: %w", ReconcileDeadlineEnv, err)
+	}
+	if deadline <= 0 {
+		return 0, fmt.Errorf("%s must be positive; got %s", ReconcileDeadlineEnv, value)
+	}
+	return deadline, nil
+}
+
+// HealthChecker tracks the state of the operator, as reported by its readiness and liveness probes. The operator is
+// ready when the cluster info was detected, the informer caches are synced, and the reconciliation does not keep
+// failing. The operator is live as long as a reconciliation is not stuck for longer than the reconcile deadline.
+type HealthChecker struct {
+	lock sync.Mutex
+
+	cacheSynced         func(ctx context.Context) bool
+	clusterInfoDetected bool
+	clusterInfoErr      error
+
+	reconcileDeadline time.Duration
+	// the start time of the current reconciliation; zero if there is no reconciliation in progress
+	reconcileStarted time.Time
+	// the time of the first of the consecutive failed reconciliations; zero if the last reconciliation succeeded
+	failingSince     time.Time
+	lastReconcileErr error
+
+	now func() time.Time
+}
+
+// NewHealthChecker creates a HealthChecker with the required reconcile deadline
+func NewHealthChecker(reconcileDeadline time.Duration) *HealthChecker {
+	return &HealthChecker{
+		reconcileDeadline: reconcileDeadline,
+		now:               time.Now,
+	}
+}
+
+// SetReconcileDeadline sets the maximum duration of a reconciliation
+func (hc *HealthChecker) SetReconcileDeadline(reconcileDeadline time.Duration) {
+	hc.lock.Lock()
+	defer hc.lock.Unlock()
+
+	hc.reconcileDeadline = reconcileDeadline
+}
+
+// SetCacheSyncCheck sets the function that checks if the informer caches are synced, e.g. the WaitForCacheSync
+// method of the cache of the manager
+func (hc *HealthChecker) SetCacheSyncCheck(cacheSynced func(ctx context.Context) bool) {
+	hc.lock.Lock()
+	defer hc.lock.Unlock()
+
+	hc.cacheSynced = cacheSynced
+}
+
+// SetClusterInfoDetected records the result of the detection of the cluster info
+func (hc *HealthChecker) SetClusterInfoDetected(err error) {
+	hc.lock.Lock()
+	defer hc.lock.Unlock()
+
+	hc.clusterInfoDetected = true
+	hc.clusterInfoErr = err
+}
+
+// ReconcileStarted records the start of a reconciliation
+func (hc *HealthChecker) ReconcileStarted() {
+	hc.lock.Lock()
+	defer hc.lock.Unlock()
+
+	hc.reconcileStarted = hc.now()
+}
+
+// ReconcileCompleted records the end of a reconciliation, and its outcome
+func (hc *HealthChecker) ReconcileCompleted(err error) {
+	hc.lock.Lock()
+	defer hc.lock.Unlock()
+
+	hc.reconcileStarted = time.Time{}
+	hc.lastReconcileErr = err
+	if err == nil {
+		hc.failingSince = time.Time{}
+	} else if hc.failingSince.IsZero() {
+		hc.failingSince = hc.now()
+	}
+}
+
+// ReadyCheck is the readiness check of the operator
+func (hc *HealthChecker) ReadyCheck(req *http.Request) error {
+	hc.lock.Lock()
+	cacheSynced := hc.cacheSynced
+	clusterInfoDetected, clusterInfoErr := hc.clusterInfoDetected, hc.clusterInfoErr
+	failingSince, lastReconcileErr := hc.failingSince, hc.lastReconcileErr
+	now := hc.now()
+	hc.lock.Unlock()
+
+	if !clusterInfoDetected {
+		return errors.New("the cluster info was not detected yet")
+	}
+	if clusterInfoErr != nil {
+		return fmt.Errorf("failed to detect the cluster info: %w", clusterInfoErr)
+	}
+
+	if cacheSynced == nil {
+		return errors.New("the informer caches are not started yet")
+	}
+	ctx := context.Background()
+	if req != nil {
+		ctx = req.Context()
+	}
+	ctx, cancel := context.WithTimeout(ctx, cacheSyncTimeout)
+	defer cancel()
+	if !cacheSynced(ctx) {
+		return errors.New("the informer caches are not synced yet")
+	}
+
+	if !failingSince.IsZero() && now.Sub(failingSince) > reconcileFailureGracePeriod {
+		return fmt.Errorf("the reconciliation keeps failing since %s: %w", failingSince.Format(time.RFC3339), lastReconcileErr)
+	}
+
+	return nil
+}
+
+// LiveCheck is the liveness check of the operator
+func (hc *HealthChecker) LiveCheck(_ *http.Request) error {
+	hc.lock.Lock()
+	defer hc.lock.Unlock()
+
+	if hc.reconcileStarted.IsZero() {
+		return nil
+	}
+
+	if duration := hc.now().Sub(hc.reconcileStarted); duration > hc.reconcileDeadline {
+		return fmt.Errorf("the reconciliation is running for %s; longer than the deadline of %s", duration.Round(time.Second), hc.reconcileDeadline)
+	}
+
+	return nil
+}
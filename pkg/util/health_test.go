@@ -0,0 +1,145 @@
+package util
+
+import (
+	"context"
+	"errors"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("test the health checker", func() {
+	var (
+		hc  *HealthChecker
+		now time.Time
+	)
+
+	synced := func(_ context.Context) bool { return true }
+
+	BeforeEach(func() {
+		now = time.Now()
+		hc = NewHealthChecker(5 * time.Minute)
+		hc.now = func() time.Time { return now }
+	})
+
+	Context("ReadyCheck", func() {
+		BeforeEach(func() {
+			hc.SetClusterInfoDetected(nil)
+			hc.SetCacheSyncCheck(synced)
+		})
+
+		It("should be ready if the cluster info was detected, and the caches are synced", func() {
+			Expect(hc.ReadyCheck(nil)).To(Succeed())
+		})
+
+		It("should not be ready before the cluster info was detected", func() {
+			hc = NewHealthChecker(5 * time.Minute)
+			hc.SetCacheSyncCheck(synced)
+			Expect(hc.ReadyCheck(nil)).To(MatchError("the cluster info was not detected yet"))
+		})
+
+		It("should not be ready if the detection of the cluster info failed", func() {
+			hc.SetClusterInfoDetected(errors.New("fake error"))
+			Expect(hc.ReadyCheck(nil)).To(MatchError("failed to detect the cluster info: fake error"))
+		})
+
+		It("should not be ready before the caches are started", func() {
+			hc.SetCacheSyncCheck(nil)
+			Expect(hc.ReadyCheck(nil)).To(MatchError("the informer caches are not started yet"))
+		})
+
+		It("should not be ready if the caches are not synced", func() {
+			hc.SetCacheSyncCheck(func(ctx context.Context) bool {
+				<-ctx.Done()
+				return false
+			})
+			Expect(hc.ReadyCheck(nil)).To(MatchError("the informer caches are not synced yet"))
+		})
+
+		It("should stay ready if the reconciliation failed only recently", func() {
+			hc.ReconcileStarted()
+			hc.ReconcileCompleted(errors.New("fake error"))
+
+			now = now.Add(reconcileFailureGracePeriod)
+			hc.ReconcileStarted()
+			hc.ReconcileCompleted(errors.New("fake error"))
+			Expect(hc.ReadyCheck(nil)).To(Succeed())
+		})
+
+		It("should not be ready if the reconciliation keeps failing", func() {
+			hc.ReconcileStarted()
+			hc.ReconcileCompleted(errors.New("first error"))
+
+			now = now.Add(reconcileFailureGracePeriod + time.Second)
+			hc.ReconcileStarted()
+			hc.ReconcileCompleted(errors.New("second error"))
+
+			err := hc.ReadyCheck(nil)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("the reconciliation keeps failing since"))
+			Expect(err.Error()).To(HaveSuffix("second error"))
+		})
+
+		It("should be ready again after a successful reconciliation", func() {
+			hc.ReconcileStarted()
+			hc.ReconcileCompleted(errors.New("fake error"))
+
+			now = now.Add(reconcileFailureGracePeriod + time.Second)
+			Expect(hc.ReadyCheck(nil)).ToNot(Succeed())
+
+			hc.ReconcileStarted()
+			hc.ReconcileCompleted(nil)
+			Expect(hc.ReadyCheck(nil)).To(Succeed())
+		})
+	})
+
+	Context("LiveCheck", func() {
+		It("should be live if there is no reconciliation in progress", func() {
+			Expect(hc.LiveCheck(nil)).To(Succeed())
+
+			hc.ReconcileStarted()
+			now = now.Add(time.Hour)
+			hc.ReconcileCompleted(nil)
+			Expect(hc.LiveCheck(nil)).To(Succeed())
+		})
+
+		It("should be live if the reconciliation in progress did not pass the deadline", func() {
+			hc.ReconcileStarted()
+			now = now.Add(5 * time.Minute)
+			Expect(hc.LiveCheck(nil)).To(Succeed())
+		})
+
+		It("should not be live if the reconciliation in progress passed the deadline", func() {
+			hc.ReconcileStarted()
+			now = now.Add(6 * time.Minute)
+			Expect(hc.LiveCheck(nil)).To(MatchError("the reconciliation is running for 6m0s; longer than the deadline of 5m0s"))
+		})
+	})
+
+	Context("GetReconcileDeadlineFromEnv", func() {
+		AfterEach(func() {
+			_ = os.Unsetenv(ReconcileDeadlineEnv)
+		})
+
+		It("should return the default deadline if the variable is not set", func() {
+			Expect(GetReconcileDeadlineFromEnv()).To(Equal(DefaultReconcileDeadline))
+		})
+
+		It("should return the deadline from the variable", func() {
+			_ = os.Setenv(ReconcileDeadlineEnv, "15m")
+			Expect(GetReconcileDeadlineFromEnv()).To(Equal(15 * time.Minute))
+		})
+
+		It("should reject an invalid deadline", func() {
+			_ = os.Setenv(ReconcileDeadlineEnv, "soon")
+			_, err := GetReconcileDeadlineFromEnv()
+			Expect(err).To(HaveOccurred())
+
+			_ = os.Setenv(ReconcileDeadlineEnv, "-1m")
+			_, err = GetReconcileDeadlineFromEnv()
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -8,6 +8,7 @@ import (
 	"io/ioutil"
 	"os"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -332,9 +333,15 @@ func ContainsString(s []string, word string) bool {
 	return false
 }
 
-var hcoKvIoVersion string
+var (
+	hcoKvIoVersion     string
+	hcoKvIoVersionLock sync.Mutex
+)
 
 func GetHcoKvIoVersion() string {
+	hcoKvIoVersionLock.Lock()
+	defer hcoKvIoVersionLock.Unlock()
+
 	if hcoKvIoVersion == "" {
 		hcoKvIoVersion = os.Getenv(HcoKvIoVersionName)
 	}
@@ -43,7 +43,7 @@ func SetupWebhookWithManager(ctx context.Context, mgr ctrl.Manager, isOpenshift
 		return nserr
 	}
 
-	whHandler := validator.NewWebhookHandler(logger, mgr.GetClient(), operatorNsEnv, isOpenshift)
+	whHandler := validator.NewWebhookHandler(logger, mgr.GetClient(), mgr.GetAPIReader(), operatorNsEnv, isOpenshift)
 	hcov1beta1.SetValidatorWebhookHandler(whHandler)
 
 	nsMutator := mutator.NewNsMutator(mgr.GetClient(), operatorNsEnv)
@@ -70,6 +70,9 @@ func SetupWebhookWithManager(ctx context.Context, mgr ctrl.Manager, isOpenshift
 	srv.KeyName = hcoutil.WebhookKeyName
 	srv.Port = hcoutil.WebhookPort
 	srv.Register(hcoutil.HCONSWebhookPath, &webhook.Admission{Handler: nsMutator})
+	// registered before the builder, so the builder does not register the default validating handler, that can't return
+	// admission warnings
+	srv.Register(hcoutil.HCOWebhookPath, &webhook.Admission{Handler: validator.NewValidatingHandler(whHandler)})
 
 	return bldr.Complete()
 }
@@ -0,0 +1,104 @@
+package validator
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1beta1"
+)
+
+var _ admission.Handler = &ValidatingHandler{}
+
+// ValidatingHandler is the admission handler of the HyperConverged validating webhook. It validates the requests by
+// the WebhookHandler, like the webhook.Validator implementation of the HyperConverged type, and adds admission warnings
+// for the settings that are valid, but risky or deprecated.
+type ValidatingHandler struct {
+	wh      *WebhookHandler
+	decoder *admission.Decoder
+}
+
+func NewValidatingHandler(wh *WebhookHandler) *ValidatingHandler {
+	return &ValidatingHandler{wh: wh}
+}
+
+// InjectDecoder injects the decoder.
+func (h *ValidatingHandler) InjectDecoder(d *admission.Decoder) error {
+	h.decoder = d
+	return nil
+}
+
+func (h *ValidatingHandler) Handle(ctx context.Context, req admission.Request) admission.Response {
+	switch req.Operation {
+	case admissionv1.Create:
+		hc := &v1beta1.HyperConverged{}
+		if err := h.decoder.Decode(req, hc); err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+
+		if err := h.wh.ValidateCreate(hc); err != nil {
+			return denied(err)
+		}
+
+		return admission.Allowed("").WithWarnings(h.wh.getWarnings(ctx, getRequestVersion(req), hc, nil)...)
+
+	case admissionv1.Update:
+		hc := &v1beta1.HyperConverged{}
+		if err := h.decoder.Decode(req, hc); err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+
+		exists := &v1beta1.HyperConverged{}
+		if err := h.decoder.DecodeRaw(req.OldObject, exists); err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+
+		if err := h.wh.ValidateUpdate(hc, exists); err != nil {
+			return denied(err)
+		}
+
+		return admission.Allowed("").WithWarnings(h.wh.getWarnings(ctx, getRequestVersion(req), hc, exists)...)
+
+	case admissionv1.Delete:
+		// OldObject contains the object being deleted
+		hc := &v1beta1.HyperConverged{}
+		if err := h.decoder.DecodeRaw(req.OldObject, hc); err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+
+		if err := h.wh.ValidateDelete(hc); err != nil {
+			return denied(err)
+		}
+	}
+
+	return admission.Allowed("")
+}
+
+// denied returns the status of an API error, such as the Invalid error with the field errors, as is
+func denied(err error) admission.Response {
+	var apiStatus apierrors.APIStatus
+	if errors.As(err, &apiStatus) {
+		status := apiStatus.Status()
+		return admission.Response{
+			AdmissionResponse: admissionv1.AdmissionResponse{
+				Allowed: false,
+				Result:  &status,
+			},
+		}
+	}
+
+	return admission.Denied(err.Error())
+}
+
+// getRequestVersion returns the API version of the request, before the API server converted it to the API version of
+// the webhook
+func getRequestVersion(req admission.Request) string {
+	if req.RequestKind != nil {
+		return req.RequestKind.Version
+	}
+	return req.Kind.Version
+}
@@ -0,0 +1,382 @@
+package validator
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	kubevirtv1 "kubevirt.io/client-go/api/v1"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1beta1"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+var (
+	// pciDeviceSelectorRegex matches a vendor_id:product_id combination; e.g. 10de:1eb8
+	pciDeviceSelectorRegex = regexp.MustCompile(`^[0-9a-fA-F]{4}:[0-9a-fA-F]{4}$`)
+
+	// cpuModelRegex matches a CPU model name, as listed by libvirt; e.g. Haswell-noTSX-IBRS or Opteron_G2
+	cpuModelRegex = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9._-]*$`)
+
+	// registryHostRegex matches a registry host, with an optional port; the host is a DNS name, an IPv4 address or an
+	// IPv6 address in square brackets
+	registryHostRegex = regexp.MustCompile(
+		`^(?:(?:[a-zA-Z0-9]|[a-zA-Z0-9][a-zA-Z0-9-]*[a-zA-Z0-9])(?:\.(?:[a-zA-Z0-9]|[a-zA-Z0-9][a-zA-Z0-9-]*[a-zA-Z0-9]))*|\[[0-9a-fA-F:.]+\])` +
+			`(?::[0-9]{1,5})?$`,
+	)
+
+	validWorkloadUpdateMethods = []string{
+		string(kubevirtv1.WorkloadUpdateMethodLiveMigrate),
+		string(kubevirtv1.WorkloadUpdateMethodEvict),
+	}
+
+	hyperConvergedGroupKind = schema.GroupKind{Group: hcoutil.APIVersionGroup, Kind: hcoutil.HyperConvergedKind}
+)
+
+// validateSpec validates the semantics of the HyperConverged spec fields, that the CRD schema can't validate. All the
+// field errors are returned together, as an Invalid error.
+//
+// On update, exists is the current HyperConverged; an error that the current spec already has is not reported, so
+// CRs that hold values that were accepted before these checks can still be updated, as long as these fields are not
+// changed. On create, exists is nil.
+func validateSpec(hc *v1beta1.HyperConverged, exists *v1beta1.HyperConverged) error {
+	errs := validateSpecFields(hc)
+	if exists != nil {
+		errs = ratchetErrors(errs, validateSpecFields(exists))
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return apierrors.NewInvalid(hyperConvergedGroupKind, hc.Name, errs)
+}
+
+func validateSpecFields(hc *v1beta1.HyperConverged) field.ErrorList {
+	specPath := field.NewPath("spec")
+
+	var errs field.ErrorList
+	errs = append(errs, validateCertConfig(hc.Spec.CertConfig, specPath.Child("certConfig"))...)
+	errs = append(errs, validateVddkInitImage(hc.Spec.VddkInitImage, specPath.Child("vddkInitImage"))...)
+	errs = append(errs, validateWorkloadUpdateStrategy(hc.Spec.WorkloadUpdateStrategy, specPath.Child("workloadUpdateStrategy"))...)
+	errs = append(errs, validateDataImportCronTemplates(hc, specPath.Child("dataImportCronTemplates"))...)
+	errs = append(errs, validatePermittedHostDevices(hc.Spec.PermittedHostDevices, specPath.Child("permittedHostDevices"))...)
+	errs = append(errs, validateObsoleteCPUs(hc.Spec.ObsoleteCPUs, specPath.Child("obsoleteCPUs"))...)
+	errs = append(errs, validateStorageImport(hc.Spec.StorageImport, specPath.Child("storageImport"))...)
+
+	return errs
+}
+
+// ratchetErrors drops the errors that are also in the existing errors; i.e. the same error, for the same value, in the
+// same field
+func ratchetErrors(errs field.ErrorList, existingErrs field.ErrorList) field.ErrorList {
+	if len(errs) == 0 || len(existingErrs) == 0 {
+		return errs
+	}
+
+	existing := sets.NewString()
+	for _, err := range existingErrs {
+		existing.Insert(err.Error())
+	}
+
+	var newErrs field.ErrorList
+	for _, err := range errs {
+		if !existing.Has(err.Error()) {
+			newErrs = append(newErrs, err)
+		}
+	}
+
+	return newErrs
+}
+
+// validateCertConfig rejects certificate durations that are too short, and certificates that would be renewed before
+// they are issued, or that would outlive their CA
+func validateCertConfig(certConfig v1beta1.HyperConvergedCertConfig, fldPath *field.Path) field.ErrorList {
+	const minimalDuration = 10 * time.Minute
+
+	caPath := fldPath.Child("ca")
+	serverPath := fldPath.Child("server")
+
+	var errs field.ErrorList
+	for _, cc := range []struct {
+		path     *field.Path
+		duration time.Duration
+	}{
+		{path: caPath.Child("duration"), duration: certConfig.CA.Duration.Duration},
+		{path: caPath.Child("renewBefore"), duration: certConfig.CA.RenewBefore.Duration},
+		{path: serverPath.Child("duration"), duration: certConfig.Server.Duration.Duration},
+		{path: serverPath.Child("renewBefore"), duration: certConfig.Server.RenewBefore.Duration},
+	} {
+		if cc.duration < minimalDuration {
+			errs = append(errs, field.Invalid(cc.path, cc.duration.String(), fmt.Sprintf("value is too small; must be at least %s", minimalDuration)))
+		}
+	}
+
+	if certConfig.CA.Duration.Duration < certConfig.CA.RenewBefore.Duration {
+		errs = append(errs, field.Invalid(caPath.Child("renewBefore"), certConfig.CA.RenewBefore.Duration.String(),
+			fmt.Sprintf("must not be greater than %s", caPath.Child("duration"))))
+	}
+
+	if certConfig.Server.Duration.Duration < certConfig.Server.RenewBefore.Duration {
+		errs = append(errs, field.Invalid(serverPath.Child("renewBefore"), certConfig.Server.RenewBefore.Duration.String(),
+			fmt.Sprintf("must not be greater than %s", serverPath.Child("duration"))))
+	}
+
+	if certConfig.CA.Duration.Duration < certConfig.Server.Duration.Duration {
+		errs = append(errs, field.Invalid(serverPath.Child("duration"), certConfig.Server.Duration.Duration.String(),
+			fmt.Sprintf("must not be greater than %s", caPath.Child("duration"))))
+	}
+
+	return errs
+}
+
+// validateVddkInitImage rejects a VDDK init image that is not a valid image reference, so it won't fail the imports
+// from VMware only when they are started
+func validateVddkInitImage(image *string, fldPath *field.Path) field.ErrorList {
+	if image == nil || *image == "" {
+		return nil
+	}
+
+	if !imageReferenceRegex.MatchString(*image) {
+		return field.ErrorList{field.Invalid(fldPath, *image, "is not a valid image reference")}
+	}
+
+	return nil
+}
+
+func validateWorkloadUpdateStrategy(strategy *v1beta1.HyperConvergedWorkloadUpdateStrategy, fldPath *field.Path) field.ErrorList {
+	if strategy == nil {
+		return nil
+	}
+
+	var errs field.ErrorList
+	supported := sets.NewString(validWorkloadUpdateMethods...)
+	methods := sets.NewString()
+	for i, method := range strategy.WorkloadUpdateMethods {
+		methodPath := fldPath.Child("workloadUpdateMethods").Index(i)
+		if !supported.Has(method) {
+			errs = append(errs, field.NotSupported(methodPath, method, validWorkloadUpdateMethods))
+		} else if methods.Has(method) {
+			errs = append(errs, field.Duplicate(methodPath, method))
+		}
+		methods.Insert(method)
+	}
+
+	if strategy.BatchEvictionSize != nil && *strategy.BatchEvictionSize <= 0 {
+		errs = append(errs, field.Invalid(fldPath.Child("batchEvictionSize"), *strategy.BatchEvictionSize, "must be greater than 0"))
+	}
+
+	return errs
+}
+
+func validateDataImportCronTemplates(hc *v1beta1.HyperConverged, fldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	names := sets.NewString()
+	for i, template := range hc.Spec.DataImportCronTemplates {
+		templatePath := fldPath.Index(i)
+
+		namePath := templatePath.Child("metadata", "name")
+		if template.Name == "" {
+			errs = append(errs, field.Required(namePath, ""))
+		} else if names.Has(template.Name) {
+			errs = append(errs, field.Duplicate(namePath, template.Name))
+		}
+		names.Insert(template.Name)
+
+		schedulePath := templatePath.Child("spec", "schedule")
+		if template.Spec.Schedule == "" {
+			errs = append(errs, field.Required(schedulePath, ""))
+		} else if err := validateCronSchedule(template.Spec.Schedule); err != nil {
+			errs = append(errs, field.Invalid(schedulePath, template.Spec.Schedule, err.Error()))
+		}
+	}
+
+	return errs
+}
+
+func validatePermittedHostDevices(devices *v1beta1.PermittedHostDevices, fldPath *field.Path) field.ErrorList {
+	if devices == nil {
+		return nil
+	}
+
+	var errs field.ErrorList
+	resourceNames := sets.NewString()
+	for i, device := range devices.PciHostDevices {
+		devicePath := fldPath.Child("pciHostDevices").Index(i)
+
+		if !pciDeviceSelectorRegex.MatchString(device.PCIDeviceSelector) {
+			errs = append(errs, field.Invalid(devicePath.Child("pciDeviceSelector"), device.PCIDeviceSelector, "must be in the vendor_id:product_id format; e.g. 10de:1eb8"))
+		}
+
+		resourceNamePath := devicePath.Child("resourceName")
+		if device.ResourceName == "" {
+			errs = append(errs, field.Required(resourceNamePath, ""))
+		} else if resourceNames.Has(device.ResourceName) {
+			errs = append(errs, field.Duplicate(resourceNamePath, device.ResourceName))
+		}
+		resourceNames.Insert(device.ResourceName)
+	}
+
+	return errs
+}
+
+func validateObsoleteCPUs(obsoleteCPUs *v1beta1.HyperConvergedObsoleteCPUs, fldPath *field.Path) field.ErrorList {
+	if obsoleteCPUs == nil {
+		return nil
+	}
+
+	var errs field.ErrorList
+	if obsoleteCPUs.MinCPUModel != "" && !cpuModelRegex.MatchString(obsoleteCPUs.MinCPUModel) {
+		errs = append(errs, field.Invalid(fldPath.Child("minCPUModel"), obsoleteCPUs.MinCPUModel, "is not a valid CPU model name"))
+	}
+
+	for i, model := range obsoleteCPUs.CPUModels {
+		if !cpuModelRegex.MatchString(model) {
+			errs = append(errs, field.Invalid(fldPath.Child("cpuModels").Index(i), model, "is not a valid CPU model name"))
+		}
+	}
+
+	return errs
+}
+
+func validateStorageImport(storageImport *v1beta1.StorageImportConfig, fldPath *field.Path) field.ErrorList {
+	if storageImport == nil {
+		return nil
+	}
+
+	var errs field.ErrorList
+	for i, registry := range storageImport.InsecureRegistries {
+		if !isValidRegistryHost(registry) {
+			errs = append(errs, field.Invalid(fldPath.Child("insecureRegistries").Index(i), registry, "must be a registry host, with an optional port; e.g. registry.example.com:5000"))
+		}
+	}
+
+	return errs
+}
+
+func isValidRegistryHost(registry string) bool {
+	if !registryHostRegex.MatchString(registry) {
+		return false
+	}
+
+	if i := strings.LastIndex(registry, ":"); i > strings.LastIndex(registry, "]") {
+		port, err := strconv.Atoi(registry[i+1:])
+		return err == nil && port > 0 && port <= 65535
+	}
+
+	return true
+}
+
+// cronField is a field of a cron schedule, with its range of values, and the names that may replace the values
+type cronField struct {
+	name     string
+	min, max int
+	names    map[string]int
+	// any allows '?' instead of '*'
+	any bool
+}
+
+var cronFields = []cronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31, any: true},
+	{name: "month", min: 1, max: 12, names: map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}},
+	{name: "day of week", min: 0, max: 6, any: true, names: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}},
+}
+
+var cronDescriptors = sets.NewString("@yearly", "@annually", "@monthly", "@weekly", "@daily", "@midnight", "@hourly")
+
+// validateCronSchedule validates a schedule in the standard cron format, as CDI parses the schedule of a
+// DataImportCron; that is, five fields, or a descriptor such as @daily or @every 1h
+func validateCronSchedule(schedule string) error {
+	if strings.HasPrefix(schedule, "@") {
+		if cronDescriptors.Has(schedule) {
+			return nil
+		}
+
+		if interval := strings.TrimPrefix(schedule, "@every "); interval != schedule {
+			duration, err := time.ParseDuration(interval)
+			if err != nil || duration <= 0 {
+				return fmt.Errorf("invalid interval %q", interval)
+			}
+			return nil
+		}
+
+		return fmt.Errorf("unknown descriptor %q", schedule)
+	}
+
+	values := strings.Fields(schedule)
+	if len(values) != len(cronFields) {
+		return fmt.Errorf("expected %d fields, found %d", len(cronFields), len(values))
+	}
+
+	for i, value := range values {
+		if err := cronFields[i].validate(value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validate validates a comma separated list of values, ranges and steps; e.g. 1,5-10,*/15
+func (f cronField) validate(value string) error {
+	for _, part := range strings.Split(value, ",") {
+		stepParts := strings.SplitN(part, "/", 2)
+		rangePart := stepParts[0]
+		if len(stepParts) == 2 {
+			if n, err := strconv.Atoi(stepParts[1]); err != nil || n <= 0 {
+				return fmt.Errorf("invalid step %q in the %s field", stepParts[1], f.name)
+			}
+		}
+
+		if rangePart == "*" || (f.any && rangePart == "?") {
+			continue
+		}
+
+		bounds := strings.SplitN(rangePart, "-", 2)
+		start, err := f.parseValue(bounds[0])
+		if err != nil {
+			return err
+		}
+
+		if len(bounds) == 1 {
+			continue
+		}
+
+		end, err := f.parseValue(bounds[1])
+		if err != nil {
+			return err
+		}
+
+		if start > end {
+			return fmt.Errorf("invalid range %q in the %s field", rangePart, f.name)
+		}
+	}
+
+	return nil
+}
+
+func (f cronField) parseValue(value string) (int, error) {
+	if n, found := f.names[strings.ToLower(value)]; found {
+		return n, nil
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil || n < f.min || n > f.max {
+		return 0, fmt.Errorf("invalid value %q in the %s field; must be between %d and %d", value, f.name, f.min, f.max)
+	}
+
+	return n, nil
+}
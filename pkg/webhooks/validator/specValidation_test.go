@@ -0,0 +1,202 @@
+package validator
+
+import (
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	cdiv1beta1 "kubevirt.io/containerized-data-importer/pkg/apis/core/v1beta1"
+	sspv1beta1 "kubevirt.io/ssp-operator/api/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/controller/commonTestUtils"
+)
+
+var _ = Describe("validate the semantics of the spec", func() {
+	var hco *v1beta1.HyperConverged
+
+	BeforeEach(func() {
+		Expect(os.Setenv("OPERATOR_NAMESPACE", HcoValidNamespace)).To(BeNil())
+		hco = commonTestUtils.NewHco()
+	})
+
+	getFieldErrors := func(err error) field.ErrorList {
+		ExpectWithOffset(1, apierrors.IsInvalid(err)).To(BeTrue(), "expected an Invalid error; got %v", err)
+
+		var errs field.ErrorList
+		for _, cause := range err.(apierrors.APIStatus).Status().Details.Causes {
+			errs = append(errs, &field.Error{Type: field.ErrorType(cause.Type), Field: cause.Field})
+		}
+		return errs
+	}
+
+	newDataImportCronTemplate := func(name, schedule string) sspv1beta1.DataImportCronTemplate {
+		return sspv1beta1.DataImportCronTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       cdiv1beta1.DataImportCronSpec{Schedule: schedule},
+		}
+	}
+
+	It("should accept a spec without the validated fields", func() {
+		Expect(validateSpec(hco, nil)).To(Succeed())
+	})
+
+	It("should accept valid values", func() {
+		batchEvictionSize := 10
+		hco.Spec.WorkloadUpdateStrategy = &v1beta1.HyperConvergedWorkloadUpdateStrategy{
+			WorkloadUpdateMethods: []string{"LiveMigrate", "Evict"},
+			BatchEvictionSize:     &batchEvictionSize,
+		}
+		hco.Spec.DataImportCronTemplates = []sspv1beta1.DataImportCronTemplate{
+			newDataImportCronTemplate("fedora-image-cron", "0 */12 * * *"),
+			newDataImportCronTemplate("centos-image-cron", "@daily"),
+		}
+		hco.Spec.PermittedHostDevices = &v1beta1.PermittedHostDevices{
+			PciHostDevices: []v1beta1.PciHostDevice{
+				{PCIDeviceSelector: "10DE:1DB6", ResourceName: "nvidia.com/GV100GL_Tesla_V100"},
+				{PCIDeviceSelector: "10de:1eb8", ResourceName: "nvidia.com/TU104GL_Tesla_T4"},
+			},
+		}
+		hco.Spec.ObsoleteCPUs = &v1beta1.HyperConvergedObsoleteCPUs{
+			MinCPUModel: "Penryn",
+			CPUModels:   []string{"486", "Opteron_G2", "Haswell-noTSX-IBRS"},
+		}
+		hco.Spec.StorageImport = &v1beta1.StorageImportConfig{
+			InsecureRegistries: []string{"private-registry-example-1:5000", "registry.example.com", "10.0.0.1:8443", "[fd00::1]:5000"},
+		}
+
+		Expect(validateSpec(hco, nil)).To(Succeed())
+	})
+
+	It("should return all the field errors together", func() {
+		batchEvictionSize := 0
+		hco.Spec.WorkloadUpdateStrategy = &v1beta1.HyperConvergedWorkloadUpdateStrategy{
+			WorkloadUpdateMethods: []string{"LiveMigrate", "Restart", "LiveMigrate"},
+			BatchEvictionSize:     &batchEvictionSize,
+		}
+		hco.Spec.DataImportCronTemplates = []sspv1beta1.DataImportCronTemplate{
+			newDataImportCronTemplate("fedora-image-cron", "0 */12 * * *"),
+			newDataImportCronTemplate("fedora-image-cron", "every day"),
+			newDataImportCronTemplate("", ""),
+		}
+		hco.Spec.PermittedHostDevices = &v1beta1.PermittedHostDevices{
+			PciHostDevices: []v1beta1.PciHostDevice{
+				{PCIDeviceSelector: "10DE:1DB6", ResourceName: "nvidia.com/GPU"},
+				{PCIDeviceSelector: "10DE1EB8", ResourceName: "nvidia.com/GPU"},
+			},
+		}
+		hco.Spec.ObsoleteCPUs = &v1beta1.HyperConvergedObsoleteCPUs{
+			MinCPUModel: "Penryn!",
+			CPUModels:   []string{"486", "Opteron G2"},
+		}
+		hco.Spec.StorageImport = &v1beta1.StorageImportConfig{
+			InsecureRegistries: []string{"https://registry.example.com", "registry.example.com:70000"},
+		}
+
+		err := validateSpec(hco, nil)
+		Expect(err).To(HaveOccurred())
+		Expect(getFieldErrors(err)).To(ConsistOf(
+			&field.Error{Type: field.ErrorTypeNotSupported, Field: "spec.workloadUpdateStrategy.workloadUpdateMethods[1]"},
+			&field.Error{Type: field.ErrorTypeDuplicate, Field: "spec.workloadUpdateStrategy.workloadUpdateMethods[2]"},
+			&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.workloadUpdateStrategy.batchEvictionSize"},
+			&field.Error{Type: field.ErrorTypeDuplicate, Field: "spec.dataImportCronTemplates[1].metadata.name"},
+			&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.dataImportCronTemplates[1].spec.schedule"},
+			&field.Error{Type: field.ErrorTypeRequired, Field: "spec.dataImportCronTemplates[2].metadata.name"},
+			&field.Error{Type: field.ErrorTypeRequired, Field: "spec.dataImportCronTemplates[2].spec.schedule"},
+			&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.permittedHostDevices.pciHostDevices[1].pciDeviceSelector"},
+			&field.Error{Type: field.ErrorTypeDuplicate, Field: "spec.permittedHostDevices.pciHostDevices[1].resourceName"},
+			&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.obsoleteCPUs.minCPUModel"},
+			&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.obsoleteCPUs.cpuModels[1]"},
+			&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.storageImport.insecureRegistries[0]"},
+			&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.storageImport.insecureRegistries[1]"},
+		))
+	})
+
+	It("should not report the errors that the existing spec already has", func() {
+		hco.Spec.PermittedHostDevices = &v1beta1.PermittedHostDevices{
+			PciHostDevices: []v1beta1.PciHostDevice{
+				{PCIDeviceSelector: "111", ResourceName: "name"},
+				{PCIDeviceSelector: "222", ResourceName: "name"},
+			},
+		}
+		exists := hco.DeepCopy()
+		Expect(validateSpec(exists, nil)).ToNot(Succeed())
+
+		hco.Spec.ObsoleteCPUs = &v1beta1.HyperConvergedObsoleteCPUs{MinCPUModel: "Penryn"}
+		Expect(validateSpec(hco, exists)).To(Succeed())
+	})
+
+	It("should report the errors of the changed fields, even if the existing spec is invalid", func() {
+		hco.Spec.PermittedHostDevices = &v1beta1.PermittedHostDevices{
+			PciHostDevices: []v1beta1.PciHostDevice{
+				{PCIDeviceSelector: "111", ResourceName: "name"},
+				{PCIDeviceSelector: "222", ResourceName: "name"},
+			},
+		}
+		exists := hco.DeepCopy()
+
+		hco.Spec.PermittedHostDevices.PciHostDevices[1].PCIDeviceSelector = "333"
+		hco.Spec.PermittedHostDevices.PciHostDevices = append(hco.Spec.PermittedHostDevices.PciHostDevices,
+			v1beta1.PciHostDevice{PCIDeviceSelector: "10DE:1EB8", ResourceName: "name"},
+		)
+
+		err := validateSpec(hco, exists)
+		Expect(err).To(HaveOccurred())
+		Expect(getFieldErrors(err)).To(ConsistOf(
+			&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.permittedHostDevices.pciHostDevices[1].pciDeviceSelector"},
+			&field.Error{Type: field.ErrorTypeDuplicate, Field: "spec.permittedHostDevices.pciHostDevices[2].resourceName"},
+		))
+	})
+
+	It("should reject the creation and the update of a resource with an invalid spec", func() {
+		cli := fake.NewClientBuilder().WithScheme(commonTestUtils.GetScheme()).Build()
+		wh := NewWebhookHandler(logger, cli, cli, HcoValidNamespace, true)
+
+		batchEvictionSize := -1
+		hco.Spec.WorkloadUpdateStrategy = &v1beta1.HyperConvergedWorkloadUpdateStrategy{
+			BatchEvictionSize: &batchEvictionSize,
+		}
+
+		err := wh.ValidateCreate(hco)
+		Expect(apierrors.IsInvalid(err)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("spec.workloadUpdateStrategy.batchEvictionSize: Invalid value: -1: must be greater than 0"))
+
+		err = wh.ValidateUpdate(hco, commonTestUtils.NewHco())
+		Expect(apierrors.IsInvalid(err)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("spec.workloadUpdateStrategy.batchEvictionSize: Invalid value: -1: must be greater than 0"))
+	})
+
+	DescribeTable("should accept a valid cron schedule",
+		func(schedule string) {
+			Expect(validateCronSchedule(schedule)).To(Succeed())
+		},
+		Entry("every minute", "* * * * *"),
+		Entry("values", "30 2 1 6 0"),
+		Entry("lists, ranges and steps", "0,30 8-18/2 */5 1-6 mon-fri"),
+		Entry("names", "0 0 * JAN,jul SUN"),
+		Entry("question mark", "0 0 ? * ?"),
+		Entry("descriptor", "@weekly"),
+		Entry("interval", "@every 1h30m"),
+	)
+
+	DescribeTable("should reject an invalid cron schedule",
+		func(schedule string) {
+			Expect(validateCronSchedule(schedule)).ToNot(Succeed())
+		},
+		Entry("too few fields", "0 0 * *"),
+		Entry("too many fields", "0 0 0 * * *"),
+		Entry("out of range minute", "60 * * * *"),
+		Entry("out of range day of month", "0 0 0 * *"),
+		Entry("unknown name", "0 0 * foo *"),
+		Entry("reversed range", "0 10-5 * * *"),
+		Entry("zero step", "*/0 * * * *"),
+		Entry("question mark in the hour field", "0 ? * * *"),
+		Entry("unknown descriptor", "@sometimes"),
+		Entry("invalid interval", "@every day"),
+	)
+})
@@ -2,17 +2,23 @@ package validator
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"reflect"
+	"regexp"
+	"sort"
 	"sync"
 	"time"
 
 	"github.com/go-logr/logr"
 	networkaddonsv1 "github.com/kubevirt/cluster-network-addons-operator/pkg/apis/networkaddonsoperator/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
 	kubevirtv1 "kubevirt.io/client-go/api/v1"
 	cdiv1beta1 "kubevirt.io/containerized-data-importer/pkg/apis/core/v1beta1"
+	sdkapi "kubevirt.io/controller-lifecycle-operator-sdk/pkg/sdk/api"
 	sspv1beta1 "kubevirt.io/ssp-operator/api/v1beta1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -25,19 +31,31 @@ const (
 	updateDryRunTimeOut = time.Second * 3
 )
 
+// imageReferenceRegex matches a container image reference; that is, [registry[:port]/]repository[:tag][@digest]
+var imageReferenceRegex = regexp.MustCompile(
+	`^(?:(?:[a-zA-Z0-9]|[a-zA-Z0-9][a-zA-Z0-9-]*[a-zA-Z0-9])(?:\.(?:[a-zA-Z0-9]|[a-zA-Z0-9][a-zA-Z0-9-]*[a-zA-Z0-9]))*(?::[0-9]+)?/)?` + // registry
+		`[a-z0-9]+(?:(?:[._]|__|-+)[a-z0-9]+)*(?:/[a-z0-9]+(?:(?:[._]|__|-+)[a-z0-9]+)*)*` + // repository
+		`(?::[a-zA-Z0-9_][a-zA-Z0-9_.-]{0,127})?` + // tag
+		`(?:@[A-Za-z][A-Za-z0-9]*(?:[-_+.][A-Za-z][A-Za-z0-9]*)*:[0-9a-fA-F]{32,})?$`, // digest
+)
+
 var _ v1beta1.ValidatorWebhookHandler = &WebhookHandler{}
 
 type WebhookHandler struct {
-	logger      logr.Logger
-	cli         client.Client
+	logger logr.Logger
+	cli    client.Client
+	// apiReader reads directly from the API server. The cache of cli holds only the objects in the watched namespace,
+	// so apiReader is used to list the workloads, that are in any namespace.
+	apiReader   client.Reader
 	namespace   string
 	isOpenshift bool
 }
 
-func NewWebhookHandler(logger logr.Logger, cli client.Client, namespace string, isOpenshift bool) *WebhookHandler {
+func NewWebhookHandler(logger logr.Logger, cli client.Client, apiReader client.Reader, namespace string, isOpenshift bool) *WebhookHandler {
 	return &WebhookHandler{
 		logger:      logger,
 		cli:         cli,
+		apiReader:   apiReader,
 		namespace:   namespace,
 		isOpenshift: isOpenshift,
 	}
@@ -46,7 +64,7 @@ func NewWebhookHandler(logger logr.Logger, cli client.Client, namespace string,
 func (wh WebhookHandler) ValidateCreate(hc *v1beta1.HyperConverged) error {
 	wh.logger.Info("Validating create", "name", hc.Name, "namespace:", hc.Namespace)
 
-	if err := wh.validateCertConfig(hc); err != nil {
+	if err := validateSpec(hc, nil); err != nil {
 		return err
 	}
 
@@ -54,6 +72,10 @@ func (wh WebhookHandler) ValidateCreate(hc *v1beta1.HyperConverged) error {
 		return fmt.Errorf("invalid namespace for v1beta1.HyperConverged - please use the %s namespace", wh.namespace)
 	}
 
+	if err := wh.validateNodePlacements(context.TODO(), hc, nil); err != nil {
+		return err
+	}
+
 	if _, err := operands.NewKubeVirt(hc); err != nil {
 		return err
 	}
@@ -66,6 +88,10 @@ func (wh WebhookHandler) ValidateCreate(hc *v1beta1.HyperConverged) error {
 		return err
 	}
 
+	if err := wh.validateOverrides(hc); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -82,7 +108,19 @@ func (wh WebhookHandler) ValidateUpdate(requested *v1beta1.HyperConverged, exist
 		return nil
 	}
 
-	if err := wh.validateCertConfig(requested); err != nil {
+	if err := validateSpec(requested, exists); err != nil {
+		return err
+	}
+
+	if err := wh.validateNodePlacements(ctx, requested, exists); err != nil {
+		return err
+	}
+
+	if err := wh.validateWorkloadsPlacementChange(ctx, requested, exists); err != nil {
+		return err
+	}
+
+	if err := wh.validateOverrides(requested); err != nil {
 		return err
 	}
 
@@ -164,25 +202,41 @@ func (wh WebhookHandler) updateOperatorCr(ctx context.Context, hc *v1beta1.Hyper
 		if err != nil {
 			return err
 		}
-		required.Spec.DeepCopyInto(&existing.Spec)
+		patched, err := applyOverrides(hc, required)
+		if err != nil {
+			return err
+		}
+		patched.(*kubevirtv1.KubeVirt).Spec.DeepCopyInto(&existing.Spec)
 
 	case *cdiv1beta1.CDI:
 		required, err := operands.NewCDI(hc)
 		if err != nil {
 			return err
 		}
-		required.Spec.DeepCopyInto(&existing.Spec)
+		patched, err := applyOverrides(hc, required)
+		if err != nil {
+			return err
+		}
+		patched.(*cdiv1beta1.CDI).Spec.DeepCopyInto(&existing.Spec)
 
 	case *networkaddonsv1.NetworkAddonsConfig:
 		required, err := operands.NewNetworkAddons(hc)
 		if err != nil {
 			return err
 		}
-		required.Spec.DeepCopyInto(&existing.Spec)
+		patched, err := applyOverrides(hc, required)
+		if err != nil {
+			return err
+		}
+		patched.(*networkaddonsv1.NetworkAddonsConfig).Spec.DeepCopyInto(&existing.Spec)
 
 	case *sspv1beta1.SSP:
 		required := operands.NewSSP(hc)
-		required.Spec.DeepCopyInto(&existing.Spec)
+		patched, err := applyOverrides(hc, required)
+		if err != nil {
+			return err
+		}
+		patched.(*sspv1beta1.SSP).Spec.DeepCopyInto(&existing.Spec)
 
 	}
 
@@ -195,6 +249,18 @@ func (wh WebhookHandler) updateOperatorCr(ctx context.Context, hc *v1beta1.Hyper
 	return nil
 }
 
+// applyOverrides applies the spec.overrides entries on the required operand CR, so the dry-run update also validates
+// the overrides against the operand schema
+func applyOverrides(hc *v1beta1.HyperConverged, required client.Object) (client.Object, error) {
+	patched, results := operands.ApplyOverrides(hc, required)
+	for _, res := range results {
+		if res.Err != nil {
+			return nil, fmt.Errorf("spec.overrides[%d]: %w", res.Index, res.Err)
+		}
+	}
+	return patched, nil
+}
+
 func (wh WebhookHandler) ValidateDelete(hc *v1beta1.HyperConverged) error {
 	wh.logger.Info("Validating delete", "name", hc.Name, "namespace", hc.Namespace)
 
@@ -217,32 +283,206 @@ func (wh WebhookHandler) ValidateDelete(hc *v1beta1.HyperConverged) error {
 	return nil
 }
 
-func (wh WebhookHandler) validateCertConfig(hc *v1beta1.HyperConverged) error {
-	minimalDuration := metav1.Duration{Duration: 10 * time.Minute}
+// validateOverrides checks the spec.overrides entries, and applies them on the resources that HCO is going to deploy,
+// to make sure that the result is still valid
+func (wh WebhookHandler) validateOverrides(hc *v1beta1.HyperConverged) error {
+	if len(hc.Spec.Overrides) == 0 {
+		return nil
+	}
+
+	for i, override := range hc.Spec.Overrides {
+		if err := operands.ValidateOverride(override); err != nil {
+			return fmt.Errorf("spec.overrides[%d]: %w", i, err)
+		}
+	}
+
+	kv, err := operands.NewKubeVirt(hc)
+	if err != nil {
+		return err
+	}
+
+	cdi, err := operands.NewCDI(hc)
+	if err != nil {
+		return err
+	}
+
+	cna, err := operands.NewNetworkAddons(hc)
+	if err != nil {
+		return err
+	}
+
+	resources := []client.Object{
+		kv,
+		cdi,
+		cna,
+		operands.NewKubeVirtStorageConfigForCR(hc, hc.Namespace),
+	}
+
+	if hc.Spec.Storage != nil && hc.Spec.Storage.HostPathProvisioner != nil {
+		hpp, err := operands.NewHostPathProvisioner(hc)
+		if err != nil {
+			return err
+		}
+		resources = append(resources, hpp)
+	}
+
+	if wh.isOpenshift {
+		resources = append(resources, operands.NewSSP(hc))
+	}
 
-	ccValues := make(map[string]time.Duration)
-	ccValues["spec.certConfig.ca.duration"] = hc.Spec.CertConfig.CA.Duration.Duration
-	ccValues["spec.certConfig.ca.renewBefore"] = hc.Spec.CertConfig.CA.RenewBefore.Duration
-	ccValues["spec.certConfig.server.duration"] = hc.Spec.CertConfig.Server.Duration.Duration
-	ccValues["spec.certConfig.server.renewBefore"] = hc.Spec.CertConfig.Server.RenewBefore.Duration
+	for _, obj := range resources {
+		if _, err = applyOverrides(hc, obj); err != nil {
+			return err
+		}
+	}
 
-	for key, value := range ccValues {
-		if value < minimalDuration.Duration {
-			return fmt.Errorf("%v: value is too small", key)
+	return nil
+}
+
+// validateNodePlacements rejects node placements that do not match any schedulable node. When validating an update,
+// only the modified node placements are validated.
+func (wh WebhookHandler) validateNodePlacements(ctx context.Context, requested *v1beta1.HyperConverged, exists *v1beta1.HyperConverged) error {
+	placements := getNodePlacements(requested)
+	if exists != nil {
+		for field, placement := range getNodePlacements(exists) {
+			if reflect.DeepEqual(placements[field], placement) {
+				delete(placements, field)
+			}
 		}
 	}
 
-	if hc.Spec.CertConfig.CA.Duration.Duration < hc.Spec.CertConfig.CA.RenewBefore.Duration {
-		return errors.New("spec.certConfig.ca: duration is smaller than renewBefore")
+	if len(placements) == 0 {
+		return nil
+	}
+
+	nodes := &corev1.NodeList{}
+	if err := wh.cli.List(ctx, nodes); err != nil {
+		wh.logger.Error(err, "failed to list the cluster nodes")
+		return err
 	}
 
-	if hc.Spec.CertConfig.Server.Duration.Duration < hc.Spec.CertConfig.Server.RenewBefore.Duration {
-		return errors.New("spec.certConfig.server: duration is smaller than renewBefore")
+	fields := make([]string, 0, len(placements))
+	for field := range placements {
+		fields = append(fields, field)
 	}
+	sort.Strings(fields)
 
-	if hc.Spec.CertConfig.CA.Duration.Duration < hc.Spec.CertConfig.Server.Duration.Duration {
-		return errors.New("spec.certConfig: ca.duration is smaller than server.duration")
+	for _, field := range fields {
+		if !anySchedulableNodeMatches(nodes.Items, placements[field]) {
+			return fmt.Errorf("%s: the node placement does not match any schedulable node", field)
+		}
 	}
 
 	return nil
 }
+
+func getNodePlacements(hc *v1beta1.HyperConverged) map[string]*sdkapi.NodePlacement {
+	placements := make(map[string]*sdkapi.NodePlacement)
+
+	addPlacement := func(field string, placement *sdkapi.NodePlacement) {
+		if placement != nil {
+			placements[field] = placement
+		}
+	}
+
+	addPlacement("spec.infra.nodePlacement", hc.Spec.Infra.NodePlacement)
+	addPlacement("spec.workloads.nodePlacement", hc.Spec.Workloads.NodePlacement)
+
+	for i, override := range hc.Spec.NodePlacementOverrides {
+		addPlacement(fmt.Sprintf("spec.nodePlacementOverrides[%d].infra.nodePlacement", i), override.Infra.NodePlacement)
+		addPlacement(fmt.Sprintf("spec.nodePlacementOverrides[%d].workloads.nodePlacement", i), override.Workloads.NodePlacement)
+	}
+
+	if hc.Spec.NodeMaintenance != nil {
+		addPlacement("spec.nodeMaintenance.nodePlacement", hc.Spec.NodeMaintenance.NodePlacement)
+	}
+
+	return placements
+}
+
+func anySchedulableNodeMatches(nodes []corev1.Node, placement *sdkapi.NodePlacement) bool {
+	for i := range nodes {
+		if !nodes[i].Spec.Unschedulable && nodeMatchesPlacement(&nodes[i], placement) {
+			return true
+		}
+	}
+	return false
+}
+
+func nodeMatchesPlacement(node *corev1.Node, placement *sdkapi.NodePlacement) bool {
+	if !labels.SelectorFromSet(placement.NodeSelector).Matches(labels.Set(node.Labels)) {
+		return false
+	}
+
+	if placement.Affinity != nil && placement.Affinity.NodeAffinity != nil {
+		if required := placement.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution; required != nil && !nodeMatchesNodeSelectorTerms(node, required.NodeSelectorTerms) {
+			return false
+		}
+	}
+
+	for i := range node.Spec.Taints {
+		taint := &node.Spec.Taints[i]
+		if taint.Effect == corev1.TaintEffectPreferNoSchedule {
+			continue
+		}
+
+		if !taintTolerated(taint, placement.Tolerations) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// nodeMatchesNodeSelectorTerms checks if the node matches at least one of the terms. The requirements of a term are
+// ANDed; an empty term matches no node.
+func nodeMatchesNodeSelectorTerms(node *corev1.Node, terms []corev1.NodeSelectorTerm) bool {
+	for _, term := range terms {
+		if len(term.MatchExpressions) == 0 && len(term.MatchFields) == 0 {
+			continue
+		}
+
+		if nodeSelectorRequirementsMatch(term.MatchExpressions, labels.Set(node.Labels)) &&
+			nodeSelectorRequirementsMatch(term.MatchFields, fields.Set{"metadata.name": node.Name}) {
+			return true
+		}
+	}
+	return false
+}
+
+func nodeSelectorRequirementsMatch(requirements []corev1.NodeSelectorRequirement, values labels.Labels) bool {
+	for _, req := range requirements {
+		var op selection.Operator
+		switch req.Operator {
+		case corev1.NodeSelectorOpIn:
+			op = selection.In
+		case corev1.NodeSelectorOpNotIn:
+			op = selection.NotIn
+		case corev1.NodeSelectorOpExists:
+			op = selection.Exists
+		case corev1.NodeSelectorOpDoesNotExist:
+			op = selection.DoesNotExist
+		case corev1.NodeSelectorOpGt:
+			op = selection.GreaterThan
+		case corev1.NodeSelectorOpLt:
+			op = selection.LessThan
+		default:
+			return false
+		}
+
+		requirement, err := labels.NewRequirement(req.Key, op, req.Values)
+		if err != nil || !requirement.Matches(values) {
+			return false
+		}
+	}
+	return true
+}
+
+func taintTolerated(taint *corev1.Taint, tolerations []corev1.Toleration) bool {
+	for i := range tolerations {
+		if tolerations[i].ToleratesTaint(taint) {
+			return true
+		}
+	}
+	return false
+}
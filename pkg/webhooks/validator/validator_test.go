@@ -24,6 +24,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/client-go/kubernetes/scheme"
 	kubevirtv1 "kubevirt.io/client-go/api/v1"
 	cdiv1beta1 "kubevirt.io/containerized-data-importer/pkg/apis/core/v1beta1"
@@ -116,7 +117,7 @@ var _ = Describe("webhooks validator", func() {
 		})
 
 		cli := fake.NewClientBuilder().WithScheme(s).Build()
-		wh := NewWebhookHandler(logger, cli, HcoValidNamespace, true)
+		wh := NewWebhookHandler(logger, cli, cli, HcoValidNamespace, true)
 
 		It("should accept creation of a resource with a valid namespace", func() {
 			err := wh.ValidateCreate(cr)
@@ -170,16 +171,16 @@ var _ = Describe("webhooks validator", func() {
 				cr.Spec.PermittedHostDevices = &v1beta1.PermittedHostDevices{
 					PciHostDevices: []v1beta1.PciHostDevice{
 						{
-							PCIDeviceSelector: "111",
-							ResourceName:      "name",
+							PCIDeviceSelector: "10DE:1DB6",
+							ResourceName:      "nvidia.com/GV100GL_Tesla_V100",
 						},
 						{
-							PCIDeviceSelector: "222",
-							ResourceName:      "name",
+							PCIDeviceSelector: "10DE:1EB8",
+							ResourceName:      "nvidia.com/TU104GL_Tesla_T4",
 						},
 						{
-							PCIDeviceSelector: "333",
-							ResourceName:      "name",
+							PCIDeviceSelector: "8086:6f54",
+							ResourceName:      "intel.com/qat",
 						},
 					},
 				}
@@ -231,7 +232,7 @@ var _ = Describe("webhooks validator", func() {
 			kv := operands.NewKubeVirtWithNameOnly(hco)
 			Expect(cli.Delete(ctx, kv)).ToNot(HaveOccurred())
 
-			wh := NewWebhookHandler(logger, cli, HcoValidNamespace, true)
+			wh := NewWebhookHandler(logger, cli, cli, HcoValidNamespace, true)
 
 			newHco := &v1beta1.HyperConverged{}
 			hco.DeepCopyInto(newHco)
@@ -248,7 +249,7 @@ var _ = Describe("webhooks validator", func() {
 			cli := getFakeClient(hco)
 			cli.InitiateUpdateErrors(getUpdateError(kvUpdateFailure))
 
-			wh := NewWebhookHandler(logger, cli, HcoValidNamespace, true)
+			wh := NewWebhookHandler(logger, cli, cli, HcoValidNamespace, true)
 
 			newHco := &v1beta1.HyperConverged{}
 			hco.DeepCopyInto(newHco)
@@ -267,7 +268,7 @@ var _ = Describe("webhooks validator", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(cli.Delete(ctx, cdi)).To(BeNil())
 
-			wh := NewWebhookHandler(logger, cli, HcoValidNamespace, true)
+			wh := NewWebhookHandler(logger, cli, cli, HcoValidNamespace, true)
 
 			newHco := &v1beta1.HyperConverged{}
 			hco.DeepCopyInto(newHco)
@@ -283,7 +284,7 @@ var _ = Describe("webhooks validator", func() {
 		It("should return error if dry-run update of CDI CR returns error", func() {
 			cli := getFakeClient(hco)
 			cli.InitiateUpdateErrors(getUpdateError(cdiUpdateFailure))
-			wh := NewWebhookHandler(logger, cli, HcoValidNamespace, true)
+			wh := NewWebhookHandler(logger, cli, cli, HcoValidNamespace, true)
 
 			newHco := &v1beta1.HyperConverged{}
 			hco.DeepCopyInto(newHco)
@@ -299,7 +300,7 @@ var _ = Describe("webhooks validator", func() {
 			cli := getFakeClient(hco)
 			cli.InitiateUpdateErrors(getUpdateError(noFailure))
 
-			wh := NewWebhookHandler(logger, cli, HcoValidNamespace, true)
+			wh := NewWebhookHandler(logger, cli, cli, HcoValidNamespace, true)
 
 			newHco := &v1beta1.HyperConverged{}
 			hco.DeepCopyInto(newHco)
@@ -316,7 +317,7 @@ var _ = Describe("webhooks validator", func() {
 			cna, err := operands.NewNetworkAddons(hco)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(cli.Delete(ctx, cna)).To(BeNil())
-			wh := NewWebhookHandler(logger, cli, HcoValidNamespace, true)
+			wh := NewWebhookHandler(logger, cli, cli, HcoValidNamespace, true)
 
 			newHco := &v1beta1.HyperConverged{}
 			hco.DeepCopyInto(newHco)
@@ -333,7 +334,7 @@ var _ = Describe("webhooks validator", func() {
 			cli := getFakeClient(hco)
 			cli.InitiateUpdateErrors(getUpdateError(networkUpdateFailure))
 
-			wh := NewWebhookHandler(logger, cli, HcoValidNamespace, true)
+			wh := NewWebhookHandler(logger, cli, cli, HcoValidNamespace, true)
 
 			newHco := &v1beta1.HyperConverged{}
 			hco.DeepCopyInto(newHco)
@@ -349,7 +350,7 @@ var _ = Describe("webhooks validator", func() {
 			ctx := context.TODO()
 			cli := getFakeClient(hco)
 			Expect(cli.Delete(ctx, operands.NewSSP(hco))).To(BeNil())
-			wh := NewWebhookHandler(logger, cli, HcoValidNamespace, true)
+			wh := NewWebhookHandler(logger, cli, cli, HcoValidNamespace, true)
 
 			newHco := &v1beta1.HyperConverged{}
 			hco.DeepCopyInto(newHco)
@@ -365,7 +366,7 @@ var _ = Describe("webhooks validator", func() {
 		It("should return error if dry-run update of SSP CR returns error", func() {
 			cli := getFakeClient(hco)
 			cli.InitiateUpdateErrors(getUpdateError(sspUpdateFailure))
-			wh := NewWebhookHandler(logger, cli, HcoValidNamespace, true)
+			wh := NewWebhookHandler(logger, cli, cli, HcoValidNamespace, true)
 
 			newHco := &v1beta1.HyperConverged{}
 			hco.DeepCopyInto(newHco)
@@ -382,7 +383,7 @@ var _ = Describe("webhooks validator", func() {
 			cli := getFakeClient(hco)
 			cli.InitiateUpdateErrors(initiateTimeout)
 
-			wh := NewWebhookHandler(logger, cli, HcoValidNamespace, true)
+			wh := NewWebhookHandler(logger, cli, cli, HcoValidNamespace, true)
 
 			newHco := &v1beta1.HyperConverged{}
 			hco.DeepCopyInto(newHco)
@@ -398,7 +399,7 @@ var _ = Describe("webhooks validator", func() {
 			cli := getFakeClient(hco)
 			cli.InitiateUpdateErrors(initiateTimeout)
 
-			wh := NewWebhookHandler(logger, cli, HcoValidNamespace, true)
+			wh := NewWebhookHandler(logger, cli, cli, HcoValidNamespace, true)
 
 			newHco := &v1beta1.HyperConverged{}
 			hco.DeepCopyInto(newHco)
@@ -409,12 +410,8 @@ var _ = Describe("webhooks validator", func() {
 
 		Context("test permitted host devices update validation", func() {
 			It("should allow unique PCI Host Device", func() {
-				cli := getFakeClient(hco)
-				wh := NewWebhookHandler(logger, cli, HcoValidNamespace, true)
-
-				newHco := &v1beta1.HyperConverged{}
-				hco.DeepCopyInto(newHco)
-				newHco.Spec.PermittedHostDevices = &v1beta1.PermittedHostDevices{
+				// a CR with values that were accepted before the semantic validation of the spec
+				hco.Spec.PermittedHostDevices = &v1beta1.PermittedHostDevices{
 					PciHostDevices: []v1beta1.PciHostDevice{
 						{
 							PCIDeviceSelector: "111",
@@ -430,12 +427,22 @@ var _ = Describe("webhooks validator", func() {
 						},
 					},
 				}
+				cli := getFakeClient(hco)
+				wh := NewWebhookHandler(logger, cli, cli, HcoValidNamespace, true)
+
+				newHco := &v1beta1.HyperConverged{}
+				hco.DeepCopyInto(newHco)
+				if newHco.Annotations == nil {
+					newHco.Annotations = make(map[string]string)
+				}
+				newHco.Annotations["a change"] = "Something else"
+
 				Expect(wh.ValidateUpdate(newHco, hco)).ToNot(HaveOccurred())
 			})
 
 			It("should allow unique Mediate Host Device", func() {
 				cli := getFakeClient(hco)
-				wh := NewWebhookHandler(logger, cli, HcoValidNamespace, true)
+				wh := NewWebhookHandler(logger, cli, cli, HcoValidNamespace, true)
 
 				newHco := &v1beta1.HyperConverged{}
 				hco.DeepCopyInto(newHco)
@@ -467,7 +474,7 @@ var _ = Describe("webhooks validator", func() {
 				kv, err := operands.NewKubeVirt(hco)
 				Expect(err).ToNot(HaveOccurred())
 				Expect(cli.Delete(ctx, kv)).To(BeNil())
-				wh := NewWebhookHandler(logger, cli, HcoValidNamespace, false)
+				wh := NewWebhookHandler(logger, cli, cli, HcoValidNamespace, false)
 
 				newHco := commonTestUtils.NewHco()
 				newHco.Spec.Infra = v1beta1.HyperConvergedConfig{
@@ -500,7 +507,7 @@ var _ = Describe("webhooks validator", func() {
 				kv := operands.NewKubeVirtWithNameOnly(hco)
 				Expect(cli.Delete(context.TODO(), kv)).ToNot(HaveOccurred())
 
-				wh := NewWebhookHandler(logger, cli, HcoValidNamespace, true)
+				wh := NewWebhookHandler(logger, cli, cli, HcoValidNamespace, true)
 
 				newHco := &v1beta1.HyperConverged{}
 				hco.DeepCopyInto(newHco)
@@ -512,7 +519,7 @@ var _ = Describe("webhooks validator", func() {
 			It("should allow updating of live migration", func() {
 				cli := getFakeClient(hco)
 
-				wh := NewWebhookHandler(logger, cli, HcoValidNamespace, true)
+				wh := NewWebhookHandler(logger, cli, cli, HcoValidNamespace, true)
 
 				newHco := &v1beta1.HyperConverged{}
 				hco.DeepCopyInto(newHco)
@@ -528,7 +535,7 @@ var _ = Describe("webhooks validator", func() {
 			It("should fail if live migration is wrong", func() {
 				cli := getFakeClient(hco)
 
-				wh := NewWebhookHandler(logger, cli, HcoValidNamespace, true)
+				wh := NewWebhookHandler(logger, cli, cli, HcoValidNamespace, true)
 
 				newHco := &v1beta1.HyperConverged{}
 				hco.DeepCopyInto(newHco)
@@ -560,7 +567,7 @@ var _ = Describe("webhooks validator", func() {
 				kv := operands.NewKubeVirtWithNameOnly(hco)
 				Expect(cli.Delete(context.TODO(), kv)).ToNot(HaveOccurred())
 
-				wh := NewWebhookHandler(logger, cli, HcoValidNamespace, true)
+				wh := NewWebhookHandler(logger, cli, cli, HcoValidNamespace, true)
 
 				newHco := &v1beta1.HyperConverged{}
 				hco.DeepCopyInto(newHco)
@@ -572,7 +579,7 @@ var _ = Describe("webhooks validator", func() {
 			It("should allow updating of cert config", func() {
 				cli := getFakeClient(hco)
 
-				wh := NewWebhookHandler(logger, cli, HcoValidNamespace, true)
+				wh := NewWebhookHandler(logger, cli, cli, HcoValidNamespace, true)
 
 				newHco := &v1beta1.HyperConverged{}
 				hco.DeepCopyInto(newHco)
@@ -591,7 +598,7 @@ var _ = Describe("webhooks validator", func() {
 				func(newHco v1beta1.HyperConverged, errorMsg string) {
 					cli := getFakeClient(hco)
 
-					wh := NewWebhookHandler(logger, cli, HcoValidNamespace, true)
+					wh := NewWebhookHandler(logger, cli, cli, HcoValidNamespace, true)
 
 					err := wh.ValidateUpdate(&newHco, hco)
 					Expect(err).To(HaveOccurred())
@@ -616,7 +623,7 @@ var _ = Describe("webhooks validator", func() {
 							},
 						},
 					},
-					"spec.certConfig.ca.duration: value is too small"),
+					`spec.certConfig.ca.duration: Invalid value: "8m0s": value is too small; must be at least 10m0s`),
 				Entry("certConfig.ca.renewBefore is too short",
 					v1beta1.HyperConverged{
 						ObjectMeta: metav1.ObjectMeta{
@@ -636,7 +643,7 @@ var _ = Describe("webhooks validator", func() {
 							},
 						},
 					},
-					"spec.certConfig.ca.renewBefore: value is too small"),
+					`spec.certConfig.ca.renewBefore: Invalid value: "8m0s": value is too small; must be at least 10m0s`),
 				Entry("certConfig.server.duration is too short",
 					v1beta1.HyperConverged{
 						ObjectMeta: metav1.ObjectMeta{
@@ -656,7 +663,7 @@ var _ = Describe("webhooks validator", func() {
 							},
 						},
 					},
-					"spec.certConfig.server.duration: value is too small"),
+					`spec.certConfig.server.duration: Invalid value: "8m0s": value is too small; must be at least 10m0s`),
 				Entry("certConfig.server.renewBefore is too short",
 					v1beta1.HyperConverged{
 						ObjectMeta: metav1.ObjectMeta{
@@ -676,7 +683,7 @@ var _ = Describe("webhooks validator", func() {
 							},
 						},
 					},
-					"spec.certConfig.server.renewBefore: value is too small"),
+					`spec.certConfig.server.renewBefore: Invalid value: "8m0s": value is too small; must be at least 10m0s`),
 				Entry("ca: duration is smaller than renewBefore",
 					v1beta1.HyperConverged{
 						ObjectMeta: metav1.ObjectMeta{
@@ -696,7 +703,7 @@ var _ = Describe("webhooks validator", func() {
 							},
 						},
 					},
-					"spec.certConfig.ca: duration is smaller than renewBefore"),
+					`spec.certConfig.ca.renewBefore: Invalid value: "24h0m0s": must not be greater than spec.certConfig.ca.duration`),
 				Entry("server: duration is smaller than renewBefore",
 					v1beta1.HyperConverged{
 						ObjectMeta: metav1.ObjectMeta{
@@ -716,7 +723,7 @@ var _ = Describe("webhooks validator", func() {
 							},
 						},
 					},
-					"spec.certConfig.server: duration is smaller than renewBefore"),
+					`spec.certConfig.server.renewBefore: Invalid value: "12h0m0s": must not be greater than spec.certConfig.server.duration`),
 				Entry("ca.duration is smaller than server.duration",
 					v1beta1.HyperConverged{
 						ObjectMeta: metav1.ObjectMeta{
@@ -736,13 +743,294 @@ var _ = Describe("webhooks validator", func() {
 							},
 						},
 					},
-					"spec.certConfig: ca.duration is smaller than server.duration"),
+					`spec.certConfig.server.duration: Invalid value: "96h0m0s": must not be greater than spec.certConfig.ca.duration`),
 			)
 
 		})
 
 	})
 
+	Context("validate node placements", func() {
+		var hco *v1beta1.HyperConverged
+
+		BeforeEach(func() {
+			Expect(os.Setenv("OPERATOR_NAMESPACE", HcoValidNamespace)).To(BeNil())
+			hco = commonTestUtils.NewHco()
+		})
+
+		It("should accept a node placement that matches a schedulable node", func() {
+			hco.Spec.Infra.NodePlacement = newHyperConvergedConfig()
+			cli := commonTestUtils.InitClient([]runtime.Object{newNode()})
+			wh := NewWebhookHandler(logger, cli, cli, HcoValidNamespace, true)
+
+			Expect(wh.ValidateCreate(hco)).To(Succeed())
+		})
+
+		It("should reject a node placement that does not match any node", func() {
+			hco.Spec.Workloads.NodePlacement = &sdkapi.NodePlacement{
+				NodeSelector: map[string]string{"key1": "other-value"},
+			}
+			cli := commonTestUtils.InitClient([]runtime.Object{newNode()})
+			wh := NewWebhookHandler(logger, cli, cli, HcoValidNamespace, true)
+
+			err := wh.ValidateCreate(hco)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.workloads.nodePlacement"))
+		})
+
+		It("should reject a node placement that matches only unschedulable nodes", func() {
+			hco.Spec.Infra.NodePlacement = newHyperConvergedConfig()
+			node := newNode()
+			node.Spec.Unschedulable = true
+			cli := commonTestUtils.InitClient([]runtime.Object{node})
+			wh := NewWebhookHandler(logger, cli, cli, HcoValidNamespace, true)
+
+			err := wh.ValidateCreate(hco)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.infra.nodePlacement"))
+		})
+
+		It("should reject a node placement that does not tolerate the node taints", func() {
+			hco.Spec.Infra.NodePlacement = &sdkapi.NodePlacement{
+				NodeSelector: map[string]string{"key1": "value1"},
+			}
+			node := newNode()
+			node.Spec.Taints = []corev1.Taint{{Key: "key1", Value: "value1", Effect: corev1.TaintEffectNoSchedule}}
+			cli := commonTestUtils.InitClient([]runtime.Object{node})
+			wh := NewWebhookHandler(logger, cli, cli, HcoValidNamespace, true)
+
+			Expect(wh.ValidateCreate(hco)).ToNot(Succeed())
+
+			hco.Spec.Infra.NodePlacement.Tolerations = []corev1.Toleration{
+				{Key: "key1", Operator: corev1.TolerationOpEqual, Value: "value1", Effect: corev1.TaintEffectNoSchedule},
+			}
+			Expect(wh.ValidateCreate(hco)).To(Succeed())
+		})
+
+		It("should reject a node affinity that does not match any node", func() {
+			hco.Spec.Infra.NodePlacement = newHyperConvergedConfig()
+			hco.Spec.Infra.NodePlacement.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms[0].MatchFields[0].Values = []string{"node2"}
+			cli := commonTestUtils.InitClient([]runtime.Object{newNode()})
+			wh := NewWebhookHandler(logger, cli, cli, HcoValidNamespace, true)
+
+			Expect(wh.ValidateCreate(hco)).ToNot(Succeed())
+		})
+
+		It("should reject a component node placement override that does not match any node", func() {
+			hco.Spec.NodePlacementOverrides = []v1beta1.HyperConvergedNodePlacementOverride{
+				{
+					Component: "storage",
+					Workloads: v1beta1.HyperConvergedConfig{
+						NodePlacement: &sdkapi.NodePlacement{
+							NodeSelector: map[string]string{"storage": "true"},
+						},
+					},
+				},
+			}
+			cli := commonTestUtils.InitClient([]runtime.Object{newNode()})
+			wh := NewWebhookHandler(logger, cli, cli, HcoValidNamespace, true)
+
+			err := wh.ValidateCreate(hco)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.nodePlacementOverrides[0].workloads.nodePlacement"))
+		})
+
+		It("should reject a node maintenance operator node placement that does not match any node", func() {
+			hco.Spec.NodeMaintenance = &v1beta1.HyperConvergedNodeMaintenanceConfig{
+				NodePlacement: &sdkapi.NodePlacement{
+					NodeSelector: map[string]string{"key1": "other-value"},
+				},
+			}
+			cli := commonTestUtils.InitClient([]runtime.Object{newNode()})
+			wh := NewWebhookHandler(logger, cli, cli, HcoValidNamespace, true)
+
+			err := wh.ValidateCreate(hco)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.nodeMaintenance.nodePlacement"))
+		})
+
+		It("should validate only modified node placements on update", func() {
+			hco.Spec.Infra.NodePlacement = &sdkapi.NodePlacement{
+				NodeSelector: map[string]string{"no-such-label": "true"},
+			}
+			cli := getFakeClient(hco)
+			wh := NewWebhookHandler(logger, cli, cli, HcoValidNamespace, true)
+
+			newHco := hco.DeepCopy()
+			newHco.Spec.Workloads.NodePlacement = newHyperConvergedConfig()
+			Expect(wh.ValidateUpdate(newHco, hco)).To(Succeed())
+
+			newHco.Spec.Workloads.NodePlacement.NodeSelector["no-such-label"] = "true"
+			err := wh.ValidateUpdate(newHco, hco)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.workloads.nodePlacement"))
+		})
+	})
+
+	Context("validate overrides", func() {
+		var hco *v1beta1.HyperConverged
+
+		BeforeEach(func() {
+			Expect(os.Setenv("OPERATOR_NAMESPACE", HcoValidNamespace)).To(BeNil())
+			hco = commonTestUtils.NewHco()
+		})
+
+		newOverride := func(kind, name string, patchType v1beta1.OverridePatchType, patch string) v1beta1.HyperConvergedOverride {
+			return v1beta1.HyperConvergedOverride{
+				Target:    v1beta1.HyperConvergedOverrideTarget{Kind: kind, Name: name},
+				PatchType: patchType,
+				Patch:     patch,
+				Reason:    "testing",
+				Owner:     "tester",
+			}
+		}
+
+		It("should accept valid overrides", func() {
+			hco.Spec.Overrides = []v1beta1.HyperConvergedOverride{
+				newOverride("KubeVirt", "", v1beta1.OverridePatchTypeJSON, validKvAnnotation),
+				newOverride("SSP", "", v1beta1.OverridePatchTypeStrategicMerge, `{"spec": {"templateValidator": {"replicas": 3}}}`),
+				newOverride("ConfigMap", "kubevirt-storage-class-defaults", v1beta1.OverridePatchTypeStrategicMerge, `{"data": {"accessMode": "ReadWriteMany"}}`),
+			}
+			cli := commonTestUtils.InitClient([]runtime.Object{})
+			wh := NewWebhookHandler(logger, cli, cli, HcoValidNamespace, true)
+
+			Expect(wh.ValidateCreate(hco)).To(Succeed())
+		})
+
+		It("should reject an override that modifies fields other than the spec", func() {
+			hco.Spec.Overrides = []v1beta1.HyperConvergedOverride{
+				newOverride("CDI", "", v1beta1.OverridePatchTypeJSON, `[{"op": "add", "path": "/metadata/labels/foo", "value": "bar"}]`),
+			}
+			cli := commonTestUtils.InitClient([]runtime.Object{})
+			wh := NewWebhookHandler(logger, cli, cli, HcoValidNamespace, true)
+
+			err := wh.ValidateCreate(hco)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.overrides[0]: can only modify spec fields"))
+		})
+
+		It("should reject a ConfigMap override with no target name", func() {
+			hco.Spec.Overrides = []v1beta1.HyperConvergedOverride{
+				newOverride("ConfigMap", "", v1beta1.OverridePatchTypeStrategicMerge, `{"data": {"accessMode": "ReadWriteMany"}}`),
+			}
+			cli := commonTestUtils.InitClient([]runtime.Object{})
+			wh := NewWebhookHandler(logger, cli, cli, HcoValidNamespace, true)
+
+			err := wh.ValidateCreate(hco)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("the target name is required for ConfigMaps"))
+		})
+
+		It("should reject an override that does not match the operand schema", func() {
+			hco.Spec.Overrides = []v1beta1.HyperConvergedOverride{
+				newOverride("NetworkAddonsConfig", "", v1beta1.OverridePatchTypeJSON, validCnaAnnotation),
+				newOverride("KubeVirt", "", v1beta1.OverridePatchTypeJSON, `[{"op": "add", "path": "/spec/noSuchField", "value": true}]`),
+			}
+			cli := commonTestUtils.InitClient([]runtime.Object{})
+			wh := NewWebhookHandler(logger, cli, cli, HcoValidNamespace, true)
+
+			err := wh.ValidateCreate(hco)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.overrides[1]"))
+		})
+
+		It("should reject an override that can't be applied on update", func() {
+			cli := getFakeClient(hco)
+			wh := NewWebhookHandler(logger, cli, cli, HcoValidNamespace, true)
+
+			newHco := hco.DeepCopy()
+			newHco.Spec.Overrides = []v1beta1.HyperConvergedOverride{
+				newOverride("CDI", "", v1beta1.OverridePatchTypeJSON, `[{"op": "remove", "path": "/spec/noSuchField"}]`),
+			}
+
+			err := wh.ValidateUpdate(newHco, hco)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.overrides[0]"))
+		})
+	})
+
+	Context("validate the VDDK init image", func() {
+		var hco *v1beta1.HyperConverged
+
+		BeforeEach(func() {
+			Expect(os.Setenv("OPERATOR_NAMESPACE", HcoValidNamespace)).To(BeNil())
+			hco = commonTestUtils.NewHco()
+		})
+
+		DescribeTable("should accept a valid image reference",
+			func(image string) {
+				hco.Spec.VddkInitImage = &image
+				Expect(validateVddkInitImage(hco.Spec.VddkInitImage, field.NewPath("spec", "vddkInitImage"))).To(BeEmpty())
+			},
+			Entry("empty", ""),
+			Entry("repository only", "vddk"),
+			Entry("registry and tag", "quay.io/kubevirt/vddk:v7.0.2"),
+			Entry("registry with a port", "registry.local:5000/vddk/vddk-init"),
+			Entry("digest", "quay.io/kubevirt/vddk@sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"),
+		)
+
+		DescribeTable("should reject an invalid image reference",
+			func(image string) {
+				hco.Spec.VddkInitImage = &image
+				errs := validateVddkInitImage(hco.Spec.VddkInitImage, field.NewPath("spec", "vddkInitImage"))
+				Expect(errs).To(HaveLen(1))
+				Expect(errs[0].Type).To(Equal(field.ErrorTypeInvalid))
+				Expect(errs[0].Field).To(Equal("spec.vddkInitImage"))
+			},
+			Entry("whitespace", "quay.io/kubevirt/vddk image"),
+			Entry("upper case repository", "quay.io/kubevirt/VDDK"),
+			Entry("empty tag", "quay.io/kubevirt/vddk:"),
+			Entry("bad digest", "quay.io/kubevirt/vddk@sha256:xyz"),
+		)
+
+		It("should reject the creation and the update of a resource with an invalid VDDK init image", func() {
+			cli := fake.NewClientBuilder().WithScheme(s).Build()
+			wh := NewWebhookHandler(logger, cli, cli, HcoValidNamespace, true)
+
+			image := "not a valid image"
+			hco.Spec.VddkInitImage = &image
+			err := wh.ValidateCreate(hco)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring(`spec.vddkInitImage: Invalid value: "not a valid image": is not a valid image reference`))
+
+			exists := commonTestUtils.NewHco()
+			err = wh.ValidateUpdate(hco, exists)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring(`spec.vddkInitImage: Invalid value: "not a valid image": is not a valid image reference`))
+		})
+
+		It("should report the VDDK init image error together with the other spec errors", func() {
+			cli := fake.NewClientBuilder().WithScheme(s).Build()
+			wh := NewWebhookHandler(logger, cli, cli, HcoValidNamespace, true)
+
+			image := "not a valid image"
+			hco.Spec.VddkInitImage = &image
+			hco.Spec.CertConfig.CA.Duration = metav1.Duration{Duration: 8 * time.Minute}
+
+			err := wh.ValidateCreate(hco)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+
+			var fields []string
+			for _, cause := range err.(apierrors.APIStatus).Status().Details.Causes {
+				fields = append(fields, cause.Field)
+			}
+			Expect(fields).To(ContainElements("spec.vddkInitImage", "spec.certConfig.ca.duration"))
+		})
+
+		It("should accept an update of a resource that already has an invalid VDDK init image, if it is not changed", func() {
+			image := "not a valid image"
+			exists := commonTestUtils.NewHco()
+			exists.Spec.VddkInitImage = &image
+			cli := getFakeClient(exists)
+			wh := NewWebhookHandler(logger, cli, cli, HcoValidNamespace, true)
+
+			hco.Spec.VddkInitImage = &image
+			hco.Spec.FeatureGates.WithHostPassthroughCPU = boolPtr(true)
+
+			Expect(wh.ValidateUpdate(hco, exists)).To(Succeed())
+		})
+	})
+
 	Context("validate delete validation webhook", func() {
 		var hco *v1beta1.HyperConverged
 
@@ -758,7 +1046,7 @@ var _ = Describe("webhooks validator", func() {
 		It("should validate deletion", func() {
 			cli := getFakeClient(hco)
 
-			wh := NewWebhookHandler(logger, cli, HcoValidNamespace, true)
+			wh := NewWebhookHandler(logger, cli, cli, HcoValidNamespace, true)
 
 			err := wh.ValidateDelete(hco)
 			Expect(err).ToNot(HaveOccurred())
@@ -777,7 +1065,7 @@ var _ = Describe("webhooks validator", func() {
 		It("should reject if KV deletion fails", func() {
 			cli := getFakeClient(hco)
 
-			wh := NewWebhookHandler(logger, cli, HcoValidNamespace, true)
+			wh := NewWebhookHandler(logger, cli, cli, HcoValidNamespace, true)
 
 			cli.InitiateDeleteErrors(func(obj client.Object) error {
 				if unstructed, ok := obj.(runtime.Unstructured); ok {
@@ -797,7 +1085,7 @@ var _ = Describe("webhooks validator", func() {
 		It("should reject if CDI deletion fails", func() {
 			cli := getFakeClient(hco)
 
-			wh := NewWebhookHandler(logger, cli, HcoValidNamespace, true)
+			wh := NewWebhookHandler(logger, cli, cli, HcoValidNamespace, true)
 
 			cli.InitiateDeleteErrors(func(obj client.Object) error {
 				if unstructed, ok := obj.(runtime.Unstructured); ok {
@@ -821,7 +1109,7 @@ var _ = Describe("webhooks validator", func() {
 			kv := operands.NewKubeVirtWithNameOnly(hco)
 			Expect(cli.Delete(ctx, kv)).To(BeNil())
 
-			wh := NewWebhookHandler(logger, cli, HcoValidNamespace, true)
+			wh := NewWebhookHandler(logger, cli, cli, HcoValidNamespace, true)
 
 			err := wh.ValidateDelete(hco)
 			Expect(err).ToNot(HaveOccurred())
@@ -830,7 +1118,7 @@ var _ = Describe("webhooks validator", func() {
 		It("should reject if getting KV failed for not-not-exists error", func() {
 			cli := getFakeClient(hco)
 
-			wh := NewWebhookHandler(logger, cli, HcoValidNamespace, true)
+			wh := NewWebhookHandler(logger, cli, cli, HcoValidNamespace, true)
 
 			cli.InitiateGetErrors(func(key client.ObjectKey) error {
 				if key.Name == "kubevirt-kubevirt-hyperconverged" {
@@ -851,7 +1139,7 @@ var _ = Describe("webhooks validator", func() {
 			cdi := operands.NewCDIWithNameOnly(hco)
 			Expect(cli.Delete(ctx, cdi)).To(BeNil())
 
-			wh := NewWebhookHandler(logger, cli, HcoValidNamespace, true)
+			wh := NewWebhookHandler(logger, cli, cli, HcoValidNamespace, true)
 
 			err := wh.ValidateDelete(hco)
 			Expect(err).ToNot(HaveOccurred())
@@ -860,7 +1148,7 @@ var _ = Describe("webhooks validator", func() {
 		It("should reject if getting CDI failed for not-not-exists error", func() {
 			cli := getFakeClient(hco)
 
-			wh := NewWebhookHandler(logger, cli, HcoValidNamespace, true)
+			wh := NewWebhookHandler(logger, cli, cli, HcoValidNamespace, true)
 
 			cli.InitiateGetErrors(func(key client.ObjectKey) error {
 				if key.Name == "cdi-kubevirt-hyperconverged" {
@@ -885,7 +1173,7 @@ var _ = Describe("webhooks validator", func() {
 		DescribeTable("should accept if annotation is valid",
 			func(annotationName, annotation string) {
 				cli := getFakeClient(hco)
-				wh := NewWebhookHandler(logger, cli, HcoValidNamespace, true)
+				wh := NewWebhookHandler(logger, cli, cli, HcoValidNamespace, true)
 
 				newHco := &v1beta1.HyperConverged{}
 				hco.DeepCopyInto(newHco)
@@ -904,7 +1192,7 @@ var _ = Describe("webhooks validator", func() {
 				cli := getFakeClient(hco)
 				cli.InitiateUpdateErrors(initiateTimeout)
 
-				wh := NewWebhookHandler(logger, cli, HcoValidNamespace, true)
+				wh := NewWebhookHandler(logger, cli, cli, HcoValidNamespace, true)
 
 				newHco := &v1beta1.HyperConverged{}
 				hco.DeepCopyInto(newHco)
@@ -937,12 +1225,11 @@ func newHyperConvergedConfig() *sdkapi.NodePlacement {
 					NodeSelectorTerms: []corev1.NodeSelectorTerm{
 						{
 							MatchExpressions: []corev1.NodeSelectorRequirement{
-								{Key: "key1", Operator: "operator1", Values: []string{"value11, value12"}},
-								{Key: "key2", Operator: "operator2", Values: []string{"value21, value22"}},
+								{Key: "key1", Operator: corev1.NodeSelectorOpIn, Values: []string{"value1", "value11"}},
+								{Key: "key2", Operator: corev1.NodeSelectorOpExists},
 							},
 							MatchFields: []corev1.NodeSelectorRequirement{
-								{Key: "key1", Operator: "operator1", Values: []string{"value11, value12"}},
-								{Key: "key2", Operator: "operator2", Values: []string{"value21, value22"}},
+								{Key: "metadata.name", Operator: corev1.NodeSelectorOpIn, Values: []string{"node1", "node2"}},
 							},
 						},
 					},
@@ -966,7 +1253,22 @@ func getFakeClient(hco *v1beta1.HyperConverged) *commonTestUtils.HcoTestClient {
 	cna, err := operands.NewNetworkAddons(hco)
 	Expect(err).ToNot(HaveOccurred())
 
-	return commonTestUtils.InitClient([]runtime.Object{hco, kv, cdi, cna, operands.NewSSP(hco)})
+	return commonTestUtils.InitClient([]runtime.Object{hco, kv, cdi, cna, operands.NewSSP(hco), newNode()})
+}
+
+// a schedulable node, that matches the node placement returned by newHyperConvergedConfig, and its modifications
+func newNode() *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "node1",
+			Labels: map[string]string{
+				"key1":     "value1",
+				"key2":     "value2",
+				"key3":     "value3",
+				"a change": "Something else",
+			},
+		},
+	}
 }
 
 type fakeFailure int
@@ -0,0 +1,229 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/sets"
+	kubevirtv1 "kubevirt.io/client-go/api/v1"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/controller/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/controller/operands"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+// jsonPatchAnnotations are the annotations that patch the operand CRs; they are superseded by spec.overrides
+var jsonPatchAnnotations = []string{
+	common.JSONPatchKVAnnotationName,
+	common.JSONPatchCDIAnnotationName,
+	common.JSONPatchCNAOAnnotationName,
+}
+
+// deprecatedField is a v1beta1 field that is scheduled for removal, together with the v1beta1 API
+type deprecatedField struct {
+	path        string
+	replacement string
+	isSet       func(spec *v1beta1.HyperConvergedSpec) bool
+}
+
+var deprecatedFields = []deprecatedField{
+	{
+		path:        "spec.liveMigrationConfig",
+		replacement: "spec.migration",
+		isSet: func(spec *v1beta1.HyperConvergedSpec) bool {
+			defaults := &v1beta1.HyperConverged{}
+			defaults.Default()
+			return !reflect.DeepEqual(spec.LiveMigrationConfig, defaults.Spec.LiveMigrationConfig)
+		},
+	},
+	{
+		path:        "spec.permittedHostDevices",
+		replacement: "spec.compute.permittedHostDevices",
+		isSet:       func(spec *v1beta1.HyperConvergedSpec) bool { return spec.PermittedHostDevices != nil },
+	},
+	{
+		path:        "spec.obsoleteCPUs",
+		replacement: "spec.compute.obsoleteCPUs",
+		isSet:       func(spec *v1beta1.HyperConvergedSpec) bool { return spec.ObsoleteCPUs != nil },
+	},
+	{
+		path:        "spec.workloadUpdateStrategy",
+		replacement: "spec.compute.workloadUpdateStrategy",
+		isSet:       func(spec *v1beta1.HyperConvergedSpec) bool { return spec.WorkloadUpdateStrategy != nil },
+	},
+	{
+		path:        "spec.commonTemplatesNamespace",
+		replacement: "spec.compute.commonTemplatesNamespace",
+		isSet:       func(spec *v1beta1.HyperConvergedSpec) bool { return spec.CommonTemplatesNamespace != nil },
+	},
+	{
+		path:        "spec.localStorageClassName",
+		replacement: "spec.storage.localStorageClassName",
+		isSet:       func(spec *v1beta1.HyperConvergedSpec) bool { return spec.LocalStorageClassName != "" },
+	},
+	{
+		path:        "spec.scratchSpaceStorageClass",
+		replacement: "spec.storage.scratchSpaceStorageClass",
+		isSet:       func(spec *v1beta1.HyperConvergedSpec) bool { return spec.ScratchSpaceStorageClass != nil },
+	},
+	{
+		path:        "spec.vddkInitImage",
+		replacement: "spec.storage.vddkInitImage",
+		isSet:       func(spec *v1beta1.HyperConvergedSpec) bool { return spec.VddkInitImage != nil },
+	},
+	{
+		path:        "spec.storageImport",
+		replacement: "spec.storage.import",
+		isSet:       func(spec *v1beta1.HyperConvergedSpec) bool { return spec.StorageImport != nil },
+	},
+	{
+		path:        "spec.dataImportCronTemplates",
+		replacement: "spec.storage.dataImportCronTemplates",
+		isSet:       func(spec *v1beta1.HyperConvergedSpec) bool { return len(spec.DataImportCronTemplates) > 0 },
+	},
+}
+
+// getWarnings returns the admission warnings for the settings of the HyperConverged CR that are valid, but risky or
+// deprecated. apiVersion is the API version of the request, before the API server converted it. When validating an
+// update, exists is the HyperConverged CR before the update; otherwise, it is nil.
+func (wh WebhookHandler) getWarnings(ctx context.Context, apiVersion string, requested *v1beta1.HyperConverged, exists *v1beta1.HyperConverged) []string {
+	var warnings []string
+
+	if warning, err := wh.warnHostPassthroughCPU(ctx, requested); err != nil {
+		wh.logger.Error(err, "failed to check the CPU models of the nodes")
+	} else if warning != "" {
+		warnings = append(warnings, warning)
+	}
+
+	warnings = append(warnings, warnJSONPatchAnnotations(requested)...)
+
+	if warning := warnBandwidthPerMigration(requested); warning != "" {
+		warnings = append(warnings, warning)
+	}
+
+	if exists != nil {
+		if warning, err := wh.warnWorkloadsPlacementChange(ctx, requested, exists); err != nil {
+			wh.logger.Error(err, "failed to list the workloads")
+		} else if warning != "" {
+			warnings = append(warnings, warning)
+		}
+	}
+
+	if apiVersion == hcoutil.APIVersionBeta {
+		warnings = append(warnings, warnDeprecatedFields(requested)...)
+	}
+
+	return warnings
+}
+
+// warnHostPassthroughCPU warns if the host-passthrough CPU is allowed, while the compute workloads nodes have different
+// CPU models, as detected by the KubeVirt node labeller
+func (wh WebhookHandler) warnHostPassthroughCPU(ctx context.Context, hc *v1beta1.HyperConverged) (string, error) {
+	if !hc.Spec.FeatureGates.IsWithHostPassthroughCPUEnabled() {
+		return "", nil
+	}
+
+	nodes := &corev1.NodeList{}
+	if err := wh.cli.List(ctx, nodes); err != nil {
+		return "", err
+	}
+
+	models := sets.NewString()
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+		if node.Spec.Unschedulable {
+			continue
+		}
+
+		if placement := operands.GetWorkloadsNodePlacement(hc, hcoutil.AppComponentCompute); placement != nil && !nodeMatchesPlacement(node, placement) {
+			continue
+		}
+
+		for label := range node.Labels {
+			if strings.HasPrefix(label, kubevirtv1.HostModelCPULabel) {
+				models.Insert(strings.TrimPrefix(label, kubevirtv1.HostModelCPULabel))
+			}
+		}
+	}
+
+	if models.Len() < 2 {
+		return "", nil
+	}
+
+	return fmt.Sprintf("spec.featureGates.withHostPassthroughCPU: the workloads nodes have different CPU models (%s); "+
+		"a VM with a host-passthrough CPU may fail to migrate between them", strings.Join(models.List(), ", ")), nil
+}
+
+func warnJSONPatchAnnotations(hc *v1beta1.HyperConverged) []string {
+	var warnings []string
+	for _, annotation := range jsonPatchAnnotations {
+		if _, found := hc.Annotations[annotation]; found {
+			warnings = append(warnings, fmt.Sprintf("metadata.annotations[%s]: the JSON patch annotations are not supported, "+
+				"and may be dropped without a deprecation process; use spec.overrides instead", annotation))
+		}
+	}
+	return warnings
+}
+
+// warnBandwidthPerMigration warns if the bandwidth of a migration is too low to copy the memory of the VM before the
+// migration is canceled by the completion timeout, even if the memory is not modified during the migration
+func warnBandwidthPerMigration(hc *v1beta1.HyperConverged) string {
+	lmc := hc.Spec.LiveMigrationConfig
+	if lmc.BandwidthPerMigration == nil {
+		return ""
+	}
+
+	bandwidth, err := resource.ParseQuantity(*lmc.BandwidthPerMigration)
+	if err != nil || bandwidth.Sign() <= 0 {
+		return ""
+	}
+
+	completionTimeoutPerGiB := v1beta1.DefaultCompletionTimeoutPerGiB
+	if lmc.CompletionTimeoutPerGiB != nil {
+		completionTimeoutPerGiB = *lmc.CompletionTimeoutPerGiB
+	}
+
+	copyTimePerGiB := float64(1<<30) / bandwidth.AsApproximateFloat64()
+	if copyTimePerGiB < float64(completionTimeoutPerGiB) {
+		return ""
+	}
+
+	return fmt.Sprintf("spec.liveMigrationConfig.bandwidthPerMigration: copying 1Gi of memory at %s per second takes %.0f seconds, "+
+		"while the migrations are canceled after %d seconds per GiB; the migrations will time out",
+		*lmc.BandwidthPerMigration, math.Ceil(copyTimePerGiB), completionTimeoutPerGiB)
+}
+
+// warnWorkloadsPlacementChange warns if a workloads placement change, that strands the running VMIs or the DataVolumes
+// in progress, is forced by the ForceWorkloadsPlacementChangeAnnotation annotation
+func (wh WebhookHandler) warnWorkloadsPlacementChange(ctx context.Context, requested *v1beta1.HyperConverged, exists *v1beta1.HyperConverged) (string, error) {
+	change := getWorkloadsPlacementChange(requested, exists)
+	if change.isEmpty() || !isWorkloadsPlacementChangeForced(requested) {
+		return "", nil
+	}
+
+	stranded, err := wh.getStrandedWorkloads(ctx, requested, change)
+	if err != nil || stranded.isEmpty() {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s: the workloads placement change was forced by the %s annotation, while there are workloads "+
+		"that the change strands (%s); the VMIs should be migrated or stopped, and the DataVolumes may need to be recreated",
+		change.path, hcoutil.ForceWorkloadsPlacementChangeAnnotation, stranded), nil
+}
+
+func warnDeprecatedFields(hc *v1beta1.HyperConverged) []string {
+	var warnings []string
+	for _, field := range deprecatedFields {
+		if field.isSet(&hc.Spec) {
+			warnings = append(warnings, fmt.Sprintf("%s is replaced by %s in %s/%s, and will be removed together with %s/%s",
+				field.path, field.replacement, hcoutil.APIVersionGroup, hcoutil.APIVersionV1, hcoutil.APIVersionGroup, hcoutil.APIVersionBeta))
+		}
+	}
+	return warnings
+}
@@ -0,0 +1,293 @@
+package validator
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubevirtv1 "kubevirt.io/client-go/api/v1"
+	sdkapi "kubevirt.io/controller-lifecycle-operator-sdk/pkg/sdk/api"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/controller/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/controller/commonTestUtils"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+var _ = Describe("admission warnings", func() {
+	var hco *v1beta1.HyperConverged
+
+	BeforeEach(func() {
+		Expect(os.Setenv("OPERATOR_NAMESPACE", HcoValidNamespace)).To(BeNil())
+		hco = commonTestUtils.NewHco()
+	})
+
+	newHandler := func(vmis ...*kubevirtv1.VirtualMachineInstance) *WebhookHandler {
+		cli := getFakeClient(hco)
+		for _, vmi := range vmis {
+			Expect(cli.Create(context.TODO(), vmi)).To(Succeed())
+		}
+		return NewWebhookHandler(logger, cli, cli, HcoValidNamespace, true)
+	}
+
+	It("should not warn for the default HyperConverged CR", func() {
+		wh := newHandler()
+		Expect(wh.getWarnings(context.TODO(), "v1", hco, nil)).To(BeEmpty())
+		Expect(wh.getWarnings(context.TODO(), "v1beta1", hco, hco.DeepCopy())).To(BeEmpty())
+	})
+
+	Context("host-passthrough CPU", func() {
+		newCPUNode := func(name, model string, labels map[string]string) *corev1.Node {
+			node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{kubevirtv1.HostModelCPULabel + model: "true"}}}
+			for key, value := range labels {
+				node.Labels[key] = value
+			}
+			return node
+		}
+
+		getWarning := func(nodes ...*corev1.Node) string {
+			cli := commonTestUtils.InitClient([]runtime.Object{hco})
+			for _, node := range nodes {
+				Expect(cli.Create(context.TODO(), node)).To(Succeed())
+			}
+			wh := NewWebhookHandler(logger, cli, cli, HcoValidNamespace, true)
+
+			warning, err := wh.warnHostPassthroughCPU(context.TODO(), hco)
+			Expect(err).ToNot(HaveOccurred())
+			return warning
+		}
+
+		BeforeEach(func() {
+			hco.Spec.FeatureGates.WithHostPassthroughCPU = boolPtr(true)
+		})
+
+		It("should warn if the nodes have different CPU models", func() {
+			Expect(getWarning(newCPUNode("node1", "Skylake-Client-IBRS", nil), newCPUNode("node2", "Haswell-noTSX", nil))).
+				To(Equal("spec.featureGates.withHostPassthroughCPU: the workloads nodes have different CPU models (Haswell-noTSX, Skylake-Client-IBRS); " +
+					"a VM with a host-passthrough CPU may fail to migrate between them"))
+		})
+
+		It("should not warn if the nodes have the same CPU model", func() {
+			Expect(getWarning(newCPUNode("node1", "Haswell-noTSX", nil), newCPUNode("node2", "Haswell-noTSX", nil))).To(BeEmpty())
+		})
+
+		It("should ignore the nodes that are not in the workloads placement", func() {
+			hco.Spec.Workloads.NodePlacement = &sdkapi.NodePlacement{NodeSelector: map[string]string{"workloads": "true"}}
+			Expect(getWarning(
+				newCPUNode("node1", "Haswell-noTSX", map[string]string{"workloads": "true"}),
+				newCPUNode("node2", "Skylake-Client-IBRS", nil),
+			)).To(BeEmpty())
+		})
+
+		It("should ignore the nodes that are not in the compute workloads placement override", func() {
+			hco.Spec.Workloads.NodePlacement = &sdkapi.NodePlacement{NodeSelector: map[string]string{"workloads": "true"}}
+			hco.Spec.NodePlacementOverrides = []v1beta1.HyperConvergedNodePlacementOverride{
+				{
+					Component: string(hcoutil.AppComponentCompute),
+					Workloads: v1beta1.HyperConvergedConfig{
+						NodePlacement: &sdkapi.NodePlacement{NodeSelector: map[string]string{"compute": "true"}},
+					},
+				},
+			}
+			Expect(getWarning(
+				newCPUNode("node1", "Haswell-noTSX", map[string]string{"compute": "true"}),
+				newCPUNode("node2", "Skylake-Client-IBRS", map[string]string{"workloads": "true"}),
+			)).To(BeEmpty())
+		})
+
+		It("should not warn if the feature gate is not enabled", func() {
+			hco.Spec.FeatureGates.WithHostPassthroughCPU = boolPtr(false)
+			Expect(getWarning(newCPUNode("node1", "Skylake-Client-IBRS", nil), newCPUNode("node2", "Haswell-noTSX", nil))).To(BeEmpty())
+		})
+	})
+
+	It("should warn for each JSON patch annotation", func() {
+		hco.Annotations = map[string]string{
+			common.JSONPatchKVAnnotationName:   validKvAnnotation,
+			common.JSONPatchCNAOAnnotationName: validCnaAnnotation,
+		}
+
+		Expect(warnJSONPatchAnnotations(hco)).To(ConsistOf(
+			ContainSubstring(common.JSONPatchKVAnnotationName),
+			ContainSubstring(common.JSONPatchCNAOAnnotationName),
+		))
+	})
+
+	DescribeTable("bandwidth per migration",
+		func(bandwidth string, completionTimeoutPerGiB *int64, warn bool) {
+			hco.Spec.LiveMigrationConfig.BandwidthPerMigration = &bandwidth
+			if completionTimeoutPerGiB != nil {
+				hco.Spec.LiveMigrationConfig.CompletionTimeoutPerGiB = completionTimeoutPerGiB
+			}
+
+			if warn {
+				Expect(warnBandwidthPerMigration(hco)).To(HavePrefix("spec.liveMigrationConfig.bandwidthPerMigration: "))
+			} else {
+				Expect(warnBandwidthPerMigration(hco)).To(BeEmpty())
+			}
+		},
+		Entry("should not warn for a bandwidth that copies 1Gi within the timeout", "64Mi", nil, false),
+		Entry("should warn for a bandwidth that copies 1Gi after the timeout", "1Mi", nil, true),
+		Entry("should not warn if the timeout is long enough", "1Mi", int64Ptr(2000), false),
+		Entry("should not warn for an unlimited bandwidth", "0", nil, false),
+		Entry("should not warn for an invalid bandwidth", "fast", nil, false),
+	)
+
+	Context("workloads placement", func() {
+		var requested *v1beta1.HyperConverged
+
+		BeforeEach(func() {
+			requested = hco.DeepCopy()
+			requested.Spec.Workloads.NodePlacement = &sdkapi.NodePlacement{NodeSelector: map[string]string{"workloads": "true"}}
+			requested.Annotations = map[string]string{hcoutil.ForceWorkloadsPlacementChangeAnnotation: "true"}
+		})
+
+		It("should warn if a forced change strands running VMIs", func() {
+			wh := newHandler(newWorkloadsVMI("vmi", "node1", kubevirtv1.Running))
+			warning, err := wh.warnWorkloadsPlacementChange(context.TODO(), requested, hco)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warning).To(HavePrefix("spec.workloads: the workloads placement change was forced by the " + hcoutil.ForceWorkloadsPlacementChangeAnnotation))
+			Expect(warning).To(ContainSubstring("(VMIs: default/vmi)"))
+		})
+
+		It("should warn if a forced change of only the compute override strands running VMIs", func() {
+			wh := newHandler(newWorkloadsVMI("vmi", "node1", kubevirtv1.Running))
+			requested.Spec.Workloads = hco.Spec.Workloads
+			requested.Spec.NodePlacementOverrides = []v1beta1.HyperConvergedNodePlacementOverride{
+				{
+					Component: string(hcoutil.AppComponentCompute),
+					Workloads: v1beta1.HyperConvergedConfig{
+						NodePlacement: &sdkapi.NodePlacement{NodeSelector: map[string]string{"compute": "true"}},
+					},
+				},
+			}
+
+			warning, err := wh.warnWorkloadsPlacementChange(context.TODO(), requested, hco)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warning).To(HavePrefix("spec.nodePlacementOverrides: the workloads placement change was forced by the " + hcoutil.ForceWorkloadsPlacementChangeAnnotation))
+			Expect(warning).To(ContainSubstring("(VMIs: default/vmi)"))
+		})
+
+		It("should not warn if the forced change strands no workloads", func() {
+			wh := newHandler()
+			warning, err := wh.warnWorkloadsPlacementChange(context.TODO(), requested, hco)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warning).To(BeEmpty())
+		})
+
+		It("should not warn if the change is not forced", func() {
+			delete(requested.Annotations, hcoutil.ForceWorkloadsPlacementChangeAnnotation)
+			wh := newHandler(newWorkloadsVMI("vmi", "node1", kubevirtv1.Running))
+			warning, err := wh.warnWorkloadsPlacementChange(context.TODO(), requested, hco)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warning).To(BeEmpty())
+		})
+	})
+
+	Context("fields scheduled for removal", func() {
+		It("should warn for the v1beta1 fields that are replaced in v1", func() {
+			hco.Spec.PermittedHostDevices = &v1beta1.PermittedHostDevices{}
+			hco.Spec.LocalStorageClassName = "local"
+			Expect(warnDeprecatedFields(hco)).To(Equal([]string{
+				"spec.permittedHostDevices is replaced by spec.compute.permittedHostDevices in hco.kubevirt.io/v1, and will be removed together with hco.kubevirt.io/v1beta1",
+				"spec.localStorageClassName is replaced by spec.storage.localStorageClassName in hco.kubevirt.io/v1, and will be removed together with hco.kubevirt.io/v1beta1",
+			}))
+		})
+
+		It("should warn for the live migration configuration only if it is not the default", func() {
+			Expect(warnDeprecatedFields(hco)).To(BeEmpty())
+
+			hco.Spec.LiveMigrationConfig.ProgressTimeout = int64Ptr(300)
+			Expect(warnDeprecatedFields(hco)).To(ConsistOf(HavePrefix("spec.liveMigrationConfig is replaced by spec.migration")))
+		})
+
+		It("should not warn if the request was made with the v1 API", func() {
+			hco.Spec.PermittedHostDevices = &v1beta1.PermittedHostDevices{}
+			wh := newHandler()
+			Expect(wh.getWarnings(context.TODO(), "v1", hco, nil)).To(BeEmpty())
+			Expect(wh.getWarnings(context.TODO(), "v1beta1", hco, nil)).To(HaveLen(1))
+		})
+	})
+
+	Context("ValidatingHandler", func() {
+		newRequest := func(operation admissionv1.Operation, requestVersion string, object, oldObject *v1beta1.HyperConverged) admission.Request {
+			req := admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+				Operation:   operation,
+				Kind:        metav1.GroupVersionKind{Group: "hco.kubevirt.io", Version: "v1beta1", Kind: "HyperConverged"},
+				RequestKind: &metav1.GroupVersionKind{Group: "hco.kubevirt.io", Version: requestVersion, Kind: "HyperConverged"},
+			}}
+
+			if object != nil {
+				raw, err := json.Marshal(object)
+				Expect(err).ToNot(HaveOccurred())
+				req.Object = runtime.RawExtension{Raw: raw}
+			}
+
+			if oldObject != nil {
+				raw, err := json.Marshal(oldObject)
+				Expect(err).ToNot(HaveOccurred())
+				req.OldObject = runtime.RawExtension{Raw: raw}
+			}
+
+			return req
+		}
+
+		newValidatingHandler := func() *ValidatingHandler {
+			h := NewValidatingHandler(newHandler())
+			decoder, err := admission.NewDecoder(commonTestUtils.GetScheme())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(h.InjectDecoder(decoder)).To(Succeed())
+			return h
+		}
+
+		It("should allow a valid creation, with warnings", func() {
+			hco.Annotations = map[string]string{common.JSONPatchKVAnnotationName: validKvAnnotation}
+
+			res := newValidatingHandler().Handle(context.TODO(), newRequest(admissionv1.Create, "v1", hco, nil))
+			Expect(res.Allowed).To(BeTrue())
+			Expect(res.Warnings).To(ConsistOf(ContainSubstring(common.JSONPatchKVAnnotationName)))
+		})
+
+		It("should allow a valid update, with warnings", func() {
+			requested := hco.DeepCopy()
+			requested.Spec.ObsoleteCPUs = &v1beta1.HyperConvergedObsoleteCPUs{MinCPUModel: "Penryn"}
+
+			res := newValidatingHandler().Handle(context.TODO(), newRequest(admissionv1.Update, "v1beta1", requested, hco))
+			Expect(res.Allowed).To(BeTrue())
+			Expect(res.Warnings).To(ConsistOf(HavePrefix("spec.obsoleteCPUs is replaced by spec.compute.obsoleteCPUs")))
+		})
+
+		It("should deny an invalid request, with the field errors", func() {
+			batchEvictionSize := 0
+			hco.Spec.WorkloadUpdateStrategy = &v1beta1.HyperConvergedWorkloadUpdateStrategy{BatchEvictionSize: &batchEvictionSize}
+
+			res := newValidatingHandler().Handle(context.TODO(), newRequest(admissionv1.Create, "v1", hco, nil))
+			Expect(res.Allowed).To(BeFalse())
+			Expect(res.Result.Reason).To(Equal(metav1.StatusReasonInvalid))
+			Expect(res.Result.Details.Causes).To(HaveLen(1))
+			Expect(res.Result.Details.Causes[0].Field).To(Equal("spec.workloadUpdateStrategy.batchEvictionSize"))
+		})
+
+		It("should validate a deletion", func() {
+			res := newValidatingHandler().Handle(context.TODO(), newRequest(admissionv1.Delete, "v1beta1", nil, hco))
+			Expect(res.Allowed).To(BeTrue())
+			Expect(res.Warnings).To(BeEmpty())
+		})
+	})
+})
+
+func int64Ptr(i int64) *int64 {
+	return &i
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
@@ -0,0 +1,184 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	kubevirtv1 "kubevirt.io/client-go/api/v1"
+	cdiv1beta1 "kubevirt.io/containerized-data-importer/pkg/apis/core/v1beta1"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/controller/operands"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+// maxListedWorkloads is the maximum number of the VMIs, and of the DataVolumes, that are listed in the messages
+const maxListedWorkloads = 10
+
+// completedDataVolumePhases are the phases of the DataVolumes that are not in progress; that is, that have no
+// importer, cloner or uploader pod that the workloads placement applies to
+var completedDataVolumePhases = sets.NewString(
+	string(cdiv1beta1.PhaseUnset),
+	string(cdiv1beta1.Pending),
+	string(cdiv1beta1.WaitForFirstConsumer),
+	string(cdiv1beta1.Paused),
+	string(cdiv1beta1.Succeeded),
+	string(cdiv1beta1.Failed),
+	string(cdiv1beta1.Unknown),
+)
+
+// strandedWorkloads are the workloads that a workloads placement change would strand: the running VMIs on nodes that
+// are removed from the placement, and the DataVolumes in progress
+type strandedWorkloads struct {
+	vmis        []string
+	dataVolumes []string
+}
+
+func (s strandedWorkloads) isEmpty() bool {
+	return len(s.vmis) == 0 && len(s.dataVolumes) == 0
+}
+
+func (s strandedWorkloads) String() string {
+	var parts []string
+	if len(s.vmis) > 0 {
+		parts = append(parts, fmt.Sprintf("VMIs: %s", joinWorkloads(s.vmis)))
+	}
+	if len(s.dataVolumes) > 0 {
+		parts = append(parts, fmt.Sprintf("DataVolumes in progress: %s", joinWorkloads(s.dataVolumes)))
+	}
+	return strings.Join(parts, "; ")
+}
+
+func joinWorkloads(names []string) string {
+	if len(names) <= maxListedWorkloads {
+		return strings.Join(names, ", ")
+	}
+	return fmt.Sprintf("%s and %d more", strings.Join(names[:maxListedWorkloads], ", "), len(names)-maxListedWorkloads)
+}
+
+// workloadsPlacementChange is the change of the effective workloads placement of the components whose workloads a
+// placement change may strand: the compute component (KubeVirt) that places the VMIs, and the storage component (CDI)
+// that places the pods of the DataVolumes
+type workloadsPlacementChange struct {
+	// path is the field of the change; spec.workloads, or spec.nodePlacementOverrides if only the overrides changed
+	path    *field.Path
+	compute bool
+	storage bool
+}
+
+func getWorkloadsPlacementChange(requested *v1beta1.HyperConverged, exists *v1beta1.HyperConverged) workloadsPlacementChange {
+	change := workloadsPlacementChange{
+		path:    field.NewPath("spec", "workloads"),
+		compute: isWorkloadsNodePlacementChanged(requested, exists, hcoutil.AppComponentCompute),
+		storage: isWorkloadsNodePlacementChanged(requested, exists, hcoutil.AppComponentStorage),
+	}
+
+	if reflect.DeepEqual(requested.Spec.Workloads, exists.Spec.Workloads) {
+		change.path = field.NewPath("spec", "nodePlacementOverrides")
+	}
+
+	return change
+}
+
+func isWorkloadsNodePlacementChanged(requested *v1beta1.HyperConverged, exists *v1beta1.HyperConverged, component hcoutil.AppComponent) bool {
+	return !reflect.DeepEqual(operands.GetWorkloadsNodePlacement(requested, component), operands.GetWorkloadsNodePlacement(exists, component))
+}
+
+func (c workloadsPlacementChange) isEmpty() bool {
+	return !c.compute && !c.storage
+}
+
+// validateWorkloadsPlacementChange denies a change of the workloads placement, that would strand the running VMIs or
+// the DataVolumes in progress, unless the change is forced by the ForceWorkloadsPlacementChangeAnnotation annotation.
+// The operator removes the annotation once it reconciles the change, so it forces only that change.
+func (wh WebhookHandler) validateWorkloadsPlacementChange(ctx context.Context, requested *v1beta1.HyperConverged, exists *v1beta1.HyperConverged) error {
+	change := getWorkloadsPlacementChange(requested, exists)
+	if change.isEmpty() || isWorkloadsPlacementChangeForced(requested) {
+		return nil
+	}
+
+	stranded, err := wh.getStrandedWorkloads(ctx, requested, change)
+	if err != nil {
+		wh.logger.Error(err, "failed to list the workloads")
+		return err
+	}
+
+	if stranded.isEmpty() {
+		return nil
+	}
+
+	return apierrors.NewInvalid(hyperConvergedGroupKind, requested.Name, field.ErrorList{
+		field.Forbidden(change.path, fmt.Sprintf(
+			"the workloads placement can't be changed while there are workloads that the change would strand (%s); "+
+				"migrate or stop the VMIs and wait for the DataVolumes to complete, "+
+				`or set the %s annotation to "true" in the same update to force the change`,
+			stranded, hcoutil.ForceWorkloadsPlacementChangeAnnotation)),
+	})
+}
+
+func isWorkloadsPlacementChangeForced(hc *v1beta1.HyperConverged) bool {
+	return hc.Annotations[hcoutil.ForceWorkloadsPlacementChangeAnnotation] == "true"
+}
+
+// getStrandedWorkloads returns the running VMIs on nodes that don't match the requested compute workloads placement, if
+// it changed, and the DataVolumes in progress, if the storage workloads placement changed
+func (wh WebhookHandler) getStrandedWorkloads(ctx context.Context, requested *v1beta1.HyperConverged, change workloadsPlacementChange) (strandedWorkloads, error) {
+	stranded := strandedWorkloads{}
+
+	if placement := operands.GetWorkloadsNodePlacement(requested, hcoutil.AppComponentCompute); change.compute && placement != nil {
+		vmis := &kubevirtv1.VirtualMachineInstanceList{}
+		if err := wh.apiReader.List(ctx, vmis); err != nil {
+			return stranded, err
+		}
+
+		if len(vmis.Items) > 0 {
+			nodes := &corev1.NodeList{}
+			if err := wh.cli.List(ctx, nodes); err != nil {
+				return stranded, err
+			}
+
+			nodesByName := make(map[string]*corev1.Node, len(nodes.Items))
+			for i := range nodes.Items {
+				nodesByName[nodes.Items[i].Name] = &nodes.Items[i]
+			}
+
+			for i := range vmis.Items {
+				vmi := &vmis.Items[i]
+				if vmi.IsFinal() || vmi.Status.NodeName == "" {
+					continue
+				}
+
+				if node, found := nodesByName[vmi.Status.NodeName]; !found || !nodeMatchesPlacement(node, placement) {
+					stranded.vmis = append(stranded.vmis, types.NamespacedName{Namespace: vmi.Namespace, Name: vmi.Name}.String())
+				}
+			}
+		}
+	}
+
+	if change.storage {
+		dvs := &cdiv1beta1.DataVolumeList{}
+		if err := wh.apiReader.List(ctx, dvs); err != nil {
+			return stranded, err
+		}
+
+		for i := range dvs.Items {
+			dv := &dvs.Items[i]
+			if !completedDataVolumePhases.Has(string(dv.Status.Phase)) {
+				stranded.dataVolumes = append(stranded.dataVolumes, types.NamespacedName{Namespace: dv.Namespace, Name: dv.Name}.String())
+			}
+		}
+	}
+
+	sort.Strings(stranded.vmis)
+	sort.Strings(stranded.dataVolumes)
+
+	return stranded, nil
+}
@@ -0,0 +1,235 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	kubevirtv1 "kubevirt.io/client-go/api/v1"
+	cdiv1beta1 "kubevirt.io/containerized-data-importer/pkg/apis/core/v1beta1"
+	sdkapi "kubevirt.io/controller-lifecycle-operator-sdk/pkg/sdk/api"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/apis/hco/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/controller/commonTestUtils"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+var _ = Describe("workloads placement change", func() {
+	var (
+		exists    *v1beta1.HyperConverged
+		requested *v1beta1.HyperConverged
+	)
+
+	BeforeEach(func() {
+		Expect(os.Setenv("OPERATOR_NAMESPACE", HcoValidNamespace)).To(BeNil())
+		exists = commonTestUtils.NewHco()
+		requested = exists.DeepCopy()
+		requested.Spec.Workloads.NodePlacement = &sdkapi.NodePlacement{NodeSelector: map[string]string{"workloads": "true"}}
+	})
+
+	newHandler := func(objects ...client.Object) *WebhookHandler {
+		cli := getFakeClient(exists)
+		objects = append(objects,
+			&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "workloads-node", Labels: map[string]string{"workloads": "true"}}},
+			&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "other-node"}},
+		)
+		for _, obj := range objects {
+			Expect(cli.Create(context.TODO(), obj)).To(Succeed())
+		}
+		return NewWebhookHandler(logger, cli, cli, HcoValidNamespace, true)
+	}
+
+	It("should allow the change if there are no workloads", func() {
+		Expect(newHandler().validateWorkloadsPlacementChange(context.TODO(), requested, exists)).To(Succeed())
+	})
+
+	It("should deny the change if there are running VMIs on nodes that are removed from the placement", func() {
+		wh := newHandler(
+			newWorkloadsVMI("vmi1", "other-node", kubevirtv1.Running),
+			newWorkloadsVMI("vmi2", "workloads-node", kubevirtv1.Running),
+			newWorkloadsVMI("vmi3", "removed-node", kubevirtv1.Running),
+		)
+
+		err := wh.validateWorkloadsPlacementChange(context.TODO(), requested, exists)
+		Expect(apierrors.IsInvalid(err)).To(BeTrue())
+
+		causes := err.(apierrors.APIStatus).Status().Details.Causes
+		Expect(causes).To(HaveLen(1))
+		Expect(causes[0].Type).To(Equal(metav1.CauseType(field.ErrorTypeForbidden)))
+		Expect(causes[0].Field).To(Equal("spec.workloads"))
+		Expect(causes[0].Message).To(ContainSubstring("(VMIs: default/vmi1, default/vmi3)"))
+		Expect(causes[0].Message).To(ContainSubstring(hcoutil.ForceWorkloadsPlacementChangeAnnotation))
+	})
+
+	It("should find the workloads outside the operator namespace, that the webhook cache doesn't hold", func() {
+		cli := getFakeClient(exists)
+		for _, obj := range []client.Object{
+			&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "workloads-node", Labels: map[string]string{"workloads": "true"}}},
+			&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "other-node"}},
+			newWorkloadsVMI("vmi1", "other-node", kubevirtv1.Running),
+			newWorkloadsVMI("vmi2", "workloads-node", kubevirtv1.Running),
+		} {
+			Expect(cli.Create(context.TODO(), obj)).To(Succeed())
+		}
+
+		wh := NewWebhookHandler(logger, namespacedCacheClient{Client: cli, namespace: HcoValidNamespace}, cli, HcoValidNamespace, true)
+
+		err := wh.validateWorkloadsPlacementChange(context.TODO(), requested, exists)
+		Expect(apierrors.IsInvalid(err)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("(VMIs: default/vmi1)"))
+	})
+
+	It("should ignore the VMIs that are not scheduled, or that are completed", func() {
+		wh := newHandler(
+			newWorkloadsVMI("pending", "", kubevirtv1.Pending),
+			newWorkloadsVMI("succeeded", "other-node", kubevirtv1.Succeeded),
+			newWorkloadsVMI("failed", "other-node", kubevirtv1.Failed),
+		)
+
+		Expect(wh.validateWorkloadsPlacementChange(context.TODO(), requested, exists)).To(Succeed())
+	})
+
+	It("should not check the VMIs if the placement is removed", func() {
+		wh := newHandler(newWorkloadsVMI("vmi1", "other-node", kubevirtv1.Running))
+		Expect(wh.validateWorkloadsPlacementChange(context.TODO(), exists, requested)).To(Succeed())
+	})
+
+	It("should deny the change if there are DataVolumes in progress", func() {
+		wh := newHandler(
+			newWorkloadsDataVolume("importing", cdiv1beta1.ImportInProgress),
+			newWorkloadsDataVolume("cloning", cdiv1beta1.CloneScheduled),
+			newWorkloadsDataVolume("imported", cdiv1beta1.Succeeded),
+			newWorkloadsDataVolume("waiting", cdiv1beta1.WaitForFirstConsumer),
+		)
+
+		err := wh.validateWorkloadsPlacementChange(context.TODO(), requested, exists)
+		Expect(apierrors.IsInvalid(err)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("(DataVolumes in progress: default/cloning, default/importing)"))
+	})
+
+	It("should deny a change of only the compute workloads placement override, if there are running VMIs on removed nodes", func() {
+		wh := newHandler(
+			newWorkloadsVMI("vmi1", "other-node", kubevirtv1.Running),
+			newWorkloadsDataVolume("importing", cdiv1beta1.ImportInProgress),
+		)
+		requested = exists.DeepCopy()
+		requested.Spec.NodePlacementOverrides = []v1beta1.HyperConvergedNodePlacementOverride{
+			{
+				Component: string(hcoutil.AppComponentCompute),
+				Workloads: v1beta1.HyperConvergedConfig{
+					NodePlacement: &sdkapi.NodePlacement{NodeSelector: map[string]string{"workloads": "true"}},
+				},
+			},
+		}
+
+		err := wh.validateWorkloadsPlacementChange(context.TODO(), requested, exists)
+		Expect(apierrors.IsInvalid(err)).To(BeTrue())
+
+		causes := err.(apierrors.APIStatus).Status().Details.Causes
+		Expect(causes).To(HaveLen(1))
+		Expect(causes[0].Field).To(Equal("spec.nodePlacementOverrides"))
+		Expect(causes[0].Message).To(ContainSubstring("(VMIs: default/vmi1)"))
+	})
+
+	It("should deny a change of only the storage workloads placement override, if there are DataVolumes in progress", func() {
+		wh := newHandler(
+			newWorkloadsVMI("vmi1", "other-node", kubevirtv1.Running),
+			newWorkloadsDataVolume("importing", cdiv1beta1.ImportInProgress),
+		)
+		requested = exists.DeepCopy()
+		requested.Spec.NodePlacementOverrides = []v1beta1.HyperConvergedNodePlacementOverride{
+			{
+				Component: string(hcoutil.AppComponentStorage),
+				Workloads: v1beta1.HyperConvergedConfig{
+					NodePlacement: &sdkapi.NodePlacement{NodeSelector: map[string]string{"workloads": "true"}},
+				},
+			},
+		}
+
+		err := wh.validateWorkloadsPlacementChange(context.TODO(), requested, exists)
+		Expect(apierrors.IsInvalid(err)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("(DataVolumes in progress: default/importing)"))
+	})
+
+	It("should allow a global change that the compute and storage overrides mask", func() {
+		wh := newHandler(
+			newWorkloadsVMI("vmi1", "other-node", kubevirtv1.Running),
+			newWorkloadsDataVolume("importing", cdiv1beta1.ImportInProgress),
+		)
+		overrides := []v1beta1.HyperConvergedNodePlacementOverride{
+			{Component: string(hcoutil.AppComponentCompute), Workloads: v1beta1.HyperConvergedConfig{NodePlacement: commonTestUtils.NewNodePlacement()}},
+			{Component: string(hcoutil.AppComponentStorage), Workloads: v1beta1.HyperConvergedConfig{NodePlacement: commonTestUtils.NewNodePlacement()}},
+		}
+		exists.Spec.NodePlacementOverrides = overrides
+		requested.Spec.NodePlacementOverrides = overrides
+
+		Expect(wh.validateWorkloadsPlacementChange(context.TODO(), requested, exists)).To(Succeed())
+	})
+
+	It("should allow the change if it is forced by the annotation", func() {
+		wh := newHandler(newWorkloadsVMI("vmi1", "other-node", kubevirtv1.Running))
+		requested.Annotations = map[string]string{hcoutil.ForceWorkloadsPlacementChangeAnnotation: "true"}
+
+		Expect(wh.validateWorkloadsPlacementChange(context.TODO(), requested, exists)).To(Succeed())
+	})
+
+	It("should allow other changes, while there are workloads", func() {
+		wh := newHandler(newWorkloadsVMI("vmi1", "other-node", kubevirtv1.Running))
+		exists.Spec.Workloads = requested.Spec.Workloads
+		requested.Spec.FeatureGates.WithHostPassthroughCPU = boolPtr(true)
+
+		Expect(wh.validateWorkloadsPlacementChange(context.TODO(), requested, exists)).To(Succeed())
+	})
+
+	It("should deny the update of the HyperConverged CR", func() {
+		wh := newHandler(newWorkloadsVMI("vmi1", "other-node", kubevirtv1.Running))
+
+		err := wh.ValidateUpdate(requested, exists)
+		Expect(apierrors.IsInvalid(err)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("spec.workloads: Forbidden: the workloads placement can't be changed"))
+	})
+
+	It("should list only the first workloads in the message", func() {
+		var names []string
+		for i := 0; i < maxListedWorkloads+2; i++ {
+			names = append(names, fmt.Sprintf("ns/vmi%02d", i))
+		}
+
+		Expect(strandedWorkloads{vmis: names}.String()).To(HaveSuffix("ns/vmi09 and 2 more"))
+	})
+})
+
+func newWorkloadsVMI(name, nodeName string, phase kubevirtv1.VirtualMachineInstancePhase) *kubevirtv1.VirtualMachineInstance {
+	return &kubevirtv1.VirtualMachineInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Status:     kubevirtv1.VirtualMachineInstanceStatus{NodeName: nodeName, Phase: phase},
+	}
+}
+
+func newWorkloadsDataVolume(name string, phase cdiv1beta1.DataVolumePhase) *cdiv1beta1.DataVolume {
+	return &cdiv1beta1.DataVolume{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Status:     cdiv1beta1.DataVolumeStatus{Phase: phase},
+	}
+}
+
+// namespacedCacheClient mocks the client of the webhook manager, that lists the namespaced objects only from the cache
+// of the watched namespace
+type namespacedCacheClient struct {
+	client.Client
+	namespace string
+}
+
+func (c namespacedCacheClient) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	if _, isClusterScoped := list.(*corev1.NodeList); isClusterScoped {
+		return c.Client.List(ctx, list, opts...)
+	}
+	return c.Client.List(ctx, list, append(opts, client.InNamespace(c.namespace))...)
+}
@@ -113,7 +113,7 @@ var (
 
 func genHcoCrds() error {
 	// Write out CRDs and CR
-	if err := util.MarshallObject(components.GetOperatorCRD(*apiSources), os.Stdout); err != nil {
+	if err := util.MarshallObject(components.GetOperatorCRD(*apiSources, *namespace), os.Stdout); err != nil {
 		return err
 	}
 
@@ -277,7 +277,7 @@ func getHcoCsv() {
 	// This is the base deployment + rbac for the HCO CSV
 	installStrategyBase := components.GetInstallStrategyBase(params)
 
-	overwriteDeploymentSpecLabels(installStrategyBase.DeploymentSpecs, hcoutil.AppComponentDeployment)
+	overwriteDeploymentSpecLabels(installStrategyBase.DeploymentSpecs, hcoutil.AppComponentDeployment, "")
 
 	relatedImages := getRelatedImages()
 
@@ -349,7 +349,7 @@ func processOneCsv(c util.CsvWithComponent, i int, installStrategyBase *csvv1alp
 
 	strategySpec := csvStruct.Spec.InstallStrategy.StrategySpec
 
-	overwriteDeploymentSpecLabels(strategySpec.DeploymentSpecs, c.Component)
+	overwriteDeploymentSpecLabels(strategySpec.DeploymentSpecs, c.Component, c.Version)
 	installStrategyBase.DeploymentSpecs = append(installStrategyBase.DeploymentSpecs, strategySpec.DeploymentSpecs...)
 
 	installStrategyBase.ClusterPermissions = append(installStrategyBase.ClusterPermissions, strategySpec.ClusterPermissions...)
@@ -430,6 +430,7 @@ func getInitialCsvList() []util.CsvWithComponent {
 		{
 			Csv:       *nmoCsv,
 			Component: hcoutil.AppComponentNetwork,
+			Version:   *nmoVersion,
 		},
 		{
 			Csv:       *hppCsv,
@@ -491,7 +492,7 @@ func getDeploymentParams() *components.DeploymentOperatorParams {
 	}
 }
 
-func overwriteDeploymentSpecLabels(specs []csvv1alpha1.StrategyDeploymentSpec, component hcoutil.AppComponent) {
+func overwriteDeploymentSpecLabels(specs []csvv1alpha1.StrategyDeploymentSpec, component hcoutil.AppComponent, operatorVersion string) {
 	for i := range specs {
 		if specs[i].Label == nil {
 			specs[i].Label = make(map[string]string)
@@ -501,6 +502,9 @@ func overwriteDeploymentSpecLabels(specs []csvv1alpha1.StrategyDeploymentSpec, c
 		}
 		overwriteWithStandardLabels(specs[i].Spec.Template.Labels, *hcoKvIoVersion, component)
 		overwriteWithStandardLabels(specs[i].Label, *hcoKvIoVersion, component)
+		if operatorVersion != "" {
+			specs[i].Label[hcoutil.OperatorVersionLabel] = operatorVersion
+		}
 	}
 
 }
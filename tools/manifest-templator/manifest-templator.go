@@ -121,7 +121,7 @@ func main() {
 	}
 	// hco-operator and hco-webhook
 	for i := range deployments {
-		overwriteDeploymentLabels(&deployments[i], hcoutil.AppComponentDeployment)
+		overwriteDeploymentLabels(&deployments[i], hcoutil.AppComponentDeployment, "")
 	}
 
 	services := []v1.Service{
@@ -160,7 +160,7 @@ func main() {
 		for _, deploymentSpec := range strategySpec.DeploymentSpecs {
 			deploy := getBasicDeployment(deploymentSpec)
 			injectWebhookMounts(csvStruct.Spec.WebhookDefinitions, &deploy)
-			overwriteDeploymentLabels(&deploy, csvStr.Component)
+			overwriteDeploymentLabels(&deploy, csvStr.Component, csvStr.Version)
 			deployments = append(deployments, deploy)
 		}
 
@@ -387,6 +387,7 @@ func getCsvWithComponent() []util.CsvWithComponent {
 		{
 			Csv:       *nmoCsv,
 			Component: hcoutil.AppComponentNetwork,
+			Version:   *nmoVersion,
 		},
 		{
 			Csv:       *hppCsv,
@@ -429,7 +430,7 @@ func writeOperatorCRD() {
 	operatorCrd, err := os.Create(path.Join(*deployDir, "crds/hco.crd.yaml"))
 	check(err)
 	defer operatorCrd.Close()
-	check(util.MarshallObject(components.GetOperatorCRD(*apiSources), operatorCrd))
+	check(util.MarshallObject(components.GetOperatorCRD(*apiSources, *operatorNamespace), operatorCrd))
 }
 
 func writeOperatorDeploymentsAndServices(deployments []appsv1.Deployment, services []v1.Service) {
@@ -508,7 +509,7 @@ func injectWebhookMounts(webhookDefs []csvv1alpha1.WebhookDescription, deploy *a
 	}
 }
 
-func overwriteDeploymentLabels(deploy *appsv1.Deployment, component hcoutil.AppComponent) {
+func overwriteDeploymentLabels(deploy *appsv1.Deployment, component hcoutil.AppComponent, operatorVersion string) {
 	if deploy.Labels == nil {
 		deploy.Labels = make(map[string]string)
 	}
@@ -517,6 +518,9 @@ func overwriteDeploymentLabels(deploy *appsv1.Deployment, component hcoutil.AppC
 	}
 	overwriteWithStandardLabels(deploy.Spec.Template.Labels, *hcoKvIoVersion, component)
 	overwriteWithStandardLabels(deploy.Labels, *hcoKvIoVersion, component)
+	if operatorVersion != "" {
+		deploy.Labels[hcoutil.OperatorVersionLabel] = operatorVersion
+	}
 }
 
 func overwriteWithStandardLabels(labels map[string]string, version string, component hcoutil.AppComponent) {
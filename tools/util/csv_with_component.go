@@ -5,4 +5,6 @@ import hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 type CsvWithComponent struct {
 	Csv       string
 	Component hcoutil.AppComponent
+	// Version is the version of the component operator, for the components that have no operand CR to read it from
+	Version string
 }